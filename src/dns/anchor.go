@@ -0,0 +1,151 @@
+package dns
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// the root keys that dnssec validation starts from
+// a key is trusted if it is in Keys, or matches one of the DS records
+type TrustAnchor struct {
+	DS   []*RdDS
+	Keys []*RdDNSKEY
+}
+
+// the root key signing keys published by iana, see
+// data.iana.org/root-anchors/root-anchors.xml
+func RootTrustAnchor() *TrustAnchor {
+	ret, err := ParseTrustAnchor(strings.NewReader(rootAnchors))
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+const rootAnchors = `
+; KSK-2017
+. DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBB683457104237C7F8EC8D
+; KSK-2024
+. DS 38696 8 2 683D2D0ACB8C9B712A1948B27F741219298D0A450D612C483AF444A4C0FB2B16
+`
+
+type anchorError struct {
+	line int
+	s    string
+}
+
+func (e *anchorError) Error() string {
+	return fmt.Sprintf("trust anchor line %d: %s", e.line, e.s)
+}
+
+func parseUint(s string, bits int) (uint64, error) {
+	return strconv.ParseUint(s, 10, bits)
+}
+
+func parseAnchorDS(fields []string) (*RdDS, error) {
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("ds needs 4 fields")
+	}
+	tag, err := parseUint(fields[0], 16)
+	if err != nil {
+		return nil, err
+	}
+	alg, err := parseUint(fields[1], 8)
+	if err != nil {
+		return nil, err
+	}
+	dt, err := parseUint(fields[2], 8)
+	if err != nil {
+		return nil, err
+	}
+	digest, err := hex.DecodeString(strings.Join(fields[3:], ""))
+	if err != nil {
+		return nil, err
+	}
+	return &RdDS{uint16(tag), uint8(alg), uint8(dt), digest}, nil
+}
+
+func parseAnchorKey(fields []string) (*RdDNSKEY, error) {
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("dnskey needs 4 fields")
+	}
+	flags, err := parseUint(fields[0], 16)
+	if err != nil {
+		return nil, err
+	}
+	proto, err := parseUint(fields[1], 8)
+	if err != nil {
+		return nil, err
+	}
+	alg, err := parseUint(fields[2], 8)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.Join(fields[3:], ""))
+	if err != nil {
+		return nil, err
+	}
+	return &RdDNSKEY{uint16(flags), uint8(proto), uint8(alg), key}, nil
+}
+
+// parses root DS and DNSKEY records in the master file format, like
+// ". DS 20326 8 2 E06D..." or ". 172800 IN DNSKEY 257 3 8 AwEA..."
+// lines starting with ";" are comments
+func ParseTrustAnchor(r io.Reader) (*TrustAnchor, error) {
+	ret := new(TrustAnchor)
+	scanner := bufio.NewScanner(r)
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if fields[0] != "." {
+			return nil, &anchorError{lineno, "not a root record"}
+		}
+		fields = fields[1:]
+		// optional ttl and class
+		for len(fields) > 0 {
+			if _, err := parseUint(fields[0], 32); err == nil ||
+				strings.EqualFold(fields[0], "IN") {
+				fields = fields[1:]
+				continue
+			}
+			break
+		}
+		if len(fields) == 0 {
+			return nil, &anchorError{lineno, "missing type"}
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "DS":
+			ds, err := parseAnchorDS(fields[1:])
+			if err != nil {
+				return nil, &anchorError{lineno, err.Error()}
+			}
+			ret.DS = append(ret.DS, ds)
+		case "DNSKEY":
+			key, err := parseAnchorKey(fields[1:])
+			if err != nil {
+				return nil, &anchorError{lineno, err.Error()}
+			}
+			ret.Keys = append(ret.Keys, key)
+		default:
+			return nil, &anchorError{lineno, "unknown type " + fields[0]}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(ret.DS) == 0 && len(ret.Keys) == 0 {
+		return nil, &anchorError{lineno, "no ds or dnskey"}
+	}
+	return ret, nil
+}
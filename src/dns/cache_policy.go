@@ -0,0 +1,60 @@
+package dns
+
+// decides if the delegation of a zone should be put into the cache
+type CachePolicy interface {
+	ShouldCache(zone *Name) bool
+}
+
+// wraps a function as a cache policy
+type CacheFunc func(zone *Name) bool
+
+func (f CacheFunc) ShouldCache(zone *Name) bool {
+	return f(zone)
+}
+
+// the default policy, only caches zones on the public suffix list
+var CacheRegistrars CachePolicy = CacheFunc(IsRegistrar)
+
+// caches every delegation
+var CacheAll CachePolicy = CacheFunc(func(zone *Name) bool {
+	return true
+})
+
+// caches zones with no more than max labels
+func CacheDepth(max int) CachePolicy {
+	return CacheFunc(func(zone *Name) bool {
+		return len(zone.labels) <= max
+	})
+}
+
+// a policy by allow and deny suffix lists
+// a zone that is under (or equal to) any deny suffix is never cached;
+// a zone under any allow suffix is always cached;
+// otherwise, Else decides, and nil Else means not caching
+type SuffixPolicy struct {
+	Allow []*Name
+	Deny  []*Name
+	Else  CachePolicy
+}
+
+func underAny(zone *Name, suffixes []*Name) bool {
+	for _, s := range suffixes {
+		if zone.Equal(s) || zone.SubOf(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *SuffixPolicy) ShouldCache(zone *Name) bool {
+	if underAny(zone, p.Deny) {
+		return false
+	}
+	if underAny(zone, p.Allow) {
+		return true
+	}
+	if p.Else == nil {
+		return false
+	}
+	return p.Else.ShouldCache(zone)
+}
@@ -0,0 +1,41 @@
+package dns
+
+import "testing"
+
+func TestCachePolicy(t *testing.T) {
+	o := func(p CachePolicy, n string, b bool) {
+		if p.ShouldCache(Domain(n)) != b {
+			t.Errorf("ShouldCache(%s) expecting: %t", n, b)
+		}
+	}
+
+	o(CacheRegistrars, "com", true)
+	o(CacheRegistrars, "google.com", false)
+	o(CacheAll, "google.com", true)
+	o(CacheDepth(2), "google.com", true)
+	o(CacheDepth(2), "cloud.google.com", false)
+
+	p := &SuffixPolicy{
+		Allow: []*Name{Domain("amazonaws.com")},
+		Deny:  []*Name{Domain("internal.amazonaws.com")},
+		Else:  CacheRegistrars,
+	}
+	o(p, "s3.amazonaws.com", true)
+	o(p, "amazonaws.com", true)
+	o(p, "x.internal.amazonaws.com", false)
+	o(p, "net", true)
+	o(p, "example.net", false)
+}
+
+func TestCachePolicyNil(t *testing.T) {
+	c := new(Client)
+	c.SetCachePolicy(nil)
+	if c.policy == nil || !c.policy.ShouldCache(Domain("com")) {
+		t.Error("client: nil policy")
+	}
+	s := newSolver(nil, nil)
+	s.UsePolicy(nil)
+	if !s.policy.ShouldCache(Domain("com")) {
+		t.Error("solver: nil policy")
+	}
+}
@@ -1,34 +1,143 @@
 package dns
 
 import (
+	"fmt"
 	"io"
+	"net"
+	"strings"
+	"time"
 )
 
 // client is a synchronous helper for solving simple problems
 // it will create a connection automatically
 type Client struct {
-	conn  *Conn
-	cache *NSCache
+	conn     *Conn
+	cache    *NSCache
+	policy   CachePolicy
+	selector ServerSelector
+	anchor   *TrustAnchor
+	rootKeys *RootKeyCache // validated with anchor
+	roots    *Zone         // nil for the default
+	timeout  time.Duration // 0 for the default
+	tries    int           // 0 for the default
 }
 
 func NewClient() *Client {
-	return &Client{NewConn(), TheCache}
+	return &Client{
+		conn:     NewConn(),
+		cache:    TheCache,
+		policy:   CacheRegistrars,
+		rootKeys: NewRootKeyCache(),
+	}
 }
 
-func (c *Client) Solve(p Prob, logTo io.Writer) {
+// sets the server selection strategy; by default, servers are ordered
+// by the round trip times recorded in the cache
+func (c *Client) SetSelector(sel ServerSelector) {
+	c.selector = sel
+}
+
+// sets the time to wait for a response and the times to send a query,
+// 0 for the defaults
+func (c *Client) SetTimeout(timeout time.Duration, tries int) {
+	c.timeout = timeout
+	c.tries = tries
+}
+
+// sets which delegations are cached when solving, nil for the default
+// CacheRegistrars
+func (c *Client) SetCachePolicy(p CachePolicy) {
+	if p == nil {
+		p = CacheRegistrars
+	}
+	c.policy = p
+}
+
+// enables dnssec validation from the trust anchor, nil for disabling
+func (c *Client) SetTrustAnchor(t *TrustAnchor) {
+	c.anchor = t
+	c.rootKeys = NewRootKeyCache()
+}
+
+// sets the root servers to start from, like ones from LoadRootHints for
+// a lab setup, nil for the default; a lab should use its own cache too
+func (c *Client) SetRootHints(z *Zone) {
+	c.roots = z
+}
+
+// sets the cache of delegations, TheCache by default
+func (c *Client) SetCache(cache *NSCache) {
+	c.cache = cache
+}
+
+// asks the root servers for the current set of root servers, and puts it
+// into the cache, where recursion starts from
+func (c *Client) Prime(logTo io.Writer) error {
+	solver := c.newSolver(logTo)
+	p := NewProbPrime()
+	solver.Solve(p)
+	if p.Roots == nil {
+		return fmt.Errorf("priming: %s", strings.Join(p.Problems, "; "))
+	}
+	c.roots = p.Roots
+	c.cache.Add(p.Roots)
+	return nil
+}
+
+// fills the cache with the delegations of a local root zone file, and
+// keeps reloading it; interval is for reloading, 0 for the default
+func (c *Client) WarmStart(path string,
+	interval time.Duration) (*RootZoneLoader, error) {
+	l := NewRootZoneLoader(path, c.cache, interval)
+	if err := l.Start(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (c *Client) newSolver(logTo io.Writer) *solver {
 	solver := newSolver(c.conn, logTo)
 	solver.UseCache(c.cache)
+	solver.UsePolicy(c.policy)
+	solver.UseSelector(c.selector)
+	solver.UseTrustAnchor(c.anchor)
+	solver.UseRootKeys(c.rootKeys)
+	solver.UseRoots(c.roots)
+	solver.UseTimeout(c.timeout, c.tries)
+	return solver
+}
+
+func (c *Client) Solve(p Prob, logTo io.Writer) {
+	solver := c.newSolver(logTo)
 	solver.Solve(p)
 }
 
 func (c *Client) RecurQuery(n *Name, t uint16, logTo io.Writer) *ProbRecur {
-	solver := newSolver(c.conn, logTo)
-	solver.UseCache(c.cache)
+	solver := c.newSolver(logTo)
 	recur := NewProbRecur(n, t)
 	solver.Solve(recur)
 	return recur
 }
 
+// looks up the names of an ipv4 or ipv6 address
+func (c *Client) ReverseQuery(ip net.IP, logTo io.Writer) *ProbPTR {
+	solver := c.newSolver(logTo)
+	p := NewProbPTR(ip)
+	solver.Solve(p)
+	return p
+}
+
+// walks the nsec or nsec3 chain of a zone, calling f for each entry found
+// limit is the max number of entries, 0 for the default
+func (c *Client) WalkZone(zone *Name, limit int, f func(e *WalkEntry),
+	logTo io.Writer) *ProbWalk {
+	solver := c.newSolver(logTo)
+	p := NewProbWalk(zone, limit, f)
+	solver.UseQueryLimit(_SOLVER_MAX_QUERY + 2*p.limit)
+	solver.Solve(p)
+	return p
+}
+
 func (c *Client) Query(host *IPv4, name *Name, t uint16) (*Response, error) {
 	re, err := c.conn.Query(host, name, t)
 	return re, err
@@ -2,33 +2,50 @@ package dns
 
 // rdata type codes
 const (
-	A     = 1
-	NS    = 2
-	MD    = 3
-	MF    = 4
-	CNAME = 5
-	SOA   = 6
-	MB    = 7
-	MG    = 8
-	MR    = 9
-	NULL  = 10
-	WKS   = 11
-	PTR   = 12
-	HINFO = 13
-	MINFO = 14
-	MX    = 15
-	TXT   = 16
-	AAAA  = 28
+	A          = 1
+	NS         = 2
+	MD         = 3
+	MF         = 4
+	CNAME      = 5
+	SOA        = 6
+	MB         = 7
+	MG         = 8
+	MR         = 9
+	NULL       = 10
+	WKS        = 11
+	PTR        = 12
+	HINFO      = 13
+	MINFO      = 14
+	MX         = 15
+	TXT        = 16
+	AAAA       = 28
+	DNAME      = 39
+	DS         = 43
+	RRSIG      = 46
+	NSEC       = 47
+	DNSKEY     = 48
+	NSEC3      = 50
+	NSEC3PARAM = 51
+)
+
+// query only types
+const (
+	TSIG = 250
+	IXFR = 251
+	AXFR = 252
+	ANY  = 255
 )
 
 // flags structure
 const (
 	F_RESPONSE  = 0x1 << 15
-	F_OPMASK    = 0x3 << 11
+	F_OPMASK    = 0xf << 11
 	F_AA        = 0x1 << 10
 	F_TC        = 0x1 << 9
 	F_RD        = 0x1 << 8
 	F_RA        = 0x1 << 7
+	F_AD        = 0x1 << 5 // authentic data, rfc4035
+	F_CD        = 0x1 << 4 // checking disabled, rfc4035
 	F_RCODEMASK = 0xf
 )
 
@@ -37,6 +54,8 @@ const (
 	OPQUERY  = 0 << 11
 	OPIQUERY = 1 << 11
 	OPSTATUS = 2 << 11
+	OPNOTIFY = 4 << 11
+	OPUPDATE = 5 << 11
 )
 
 // resp code in flags
@@ -47,6 +66,11 @@ const (
 	RCODE_NAMEERROR    = 3
 	RCODE_NOTIMPLEMENT = 4
 	RCODE_REFUSED      = 5
+	RCODE_YXDOMAIN     = 6
+	RCODE_YXRRSET      = 7
+	RCODE_NXRRSET      = 8
+	RCODE_NOTAUTH      = 9
+	RCODE_NOTZONE      = 10
 )
 
 // class code
@@ -55,6 +79,8 @@ const (
 	CS = 2
 	CH = 3
 	HS = 4
+
+	NONE = 254 // for updates
 )
 
 const (
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"sync"
 	"time"
 	// "xxd"
 )
@@ -20,6 +21,7 @@ type Conn struct {
 	closeSignal chan int
 	recvClosed  chan int
 	serveClosed chan int
+	startLock   sync.Mutex // queries can be sent from many goroutines
 	started     bool
 }
 
@@ -28,6 +30,8 @@ type request struct {
 	name     *Name
 	t        uint16
 	host     *IPv4
+	opts     *QueryOptions
+	mac      []byte // of the signed query
 	deadline time.Time
 	callback func(*Response, error)
 }
@@ -62,7 +66,7 @@ func (c *Conn) logError(s string, e error) {
 
 var ErrTimeout = errors.New("time out")
 
-func (c *Conn) handleRecv(msg *Msg, addr net.Addr) error {
+func (c *Conn) handleRecv(msg *Msg, buf []byte, addr net.Addr) error {
 	switch udpa := addr.(type) {
 	case *net.UDPAddr:
 		ip := IPFromIP(udpa.IP)
@@ -79,10 +83,18 @@ func (c *Conn) handleRecv(msg *Msg, addr net.Addr) error {
 			return errors.New("recv from other hosts")
 		}
 
+		if job.opts != nil && job.opts.TSIG != nil {
+			signed, _, err := VerifyTSIG(buf, job.opts.TSIG, job.mac)
+			if err != nil {
+				// can be spoofed, keep waiting until the deadline
+				return fmt.Errorf("response dropped: %s", err)
+			}
+			msg = signed
+		}
+		delete(c.jobs, msg.ID)
+
 		resp := &Response{msg, ip, port, time.Now()}
 		job.callback(resp, nil)
-
-		delete(c.jobs, msg.ID)
 	default:
 		return errors.New("addr not UDP")
 	}
@@ -103,7 +115,7 @@ func (c *Conn) serve() {
 			if err != nil {
 				c.logError("parse", err)
 			} else {
-				err = c.handleRecv(msg, recv.addr)
+				err = c.handleRecv(msg, recv.buf, recv.addr)
 				if err != nil {
 					c.logError("handle", err)
 				}
@@ -111,8 +123,8 @@ func (c *Conn) serve() {
 			didNothing = false
 		}
 
-		if len(cleanTicker.C) > 0 { // time to clean time outs
-			t := time.Now()
+		select {
+		case t := <-cleanTicker.C: // time to clean time outs
 			toRemove := make([]uint16, 0, 1024)
 			for id, job := range c.jobs {
 				if t.After(job.deadline) {
@@ -124,24 +136,26 @@ func (c *Conn) serve() {
 			for _, id := range toRemove {
 				delete(c.jobs, id)
 			}
-
-			<-cleanTicker.C // sechedule next check
 			didNothing = false
+		default:
 		}
 
 		// send one if possible
 		if len(c.sendQueue) > 0 {
 			job := <-c.sendQueue
-			msg := NewQuery(job.name, job.t)
+			msg := job.opts.newQuery(job.name, job.t)
 			_, b := c.jobs[msg.ID]
 			for b {
 				msg.RollAnID()
 				_, b = c.jobs[msg.ID]
 			}
 			buf, err := msg.Wire()
+			if err == nil && job.opts != nil && job.opts.TSIG != nil {
+				buf, job.mac, err = msg.WireTSIG(job.opts.TSIG, nil)
+			}
 			if err == nil {
 				ip := job.host.IP()
-				addr := &net.UDPAddr{IP: ip, Port: DNS_PORT}
+				addr := &net.UDPAddr{IP: ip, Port: job.opts.port()}
 				// xxd.Print(buf)
 				_, err = c.conn.WriteTo(buf, addr)
 			}
@@ -150,7 +164,7 @@ func (c *Conn) serve() {
 				job.callback(nil, err)
 			} else {
 				// send succeed, waiting now
-				job.deadline = time.Now().Add(time.Second * 5)
+				job.deadline = time.Now().Add(job.opts.timeout())
 				c.jobs[msg.ID] = job
 			}
 
@@ -172,14 +186,15 @@ func (c *Conn) serve() {
 
 func (c *Conn) recv() {
 	wait := time.Millisecond
-	buf := make([]byte, 512)
+	buf := make([]byte, 65536) // large enough for edns
 	for {
 		deadline := time.Now().Add(wait)
 		c.conn.SetReadDeadline(deadline)
 		n, addr, err := c.conn.ReadFrom(buf)
 		if err == nil {
-			c.recvQueue <- &recvBuf{buf[:n], addr}
-			buf = make([]byte, 512) // make a new one
+			packet := make([]byte, n)
+			copy(packet, buf[:n])
+			c.recvQueue <- &recvBuf{packet, addr}
 		} else {
 			if nerr, b := err.(net.Error); b {
 				if !nerr.Timeout() &&
@@ -208,9 +223,10 @@ func (c *Conn) LogTo(f func(e error)) {
 		close(c.errlog)
 	}
 
-	c.errlog = make(chan error)
+	errlog := make(chan error)
+	c.errlog = errlog
 	go func() {
-		for e := range c.errlog {
+		for e := range errlog {
 			f(e)
 		}
 	}()
@@ -265,6 +281,8 @@ func NewConn() *Conn {
 }
 
 func (c *Conn) ensureStarted() error {
+	c.startLock.Lock()
+	defer c.startLock.Unlock()
 	if !c.started {
 		return c.start()
 	}
@@ -272,13 +290,19 @@ func (c *Conn) ensureStarted() error {
 }
 
 func (c *Conn) SendQuery(h *IPv4, n *Name, t uint16, callback func(*Response, error)) {
+	c.SendQueryOpts(h, n, t, nil, callback)
+}
+
+// sends a query with options, nil opts for a plain query
+func (c *Conn) SendQueryOpts(h *IPv4, n *Name, t uint16, opts *QueryOptions,
+	callback func(*Response, error)) {
 	err := c.ensureStarted()
 	if err != nil {
 		callback(nil, err)
 		return
 	}
 
-	job := &request{name: n, t: t, host: h, callback: callback}
+	job := &request{name: n, t: t, host: h, opts: opts, callback: callback}
 
 	c.sendQueue <- job
 }
@@ -0,0 +1,34 @@
+package dns
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestConnConcurrentStart(t *testing.T) {
+	// a server that never answers
+	silent, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("no udp:", err)
+	}
+	defer silent.Close()
+	port := uint16(silent.LocalAddr().(*net.UDPAddr).Port)
+
+	conn := NewConn()
+	defer conn.Close()
+	conn.LogTo(func(e error) {})
+	opts := &QueryOptions{Port: port, Timeout: 100 * time.Millisecond}
+
+	const n = 8
+	done := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go conn.SendQueryOpts(ParseIP("127.0.0.1"), Domain("a.test"), A,
+			opts, func(r *Response, e error) { done <- e })
+	}
+	for i := 0; i < n; i++ {
+		if e := <-done; e != ErrTimeout {
+			t.Errorf("query %d: %v", i, e)
+		}
+	}
+}
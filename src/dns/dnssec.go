@@ -0,0 +1,355 @@
+package dns
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// dnssec signature verification, see rfc4034, rfc4035 and rfc5155
+
+// dnssec algorithms
+const (
+	ALG_RSASHA1         = 5
+	ALG_RSASHA1NSEC3    = 7
+	ALG_RSASHA256       = 8
+	ALG_RSASHA512       = 10
+	ALG_ECDSAP256SHA256 = 13
+	ALG_ECDSAP384SHA384 = 14
+	ALG_ED25519         = 15
+)
+
+// ds digest types
+const (
+	DIGEST_SHA1   = 1
+	DIGEST_SHA256 = 2
+	DIGEST_SHA384 = 4
+)
+
+var (
+	errUnknownAlg  = errors.New("unsupported algorithm")
+	errBadKey      = errors.New("bad public key")
+	errBadSig      = errors.New("signature verification failed")
+	errNoSig       = errors.New("no rrsig")
+	errSigTime     = errors.New("rrsig expired or not yet valid")
+	errSigNoKey    = errors.New("no key for rrsig")
+	errSigMismatch = errors.New("rrsig does not match the rrset")
+)
+
+// the canonical wire form of the rdata, names lower cased
+func canonicalRdata(rd Rdata) []byte {
+	w := &writer{canonical: true}
+	rd.writeTo(w)
+	return w.wire()
+}
+
+// the data that an rrsig signs over the rrset, rfc4034 section 3.1.8.1
+func signedData(sig *RdRRSIG, rrs []*RR) []byte {
+	w := &writer{canonical: true}
+	sig.writeHeader(w)
+
+	owner := rrs[0].Name
+	if int(sig.Labels) < owner.NumLabels() {
+		// expanded from a wildcard
+		owner, _ = owner.ancestor(int(sig.Labels)).Child("*")
+	}
+
+	rdatas := make([][]byte, 0, len(rrs))
+	for _, rr := range rrs {
+		rdatas = append(rdatas, canonicalRdata(rr.Rdata))
+	}
+	sort.Slice(rdatas, func(i, j int) bool {
+		return bytes.Compare(rdatas[i], rdatas[j]) < 0
+	})
+
+	var last []byte
+	for i, rd := range rdatas {
+		if i > 0 && bytes.Equal(rd, last) {
+			continue // duplicated
+		}
+		last = rd
+		w.writeName(owner)
+		w.writeUint16(rrs[0].Type)
+		w.writeUint16(rrs[0].Class)
+		w.writeUint32(sig.OrigTTL)
+		w.writeUint16(uint16(len(rd)))
+		w.writeBytes(rd)
+	}
+	return w.wire()
+}
+
+func hashFor(alg uint8) (crypto.Hash, bool) {
+	switch alg {
+	case ALG_RSASHA1, ALG_RSASHA1NSEC3:
+		return crypto.SHA1, true
+	case ALG_RSASHA256, ALG_ECDSAP256SHA256:
+		return crypto.SHA256, true
+	case ALG_RSASHA512:
+		return crypto.SHA512, true
+	case ALG_ECDSAP384SHA384:
+		return crypto.SHA384, true
+	}
+	return 0, false
+}
+
+func digest(h crypto.Hash, data []byte) []byte {
+	switch h {
+	case crypto.SHA1:
+		d := sha1.Sum(data)
+		return d[:]
+	case crypto.SHA256:
+		d := sha256.Sum256(data)
+		return d[:]
+	case crypto.SHA384:
+		d := sha512.Sum384(data)
+		return d[:]
+	case crypto.SHA512:
+		d := sha512.Sum512(data)
+		return d[:]
+	}
+	panic("unknown hash")
+}
+
+// the rsa public key in rfc3110 format
+func rsaKey(key []byte) (*rsa.PublicKey, error) {
+	if len(key) < 3 {
+		return nil, errBadKey
+	}
+	explen := int(key[0])
+	off := 1
+	if explen == 0 {
+		explen = int(key[1])<<8 | int(key[2])
+		off = 3
+	}
+	if explen > 4 || len(key) <= off+explen {
+		return nil, errBadKey
+	}
+	e := 0
+	for _, b := range key[off : off+explen] {
+		e = e<<8 | int(b)
+	}
+	n := new(big.Int).SetBytes(key[off+explen:])
+	return &rsa.PublicKey{N: n, E: e}, nil
+}
+
+// verifies a signature over data with a key
+func verifySig(alg uint8, key []byte, data, sig []byte) error {
+	if alg == ALG_ED25519 {
+		if len(key) != ed25519.PublicKeySize {
+			return errBadKey
+		}
+		if !ed25519.Verify(ed25519.PublicKey(key), data, sig) {
+			return errBadSig
+		}
+		return nil
+	}
+
+	h, ok := hashFor(alg)
+	if !ok {
+		return errUnknownAlg
+	}
+	d := digest(h, data)
+
+	switch alg {
+	case ALG_ECDSAP256SHA256, ALG_ECDSAP384SHA384:
+		curve := elliptic.P256()
+		if alg == ALG_ECDSAP384SHA384 {
+			curve = elliptic.P384()
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(key) != 2*size || len(sig) != 2*size {
+			return errBadKey
+		}
+		pub := &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(key[:size]),
+			Y:     new(big.Int).SetBytes(key[size:]),
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, d, r, s) {
+			return errBadSig
+		}
+		return nil
+	}
+
+	pub, err := rsaKey(key)
+	if err != nil {
+		return err
+	}
+	if rsa.VerifyPKCS1v15(pub, h, d, sig) != nil {
+		return errBadSig
+	}
+	return nil
+}
+
+// checks the validity period with serial number arithmetic
+func sigTimeValid(sig *RdRRSIG, now time.Time) bool {
+	t := uint32(now.Unix())
+	return !serialLess(t, sig.Inception) && !serialLess(sig.Expiration, t)
+}
+
+// verifies an rrset with one of its rrsigs that is made by one of the
+// keys of the signer zone
+func verifyRRset(rrs []*RR, sigs []*RdRRSIG, signer *Name,
+	keys []*RdDNSKEY, now time.Time) error {
+	if len(rrs) == 0 {
+		return errSigMismatch
+	}
+	if len(sigs) == 0 {
+		return errNoSig
+	}
+
+	err := errSigNoKey
+	for _, sig := range sigs {
+		if sig.TypeCovered != rrs[0].Type || !sig.Signer.Equal(signer) ||
+			int(sig.Labels) > rrs[0].Name.NumLabels() {
+			err = errSigMismatch
+			continue
+		}
+		if !sigTimeValid(sig, now) {
+			err = errSigTime
+			continue
+		}
+		for _, key := range keys {
+			if key.Algorithm != sig.Algorithm || key.KeyTag() != sig.KeyTag ||
+				key.Flags&DNSKEY_ZONE == 0 {
+				continue
+			}
+			e := verifySig(sig.Algorithm, key.PublicKey,
+				signedData(sig, rrs), sig.Signature)
+			if e == nil {
+				return nil
+			}
+			err = e
+		}
+	}
+	return err
+}
+
+// checks if a ds record is a digest of the key of the zone
+func dsMatch(zone *Name, key *RdDNSKEY, ds *RdDS) bool {
+	if ds.KeyTag != key.KeyTag() || ds.Algorithm != key.Algorithm {
+		return false
+	}
+	w := &writer{canonical: true}
+	w.writeName(zone)
+	key.writeTo(w)
+
+	var d []byte
+	switch ds.DigestType {
+	case DIGEST_SHA1:
+		d = digest(crypto.SHA1, w.wire())
+	case DIGEST_SHA256:
+		d = digest(crypto.SHA256, w.wire())
+	case DIGEST_SHA384:
+		d = digest(crypto.SHA384, w.wire())
+	default:
+		return false
+	}
+	return bytes.Equal(d, ds.Digest)
+}
+
+// an rrset with its signatures, taken from a message
+type signedSet struct {
+	rrs  []*RR
+	sigs []*RdRRSIG
+}
+
+// collects the rrset of a name and type in a section, with its rrsigs
+func findRRset(msg *Msg, name *Name, t uint16, section int) *signedSet {
+	ret := new(signedSet)
+	msg.ForEachIN(func(rr *RR, seg int) {
+		if seg != section || !rr.Name.Equal(name) {
+			return
+		}
+		if rr.Type == t {
+			ret.rrs = append(ret.rrs, rr)
+		} else if rr.Type == RRSIG {
+			sig := rr.Rdata.(*RdRRSIG)
+			if sig.TypeCovered == t {
+				ret.sigs = append(ret.sigs, sig)
+			}
+		}
+	})
+	return ret
+}
+
+// collects all signed rrsets of a type in a section
+func findRRsets(msg *Msg, t uint16, section int) []*signedSet {
+	names := make(map[string]*Name)
+	order := make([]*Name, 0, 4)
+	msg.ForEachIN(func(rr *RR, seg int) {
+		if seg == section && rr.Type == t && names[rr.Name.String()] == nil {
+			names[rr.Name.String()] = rr.Name
+			order = append(order, rr.Name)
+		}
+	})
+	ret := make([]*signedSet, len(order))
+	for i, n := range order {
+		ret[i] = findRRset(msg, n, t, section)
+	}
+	return ret
+}
+
+// true if name is strictly between owner and next in the canonical
+// order, considering the wrap around at the end of the zone
+func nsecCovers(owner, next, name *Name) bool {
+	if owner.Compare(next) < 0 {
+		return owner.Compare(name) < 0 && name.Compare(next) < 0
+	}
+	// the last nsec in the zone
+	return owner.Compare(name) < 0 || name.Compare(next) < 0
+}
+
+// the nsec3 hash of a name, rfc5155 section 5
+func nsec3Hash(name *Name, alg uint8, salt []byte, iterations uint16) (
+	[]byte, error) {
+	if alg != 1 {
+		return nil, errUnknownAlg
+	}
+	w := &writer{canonical: true}
+	w.writeName(name)
+	h := sha1.Sum(append(w.wire(), salt...))
+	for i := 0; i < int(iterations); i++ {
+		h = sha1.Sum(append(h[:], salt...))
+	}
+	return h[:], nil
+}
+
+// the hashed owner label of an nsec3 record
+func nsec3Label(hash []byte) string {
+	return strings.ToLower(base32Hex.EncodeToString(hash))
+}
+
+// decodes the hash in the first label of an nsec3 owner name
+func nsec3OwnerHash(owner *Name) ([]byte, error) {
+	if owner.NumLabels() == 0 {
+		return nil, errBadKey
+	}
+	ret, err := base32Hex.DecodeString(strings.ToUpper(owner.Label(0)))
+	if err != nil {
+		return nil, fmt.Errorf("bad nsec3 owner %s", owner)
+	}
+	return ret, nil
+}
+
+// true if hash is strictly between owner and next, with wrap around
+func nsec3Covers(owner, next, hash []byte) bool {
+	if bytes.Compare(owner, next) < 0 {
+		return bytes.Compare(owner, hash) < 0 && bytes.Compare(hash, next) < 0
+	}
+	return bytes.Compare(owner, hash) < 0 || bytes.Compare(hash, next) < 0
+}
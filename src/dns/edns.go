@@ -0,0 +1,81 @@
+package dns
+
+import (
+	"time"
+)
+
+// edns0, see rfc6891
+
+const OPT = 41
+
+// flags in the ttl of an opt record
+const EDNS_DO = 0x1 << 15 // dnssec ok
+
+// the options for making a query
+type QueryOptions struct {
+	Recursion bool          // set F_RD
+	EDNSSize  uint16        // 0 for not using edns
+	DNSSEC    bool          // set the do bit, requires edns
+	TSIG      *TsigKey      // sign the query and verify the response
+	Port      uint16        // 0 for DNS_PORT
+	Timeout   time.Duration // 0 for the default
+}
+
+// the default time to wait for a response
+const _QUERY_TIMEOUT = 5 * time.Second
+
+func (o *QueryOptions) port() int {
+	if o == nil || o.Port == 0 {
+		return DNS_PORT
+	}
+	return int(o.Port)
+}
+
+func (o *QueryOptions) timeout() time.Duration {
+	if o == nil || o.Timeout == 0 {
+		return _QUERY_TIMEOUT
+	}
+	return o.Timeout
+}
+
+func (o *QueryOptions) newQuery(n *Name, t uint16) *Msg {
+	ret := NewQuery(n, t)
+	if o == nil {
+		return ret
+	}
+	if o.Recursion {
+		ret.Flags |= F_RD
+	}
+	if o.EDNSSize > 0 {
+		ret.SetEDNS(o.EDNSSize, o.DNSSEC)
+	}
+	return ret
+}
+
+// adds or replaces the opt record in the additional section
+func (m *Msg) SetEDNS(size uint16, do bool) {
+	var ttl uint32
+	if do {
+		ttl |= EDNS_DO
+	}
+	opt := RR{rootName, OPT, size, ttl, &RdBytes{[]byte{}}}
+
+	for i := range m.Addi {
+		if m.Addi[i].Type == OPT {
+			m.Addi[i] = opt
+			return
+		}
+	}
+	m.Addi = append(m.Addi, opt)
+}
+
+// returns the udp size and the do bit of the opt record
+// ok is false if there is no opt record
+func (m *Msg) EDNS() (size uint16, do bool, ok bool) {
+	for _, rr := range m.Addi {
+		if rr.Type == OPT {
+			return rr.Class, rr.TTL&EDNS_DO != 0, true
+		}
+	}
+	return 0, false, false
+}
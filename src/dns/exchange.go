@@ -0,0 +1,141 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// a single query and response exchange with a server, over udp with a
+// fallback to tcp for truncated responses, or over tcp only
+
+var errExchangeReply = errors.New("exchange: not a response to the query")
+
+// default timeout for one exchange
+const _EXCHANGE_TIMEOUT = 5 * time.Second
+
+type Exchanger struct {
+	Host    *IPv4
+	Port    uint16
+	Timeout time.Duration
+	TCP     bool     // always use tcp, else only when truncated
+	TSIG    *TsigKey // nil for not signing
+}
+
+func NewExchanger(host *IPv4) *Exchanger {
+	return &Exchanger{Host: host, Port: DNS_PORT, Timeout: _EXCHANGE_TIMEOUT}
+}
+
+func (c *Exchanger) exchangeUDP(wire []byte, id uint16) ([]byte, error) {
+	addr := net.JoinHostPort(c.Host.String(), fmt.Sprintf("%d", c.Port))
+	conn, err := net.DialTimeout("udp", addr, c.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(c.Timeout))
+	if _, err = conn.Write(wire); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 65536)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		if n >= 2 && uint16(buf[0])<<8|uint16(buf[1]) == id {
+			return buf[:n], nil
+		}
+	}
+}
+
+func (c *Exchanger) exchangeTCP(wire []byte) ([]byte, error) {
+	conn, err := dialTCP(c.Host, c.Port, c.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err = conn.send(wire); err != nil {
+		return nil, err
+	}
+	return conn.recv()
+}
+
+// an error response to a signed message can be unsigned, when the
+// server does not know the key or the signature is bad
+func (c *Exchanger) parse(buf []byte, mac []byte) (*Msg, error) {
+	if c.TSIG == nil {
+		return ParseMsg(buf)
+	}
+	msg, _, err := VerifyTSIG(buf, c.TSIG, mac)
+	if err == errTsigMissing {
+		m, e := ParseMsg(buf)
+		if e == nil && (m.Flags&F_RCODEMASK) != RCODE_OKAY {
+			return m, nil
+		}
+	}
+	return msg, err
+}
+
+// sends the message and waits for the response, which is returned
+// whatever its rcode
+func (c *Exchanger) Exchange(m *Msg) (*Msg, error) {
+	var wire, mac []byte
+	var err error
+	if c.TSIG != nil {
+		wire, mac, err = m.WireTSIG(c.TSIG, nil)
+	} else {
+		wire, err = m.Wire()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var msg *Msg
+	if !c.TCP {
+		buf, err := c.exchangeUDP(wire, m.ID)
+		if err != nil {
+			return nil, err
+		}
+		if msg, err = c.parse(buf, mac); err != nil {
+			return nil, err
+		}
+		if (msg.Flags & F_TC) == F_TC {
+			msg = nil // retry over tcp
+		}
+	}
+	if msg == nil {
+		buf, err := c.exchangeTCP(wire)
+		if err != nil {
+			return nil, err
+		}
+		if msg, err = c.parse(buf, mac); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.ID != m.ID || (msg.Flags&F_RESPONSE) == 0 {
+		return nil, errExchangeReply
+	}
+	return msg, nil
+}
+
+// asks again over tcp, for a response to a query sent over a Conn that
+// came back truncated
+func queryTCP(h *IPv4, n *Name, t uint16, opts *QueryOptions) (*Response,
+	error) {
+	c := NewExchanger(h)
+	c.Port = uint16(opts.port())
+	c.Timeout = opts.timeout()
+	c.TCP = true
+	if opts != nil {
+		c.TSIG = opts.TSIG
+	}
+	msg, err := c.Exchange(opts.newQuery(n, t))
+	if err != nil {
+		return nil, err
+	}
+	return &Response{msg, h, c.Port, time.Now()}, nil
+}
@@ -0,0 +1,363 @@
+package dns
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// idna2008 support for names, rfc5891 to rfc5893
+// the only mapping is lower casing; a label must be in nfc, and each of
+// its code points must be PVALID or pass its CONTEXTJ or CONTEXTO rule,
+// so that compatibility forms such as full width letters are rejected
+// rather than mapped; the tables are in idna_table.go
+
+const _ACE_PREFIX = "xn--"
+
+// the canonical combining class of viramas
+const _VIRAMA = 9
+
+// label separators that are mapped to '.'
+var idnaDots = strings.NewReplacer("。", ".", "．", ".", "｡", ".")
+
+func idnaError(label, s string) error {
+	return &nameError{label, "idna: " + s}
+}
+
+// converts a unicode label to its xn-- form
+func idnaLabel(label string) (string, error) {
+	if !utf8.ValidString(label) {
+		return "", idnaError(label, "invalid utf-8")
+	}
+
+	// an upper case code point must come back from its lower case, which
+	// rejects the forms that only map to a letter, like the kelvin sign
+	runes := []rune(label)
+	for i, r := range runes {
+		lower := unicode.ToLower(r)
+		if lower != r && unicode.ToUpper(lower) != r {
+			return "", idnaError(label, "disallowed character")
+		}
+		runes[i] = lower
+	}
+	mapped := string(runes)
+
+	ascii := true
+	for i, r := range runes {
+		switch {
+		case r < 0x80:
+			if !(r == '-' || r == '_' || isDigit(byte(r)) ||
+				('a' <= r && r <= 'z')) {
+				return "", idnaError(label, "disallowed character")
+			}
+		case unicode.Is(idnaValid, r):
+			ascii = false
+		case r == 0x200c || r == 0x200d:
+			if !idnaContextJ(runes, i) {
+				return "", idnaError(label, "joiner out of context")
+			}
+			ascii = false
+		case idnaIsContextO(r):
+			if !idnaContextO(runes, i) {
+				return "", idnaError(label,
+					fmt.Sprintf("U+%04X out of context", r))
+			}
+			ascii = false
+		default:
+			return "", idnaError(label, "disallowed character")
+		}
+	}
+	if ascii {
+		return mapped, nil
+	}
+
+	if strings.ContainsRune(mapped, '_') {
+		return "", idnaError(label, "disallowed character")
+	}
+	if strings.HasPrefix(mapped, "-") || strings.HasSuffix(mapped, "-") {
+		return "", idnaError(label, "hyphen at the start or the end")
+	}
+	if len(mapped) >= 4 && mapped[2:4] == "--" {
+		return "", idnaError(label, "hyphens at the 3rd and 4th places")
+	}
+	if unicode.IsMark(runes[0]) {
+		return "", idnaError(label, "starts with a combining mark")
+	}
+	if !idnaIsNFC(runes) {
+		return "", idnaError(label, "not in nfc")
+	}
+	if idnaHasRTL(runes) && !idnaBidiRule(runes) {
+		return "", idnaError(label, "breaks the bidi rule")
+	}
+
+	encoded, err := punyEncode(mapped)
+	if err != nil {
+		return "", idnaError(label, err.Error())
+	}
+	return _ACE_PREFIX + encoded, nil
+}
+
+// the zero width non-joiner and joiner rules, rfc5892 appendix a.1 and
+// a.2
+func idnaContextJ(rs []rune, i int) bool {
+	if i > 0 && idnaCombiningClass(rs[i-1]) == _VIRAMA {
+		return true
+	}
+	if rs[i] == 0x200d {
+		return false
+	}
+	// a non-joiner between two joining letters, with transparent ones
+	// skipped
+	j := i - 1
+	for j >= 0 && unicode.Is(idnaJoinT, rs[j]) {
+		j--
+	}
+	if j < 0 || !unicode.Is(idnaJoinLD, rs[j]) {
+		return false
+	}
+	k := i + 1
+	for k < len(rs) && unicode.Is(idnaJoinT, rs[k]) {
+		k++
+	}
+	return k < len(rs) && unicode.Is(idnaJoinRD, rs[k])
+}
+
+func idnaIsContextO(r rune) bool {
+	return r == 0xb7 || r == 0x375 || r == 0x5f3 || r == 0x5f4 ||
+		r == 0x30fb || (0x660 <= r && r <= 0x669) ||
+		(0x6f0 <= r && r <= 0x6f9)
+}
+
+// the rules of the CONTEXTO code points, rfc5892 appendix a.3 to a.9
+func idnaContextO(rs []rune, i int) bool {
+	r := rs[i]
+	switch {
+	case r == 0xb7: // middle dot, only in l·l
+		return i > 0 && i+1 < len(rs) && rs[i-1] == 'l' && rs[i+1] == 'l'
+	case r == 0x375: // greek lower numeral sign
+		return i+1 < len(rs) && unicode.Is(unicode.Greek, rs[i+1])
+	case r == 0x5f3 || r == 0x5f4: // hebrew geresh and gershayim
+		return i > 0 && unicode.Is(unicode.Hebrew, rs[i-1])
+	case r == 0x30fb: // katakana middle dot
+		for _, c := range rs {
+			if unicode.In(c, unicode.Hiragana, unicode.Katakana, unicode.Han) {
+				return true
+			}
+		}
+		return false
+	case 0x660 <= r && r <= 0x669: // arabic-indic digits
+		for _, c := range rs {
+			if 0x6f0 <= c && c <= 0x6f9 {
+				return false
+			}
+		}
+		return true
+	case 0x6f0 <= r && r <= 0x6f9: // extended arabic-indic digits
+		for _, c := range rs {
+			if 0x660 <= c && c <= 0x669 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// a range of code points of one canonical combining class
+type idnaClassRange struct {
+	lo, hi rune
+	class  uint8
+}
+
+func idnaCombiningClass(r rune) uint8 {
+	i := sort.Search(len(idnaCombining), func(i int) bool {
+		return idnaCombining[i].hi >= r
+	})
+	if i < len(idnaCombining) && idnaCombining[i].lo <= r {
+		return idnaCombining[i].class
+	}
+	return 0
+}
+
+// checks that the code points, which are all allowed ones, do not change
+// under nfc
+func idnaIsNFC(rs []rune) bool {
+	d := make([]rune, 0, 2*len(rs))
+	for _, r := range rs {
+		if s, ok := idnaDecomp[r]; ok {
+			d = append(d, []rune(s)...)
+		} else {
+			d = append(d, r)
+		}
+	}
+
+	// the canonical ordering of the marks
+	for i := 1; i < len(d); i++ {
+		for j := i; j > 0; j-- {
+			c := idnaCombiningClass(d[j])
+			if c == 0 || idnaCombiningClass(d[j-1]) <= c {
+				break
+			}
+			d[j-1], d[j] = d[j], d[j-1]
+		}
+	}
+
+	// the canonical composition; a mark is blocked from the starter by
+	// a starter or a mark of the same or a higher class between them
+	out := make([]rune, 0, len(d))
+	starter := -1
+	last := -1 // the class of the last one after the starter, -1 if none
+	for _, r := range d {
+		c := int(idnaCombiningClass(r))
+		if starter >= 0 && (last == -1 || (last != 0 && last < c)) {
+			if comp, ok := idnaCompose[[2]rune{out[starter], r}]; ok {
+				out[starter] = comp
+				continue
+			}
+		}
+		if c == 0 {
+			starter, last = len(out), -1
+		} else {
+			last = c
+		}
+		out = append(out, r)
+	}
+
+	if len(out) != len(rs) {
+		return false
+	}
+	for i := range out {
+		if out[i] != rs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// true if the label has right to left characters, rfc5893 section 1.4
+func idnaHasRTL(rs []rune) bool {
+	for _, r := range rs {
+		if unicode.Is(idnaBidiRTL, r) || unicode.Is(idnaBidiAN, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// the bidi rule, rfc5893 section 2, for every label of a name that has
+// a right to left label
+func idnaBidiRule(rs []rune) bool {
+	if len(rs) == 0 {
+		return false
+	}
+	rtl := unicode.Is(idnaBidiRTL, rs[0])
+	if !rtl && !unicode.Is(idnaBidiL, rs[0]) {
+		return false
+	}
+
+	hasEN, hasAN := false, false
+	for _, r := range rs {
+		switch {
+		case unicode.Is(idnaBidiEN, r):
+			hasEN = true
+		case unicode.Is(idnaBidiAN, r):
+			hasAN = true
+			if !rtl {
+				return false
+			}
+		case unicode.Is(idnaBidiRTL, r):
+			if !rtl {
+				return false
+			}
+		case unicode.Is(idnaBidiL, r):
+			if rtl {
+				return false
+			}
+		case unicode.Is(idnaBidiNSM, r), unicode.Is(idnaBidiON, r):
+		default:
+			return false
+		}
+	}
+
+	end := len(rs) - 1
+	for end > 0 && unicode.Is(idnaBidiNSM, rs[end]) {
+		end--
+	}
+	last := rs[end]
+	if rtl {
+		return !(hasEN && hasAN) && (unicode.Is(idnaBidiRTL, last) ||
+			unicode.Is(idnaBidiEN, last) || unicode.Is(idnaBidiAN, last))
+	}
+	return unicode.Is(idnaBidiL, last) || unicode.Is(idnaBidiEN, last)
+}
+
+// checks the bidi rule on all labels of a name that has a right to left
+// label
+func idnaBidiName(labels []string) error {
+	for _, lab := range labels {
+		u, ok := unicodeLabel(lab)
+		if !ok {
+			u = lab
+		}
+		if !idnaBidiRule([]rune(u)) {
+			return idnaError(lab, "breaks the bidi rule")
+		}
+	}
+	return nil
+}
+
+// converts a xn-- label back to unicode
+// returns false if the label is not a valid xn-- label
+func unicodeLabel(label string) (string, bool) {
+	if len(label) <= len(_ACE_PREFIX) ||
+		lowerLabel(label[:len(_ACE_PREFIX)]) != _ACE_PREFIX {
+		return "", false
+	}
+	ret, err := punyDecode(label[len(_ACE_PREFIX):])
+	if err != nil {
+		return "", false
+	}
+	// must round trip
+	back, err := idnaLabel(ret)
+	if err != nil || back != lowerLabel(label) {
+		return "", false
+	}
+	return ret, true
+}
+
+// converts a unicode domain name to the ascii form with xn-- labels
+func ToASCII(s string) (string, error) {
+	n, err := NewName(s)
+	if err != nil {
+		return "", err
+	}
+	return n.String(), nil
+}
+
+// the name for display, with xn-- labels shown in unicode
+func (n *Name) Unicode() string {
+	if n.IsRoot() {
+		return "."
+	}
+	labels := make([]string, len(n.labels))
+	for i, lab := range n.labels {
+		u, ok := unicodeLabel(lab)
+		if ok {
+			labels[i] = u
+		} else {
+			labels[i] = escapeLabel(lab)
+		}
+	}
+	return strings.Join(labels, ".")
+}
+
+// converts a domain name with xn-- labels to unicode for display
+func ToUnicode(s string) (string, error) {
+	n, err := NewName(s)
+	if err != nil {
+		return "", err
+	}
+	return n.Unicode(), nil
+}
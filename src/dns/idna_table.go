@@ -0,0 +1,3534 @@
+package dns
+
+import "unicode"
+
+// the idna2008 tables for idna.go, generated from the derived properties
+// of rfc5892 and the unicode 14.0.0 character database; do not edit
+
+// the non-ascii code points that are PVALID
+var idnaValid = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00df, 0x00f6, 1},
+		{0x00f8, 0x00ff, 1},
+		{0x0101, 0x0131, 2},
+		{0x0135, 0x0137, 2},
+		{0x0138, 0x013e, 2},
+		{0x0142, 0x0148, 2},
+		{0x014b, 0x0177, 2},
+		{0x017a, 0x0180, 2},
+		{0x0183, 0x0185, 2},
+		{0x0188, 0x018c, 4},
+		{0x018d, 0x0192, 5},
+		{0x0195, 0x0199, 4},
+		{0x019a, 0x019b, 1},
+		{0x019e, 0x01a1, 3},
+		{0x01a3, 0x01a5, 2},
+		{0x01a8, 0x01aa, 2},
+		{0x01ab, 0x01ad, 2},
+		{0x01b0, 0x01b4, 4},
+		{0x01b6, 0x01b9, 3},
+		{0x01ba, 0x01bb, 1},
+		{0x01bd, 0x01c3, 1},
+		{0x01ce, 0x01dc, 2},
+		{0x01dd, 0x01ef, 2},
+		{0x01f0, 0x01f5, 5},
+		{0x01f9, 0x0233, 2},
+		{0x0234, 0x0239, 1},
+		{0x023c, 0x023f, 3},
+		{0x0240, 0x0242, 2},
+		{0x0247, 0x024f, 2},
+		{0x0250, 0x02af, 1},
+		{0x02b9, 0x02c1, 1},
+		{0x02c6, 0x02d1, 1},
+		{0x02ec, 0x02ee, 2},
+		{0x0300, 0x033f, 1},
+		{0x0342, 0x0346, 4},
+		{0x0347, 0x034e, 1},
+		{0x0350, 0x036f, 1},
+		{0x0371, 0x0373, 2},
+		{0x0377, 0x037b, 4},
+		{0x037c, 0x037d, 1},
+		{0x0390, 0x03ac, 28},
+		{0x03ad, 0x03ce, 1},
+		{0x03d7, 0x03ef, 2},
+		{0x03f3, 0x03f8, 5},
+		{0x03fb, 0x03fc, 1},
+		{0x0430, 0x045f, 1},
+		{0x0461, 0x0483, 2},
+		{0x0484, 0x0487, 1},
+		{0x048b, 0x04bf, 2},
+		{0x04c2, 0x04ce, 2},
+		{0x04cf, 0x052f, 2},
+		{0x0559, 0x0560, 7},
+		{0x0561, 0x0586, 1},
+		{0x0588, 0x0591, 9},
+		{0x0592, 0x05bd, 1},
+		{0x05bf, 0x05c1, 2},
+		{0x05c2, 0x05c4, 2},
+		{0x05c5, 0x05c7, 2},
+		{0x05d0, 0x05ea, 1},
+		{0x05ef, 0x05f2, 1},
+		{0x0610, 0x061a, 1},
+		{0x0620, 0x063f, 1},
+		{0x0641, 0x065f, 1},
+		{0x066e, 0x0674, 1},
+		{0x0679, 0x06d3, 1},
+		{0x06d5, 0x06dc, 1},
+		{0x06df, 0x06e8, 1},
+		{0x06ea, 0x06ef, 1},
+		{0x06fa, 0x06ff, 1},
+		{0x0710, 0x074a, 1},
+		{0x074d, 0x07b1, 1},
+		{0x07c0, 0x07f5, 1},
+		{0x07fd, 0x0800, 3},
+		{0x0801, 0x082d, 1},
+		{0x0840, 0x085b, 1},
+		{0x0860, 0x086a, 1},
+		{0x0870, 0x0887, 1},
+		{0x0889, 0x088e, 1},
+		{0x0898, 0x08e1, 1},
+		{0x08e3, 0x0957, 1},
+		{0x0960, 0x0963, 1},
+		{0x0966, 0x096f, 1},
+		{0x0971, 0x0983, 1},
+		{0x0985, 0x098c, 1},
+		{0x098f, 0x0990, 1},
+		{0x0993, 0x09a8, 1},
+		{0x09aa, 0x09b0, 1},
+		{0x09b2, 0x09b6, 4},
+		{0x09b7, 0x09b9, 1},
+		{0x09bc, 0x09c4, 1},
+		{0x09c7, 0x09c8, 1},
+		{0x09cb, 0x09ce, 1},
+		{0x09d7, 0x09e0, 9},
+		{0x09e1, 0x09e3, 1},
+		{0x09e6, 0x09f1, 1},
+		{0x09fc, 0x09fe, 2},
+		{0x0a01, 0x0a03, 1},
+		{0x0a05, 0x0a0a, 1},
+		{0x0a0f, 0x0a10, 1},
+		{0x0a13, 0x0a28, 1},
+		{0x0a2a, 0x0a30, 1},
+		{0x0a32, 0x0a38, 3},
+		{0x0a39, 0x0a3c, 3},
+		{0x0a3e, 0x0a42, 1},
+		{0x0a47, 0x0a48, 1},
+		{0x0a4b, 0x0a4d, 1},
+		{0x0a51, 0x0a5c, 11},
+		{0x0a66, 0x0a75, 1},
+		{0x0a81, 0x0a83, 1},
+		{0x0a85, 0x0a8d, 1},
+		{0x0a8f, 0x0a91, 1},
+		{0x0a93, 0x0aa8, 1},
+		{0x0aaa, 0x0ab0, 1},
+		{0x0ab2, 0x0ab3, 1},
+		{0x0ab5, 0x0ab9, 1},
+		{0x0abc, 0x0ac5, 1},
+		{0x0ac7, 0x0ac9, 1},
+		{0x0acb, 0x0acd, 1},
+		{0x0ad0, 0x0ae0, 16},
+		{0x0ae1, 0x0ae3, 1},
+		{0x0ae6, 0x0aef, 1},
+		{0x0af9, 0x0aff, 1},
+		{0x0b01, 0x0b03, 1},
+		{0x0b05, 0x0b0c, 1},
+		{0x0b0f, 0x0b10, 1},
+		{0x0b13, 0x0b28, 1},
+		{0x0b2a, 0x0b30, 1},
+		{0x0b32, 0x0b33, 1},
+		{0x0b35, 0x0b39, 1},
+		{0x0b3c, 0x0b44, 1},
+		{0x0b47, 0x0b48, 1},
+		{0x0b4b, 0x0b4d, 1},
+		{0x0b55, 0x0b57, 1},
+		{0x0b5f, 0x0b63, 1},
+		{0x0b66, 0x0b6f, 1},
+		{0x0b71, 0x0b82, 17},
+		{0x0b83, 0x0b85, 2},
+		{0x0b86, 0x0b8a, 1},
+		{0x0b8e, 0x0b90, 1},
+		{0x0b92, 0x0b95, 1},
+		{0x0b99, 0x0b9a, 1},
+		{0x0b9c, 0x0b9e, 2},
+		{0x0b9f, 0x0ba3, 4},
+		{0x0ba4, 0x0ba8, 4},
+		{0x0ba9, 0x0baa, 1},
+		{0x0bae, 0x0bb9, 1},
+		{0x0bbe, 0x0bc2, 1},
+		{0x0bc6, 0x0bc8, 1},
+		{0x0bca, 0x0bcd, 1},
+		{0x0bd0, 0x0bd7, 7},
+		{0x0be6, 0x0bef, 1},
+		{0x0c00, 0x0c0c, 1},
+		{0x0c0e, 0x0c10, 1},
+		{0x0c12, 0x0c28, 1},
+		{0x0c2a, 0x0c39, 1},
+		{0x0c3c, 0x0c44, 1},
+		{0x0c46, 0x0c48, 1},
+		{0x0c4a, 0x0c4d, 1},
+		{0x0c55, 0x0c56, 1},
+		{0x0c58, 0x0c5a, 1},
+		{0x0c5d, 0x0c60, 3},
+		{0x0c61, 0x0c63, 1},
+		{0x0c66, 0x0c6f, 1},
+		{0x0c80, 0x0c83, 1},
+		{0x0c85, 0x0c8c, 1},
+		{0x0c8e, 0x0c90, 1},
+		{0x0c92, 0x0ca8, 1},
+		{0x0caa, 0x0cb3, 1},
+		{0x0cb5, 0x0cb9, 1},
+		{0x0cbc, 0x0cc4, 1},
+		{0x0cc6, 0x0cc8, 1},
+		{0x0cca, 0x0ccd, 1},
+		{0x0cd5, 0x0cd6, 1},
+		{0x0cdd, 0x0cde, 1},
+		{0x0ce0, 0x0ce3, 1},
+		{0x0ce6, 0x0cef, 1},
+		{0x0cf1, 0x0cf2, 1},
+		{0x0d00, 0x0d0c, 1},
+		{0x0d0e, 0x0d10, 1},
+		{0x0d12, 0x0d44, 1},
+		{0x0d46, 0x0d48, 1},
+		{0x0d4a, 0x0d4e, 1},
+		{0x0d54, 0x0d57, 1},
+		{0x0d5f, 0x0d63, 1},
+		{0x0d66, 0x0d6f, 1},
+		{0x0d7a, 0x0d7f, 1},
+		{0x0d81, 0x0d83, 1},
+		{0x0d85, 0x0d96, 1},
+		{0x0d9a, 0x0db1, 1},
+		{0x0db3, 0x0dbb, 1},
+		{0x0dbd, 0x0dc0, 3},
+		{0x0dc1, 0x0dc6, 1},
+		{0x0dca, 0x0dcf, 5},
+		{0x0dd0, 0x0dd4, 1},
+		{0x0dd6, 0x0dd8, 2},
+		{0x0dd9, 0x0ddf, 1},
+		{0x0de6, 0x0def, 1},
+		{0x0df2, 0x0df3, 1},
+		{0x0e01, 0x0e32, 1},
+		{0x0e34, 0x0e3a, 1},
+		{0x0e40, 0x0e4e, 1},
+		{0x0e50, 0x0e59, 1},
+		{0x0e81, 0x0e82, 1},
+		{0x0e84, 0x0e86, 2},
+		{0x0e87, 0x0e8a, 1},
+		{0x0e8c, 0x0ea3, 1},
+		{0x0ea5, 0x0ea7, 2},
+		{0x0ea8, 0x0eb2, 1},
+		{0x0eb4, 0x0ebd, 1},
+		{0x0ec0, 0x0ec4, 1},
+		{0x0ec6, 0x0ec8, 2},
+		{0x0ec9, 0x0ecd, 1},
+		{0x0ed0, 0x0ed9, 1},
+		{0x0ede, 0x0edf, 1},
+		{0x0f00, 0x0f0b, 11},
+		{0x0f18, 0x0f19, 1},
+		{0x0f20, 0x0f29, 1},
+		{0x0f35, 0x0f39, 2},
+		{0x0f3e, 0x0f42, 1},
+		{0x0f44, 0x0f47, 1},
+		{0x0f49, 0x0f4c, 1},
+		{0x0f4e, 0x0f51, 1},
+		{0x0f53, 0x0f56, 1},
+		{0x0f58, 0x0f5b, 1},
+		{0x0f5d, 0x0f68, 1},
+		{0x0f6a, 0x0f6c, 1},
+		{0x0f71, 0x0f72, 1},
+		{0x0f74, 0x0f7a, 6},
+		{0x0f7b, 0x0f80, 1},
+		{0x0f82, 0x0f84, 1},
+		{0x0f86, 0x0f92, 1},
+		{0x0f94, 0x0f97, 1},
+		{0x0f99, 0x0f9c, 1},
+		{0x0f9e, 0x0fa1, 1},
+		{0x0fa3, 0x0fa6, 1},
+		{0x0fa8, 0x0fab, 1},
+		{0x0fad, 0x0fb8, 1},
+		{0x0fba, 0x0fbc, 1},
+		{0x0fc6, 0x1000, 58},
+		{0x1001, 0x1049, 1},
+		{0x1050, 0x109d, 1},
+		{0x10d0, 0x10fa, 1},
+		{0x10fd, 0x10ff, 1},
+		{0x1200, 0x1248, 1},
+		{0x124a, 0x124d, 1},
+		{0x1250, 0x1256, 1},
+		{0x1258, 0x125a, 2},
+		{0x125b, 0x125d, 1},
+		{0x1260, 0x1288, 1},
+		{0x128a, 0x128d, 1},
+		{0x1290, 0x12b0, 1},
+		{0x12b2, 0x12b5, 1},
+		{0x12b8, 0x12be, 1},
+		{0x12c0, 0x12c2, 2},
+		{0x12c3, 0x12c5, 1},
+		{0x12c8, 0x12d6, 1},
+		{0x12d8, 0x1310, 1},
+		{0x1312, 0x1315, 1},
+		{0x1318, 0x135a, 1},
+		{0x135d, 0x135f, 1},
+		{0x1380, 0x138f, 1},
+		{0x13a0, 0x13f5, 1},
+		{0x1401, 0x166c, 1},
+		{0x166f, 0x167f, 1},
+		{0x1681, 0x169a, 1},
+		{0x16a0, 0x16ea, 1},
+		{0x16f1, 0x16f8, 1},
+		{0x1700, 0x1715, 1},
+		{0x171f, 0x1734, 1},
+		{0x1740, 0x1753, 1},
+		{0x1760, 0x176c, 1},
+		{0x176e, 0x1770, 1},
+		{0x1772, 0x1773, 1},
+		{0x1780, 0x17b3, 1},
+		{0x17b6, 0x17d3, 1},
+		{0x17d7, 0x17dc, 5},
+		{0x17dd, 0x17e0, 3},
+		{0x17e1, 0x17e9, 1},
+		{0x1810, 0x1819, 1},
+		{0x1820, 0x1878, 1},
+		{0x1880, 0x18aa, 1},
+		{0x18b0, 0x18f5, 1},
+		{0x1900, 0x191e, 1},
+		{0x1920, 0x192b, 1},
+		{0x1930, 0x193b, 1},
+		{0x1946, 0x196d, 1},
+		{0x1970, 0x1974, 1},
+		{0x1980, 0x19ab, 1},
+		{0x19b0, 0x19c9, 1},
+		{0x19d0, 0x19d9, 1},
+		{0x1a00, 0x1a1b, 1},
+		{0x1a20, 0x1a5e, 1},
+		{0x1a60, 0x1a7c, 1},
+		{0x1a7f, 0x1a89, 1},
+		{0x1a90, 0x1a99, 1},
+		{0x1aa7, 0x1ab0, 9},
+		{0x1ab1, 0x1abd, 1},
+		{0x1abf, 0x1ace, 1},
+		{0x1b00, 0x1b4c, 1},
+		{0x1b50, 0x1b59, 1},
+		{0x1b6b, 0x1b73, 1},
+		{0x1b80, 0x1bf3, 1},
+		{0x1c00, 0x1c37, 1},
+		{0x1c40, 0x1c49, 1},
+		{0x1c4d, 0x1c7d, 1},
+		{0x1cd0, 0x1cd2, 1},
+		{0x1cd4, 0x1cfa, 1},
+		{0x1d00, 0x1d2b, 1},
+		{0x1d2f, 0x1d3b, 12},
+		{0x1d4e, 0x1d6b, 29},
+		{0x1d6c, 0x1d77, 1},
+		{0x1d79, 0x1d9a, 1},
+		{0x1dc0, 0x1dff, 1},
+		{0x1e01, 0x1e95, 2},
+		{0x1e96, 0x1e99, 1},
+		{0x1e9c, 0x1e9d, 1},
+		{0x1e9f, 0x1eff, 2},
+		{0x1f00, 0x1f07, 1},
+		{0x1f10, 0x1f15, 1},
+		{0x1f20, 0x1f27, 1},
+		{0x1f30, 0x1f37, 1},
+		{0x1f40, 0x1f45, 1},
+		{0x1f50, 0x1f57, 1},
+		{0x1f60, 0x1f67, 1},
+		{0x1f70, 0x1f7c, 2},
+		{0x1fb0, 0x1fb1, 1},
+		{0x1fb6, 0x1fc6, 16},
+		{0x1fd0, 0x1fd2, 1},
+		{0x1fd6, 0x1fd7, 1},
+		{0x1fe0, 0x1fe2, 1},
+		{0x1fe4, 0x1fe7, 1},
+		{0x1ff6, 0x1ff6, 1},
+		{0x214e, 0x2184, 54},
+		{0x2c30, 0x2c5f, 1},
+		{0x2c61, 0x2c65, 4},
+		{0x2c66, 0x2c6c, 2},
+		{0x2c71, 0x2c73, 2},
+		{0x2c74, 0x2c76, 2},
+		{0x2c77, 0x2c7b, 1},
+		{0x2c81, 0x2ce3, 2},
+		{0x2ce4, 0x2cec, 8},
+		{0x2cee, 0x2cf1, 1},
+		{0x2cf3, 0x2d00, 13},
+		{0x2d01, 0x2d25, 1},
+		{0x2d27, 0x2d2d, 6},
+		{0x2d30, 0x2d67, 1},
+		{0x2d7f, 0x2d96, 1},
+		{0x2da0, 0x2da6, 1},
+		{0x2da8, 0x2dae, 1},
+		{0x2db0, 0x2db6, 1},
+		{0x2db8, 0x2dbe, 1},
+		{0x2dc0, 0x2dc6, 1},
+		{0x2dc8, 0x2dce, 1},
+		{0x2dd0, 0x2dd6, 1},
+		{0x2dd8, 0x2dde, 1},
+		{0x2de0, 0x2dff, 1},
+		{0x2e2f, 0x2e2f, 1},
+		{0x3005, 0x3007, 1},
+		{0x302a, 0x302d, 1},
+		{0x303c, 0x3041, 5},
+		{0x3042, 0x3096, 1},
+		{0x3099, 0x309a, 1},
+		{0x309d, 0x309e, 1},
+		{0x30a1, 0x30fa, 1},
+		{0x30fc, 0x30fe, 1},
+		{0x3105, 0x312f, 1},
+		{0x31a0, 0x31bf, 1},
+		{0x31f0, 0x31ff, 1},
+		{0x3400, 0x4dbf, 1},
+		{0x4e00, 0xa48c, 1},
+		{0xa4d0, 0xa4fd, 1},
+		{0xa500, 0xa60c, 1},
+		{0xa610, 0xa62b, 1},
+		{0xa641, 0xa66d, 2},
+		{0xa66e, 0xa66f, 1},
+		{0xa674, 0xa67d, 1},
+		{0xa67f, 0xa69b, 2},
+		{0xa69e, 0xa6e5, 1},
+		{0xa6f0, 0xa6f1, 1},
+		{0xa717, 0xa71f, 1},
+		{0xa723, 0xa72f, 2},
+		{0xa730, 0xa731, 1},
+		{0xa733, 0xa771, 2},
+		{0xa772, 0xa778, 1},
+		{0xa77a, 0xa77c, 2},
+		{0xa77f, 0xa787, 2},
+		{0xa788, 0xa78c, 4},
+		{0xa78e, 0xa78f, 1},
+		{0xa791, 0xa793, 2},
+		{0xa794, 0xa795, 1},
+		{0xa797, 0xa7a9, 2},
+		{0xa7af, 0xa7b5, 6},
+		{0xa7b7, 0xa7c3, 2},
+		{0xa7c8, 0xa7ca, 2},
+		{0xa7d1, 0xa7d9, 2},
+		{0xa7f6, 0xa7f7, 1},
+		{0xa7fa, 0xa827, 1},
+		{0xa82c, 0xa840, 20},
+		{0xa841, 0xa873, 1},
+		{0xa880, 0xa8c5, 1},
+		{0xa8d0, 0xa8d9, 1},
+		{0xa8e0, 0xa8f7, 1},
+		{0xa8fb, 0xa8fd, 2},
+		{0xa8fe, 0xa92d, 1},
+		{0xa930, 0xa953, 1},
+		{0xa980, 0xa9c0, 1},
+		{0xa9cf, 0xa9d9, 1},
+		{0xa9e0, 0xa9fe, 1},
+		{0xaa00, 0xaa36, 1},
+		{0xaa40, 0xaa4d, 1},
+		{0xaa50, 0xaa59, 1},
+		{0xaa60, 0xaa76, 1},
+		{0xaa7a, 0xaac2, 1},
+		{0xaadb, 0xaadd, 1},
+		{0xaae0, 0xaaef, 1},
+		{0xaaf2, 0xaaf6, 1},
+		{0xab01, 0xab06, 1},
+		{0xab09, 0xab0e, 1},
+		{0xab11, 0xab16, 1},
+		{0xab20, 0xab26, 1},
+		{0xab28, 0xab2e, 1},
+		{0xab30, 0xab5a, 1},
+		{0xab60, 0xab68, 1},
+		{0xabc0, 0xabea, 1},
+		{0xabec, 0xabed, 1},
+		{0xabf0, 0xabf9, 1},
+		{0xac00, 0xd7a3, 1},
+		{0xfa0e, 0xfa0f, 1},
+		{0xfa11, 0xfa13, 2},
+		{0xfa14, 0xfa1f, 11},
+		{0xfa21, 0xfa23, 2},
+		{0xfa24, 0xfa27, 3},
+		{0xfa28, 0xfa29, 1},
+		{0xfb1e, 0xfb1e, 1},
+		{0xfe20, 0xfe2f, 1},
+		{0xfe73, 0xfe73, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10000, 0x1000b, 1},
+		{0x1000d, 0x10026, 1},
+		{0x10028, 0x1003a, 1},
+		{0x1003c, 0x1003d, 1},
+		{0x1003f, 0x1004d, 1},
+		{0x10050, 0x1005d, 1},
+		{0x10080, 0x100fa, 1},
+		{0x101fd, 0x10280, 131},
+		{0x10281, 0x1029c, 1},
+		{0x102a0, 0x102d0, 1},
+		{0x102e0, 0x10300, 32},
+		{0x10301, 0x1031f, 1},
+		{0x1032d, 0x10340, 1},
+		{0x10342, 0x10349, 1},
+		{0x10350, 0x1037a, 1},
+		{0x10380, 0x1039d, 1},
+		{0x103a0, 0x103c3, 1},
+		{0x103c8, 0x103cf, 1},
+		{0x10428, 0x1049d, 1},
+		{0x104a0, 0x104a9, 1},
+		{0x104d8, 0x104fb, 1},
+		{0x10500, 0x10527, 1},
+		{0x10530, 0x10563, 1},
+		{0x10597, 0x105a1, 1},
+		{0x105a3, 0x105b1, 1},
+		{0x105b3, 0x105b9, 1},
+		{0x105bb, 0x105bc, 1},
+		{0x10600, 0x10736, 1},
+		{0x10740, 0x10755, 1},
+		{0x10760, 0x10767, 1},
+		{0x10780, 0x10800, 128},
+		{0x10801, 0x10805, 1},
+		{0x10808, 0x1080a, 2},
+		{0x1080b, 0x10835, 1},
+		{0x10837, 0x10838, 1},
+		{0x1083c, 0x1083f, 3},
+		{0x10840, 0x10855, 1},
+		{0x10860, 0x10876, 1},
+		{0x10880, 0x1089e, 1},
+		{0x108e0, 0x108f2, 1},
+		{0x108f4, 0x108f5, 1},
+		{0x10900, 0x10915, 1},
+		{0x10920, 0x10939, 1},
+		{0x10980, 0x109b7, 1},
+		{0x109be, 0x109bf, 1},
+		{0x10a00, 0x10a03, 1},
+		{0x10a05, 0x10a06, 1},
+		{0x10a0c, 0x10a13, 1},
+		{0x10a15, 0x10a17, 1},
+		{0x10a19, 0x10a35, 1},
+		{0x10a38, 0x10a3a, 1},
+		{0x10a3f, 0x10a60, 33},
+		{0x10a61, 0x10a7c, 1},
+		{0x10a80, 0x10a9c, 1},
+		{0x10ac0, 0x10ac7, 1},
+		{0x10ac9, 0x10ae6, 1},
+		{0x10b00, 0x10b35, 1},
+		{0x10b40, 0x10b55, 1},
+		{0x10b60, 0x10b72, 1},
+		{0x10b80, 0x10b91, 1},
+		{0x10c00, 0x10c48, 1},
+		{0x10cc0, 0x10cf2, 1},
+		{0x10d00, 0x10d27, 1},
+		{0x10d30, 0x10d39, 1},
+		{0x10e80, 0x10ea9, 1},
+		{0x10eab, 0x10eac, 1},
+		{0x10eb0, 0x10eb1, 1},
+		{0x10f00, 0x10f1c, 1},
+		{0x10f27, 0x10f30, 9},
+		{0x10f31, 0x10f50, 1},
+		{0x10f70, 0x10f85, 1},
+		{0x10fb0, 0x10fc4, 1},
+		{0x10fe0, 0x10ff6, 1},
+		{0x11000, 0x11046, 1},
+		{0x11066, 0x11075, 1},
+		{0x1107f, 0x110ba, 1},
+		{0x110c2, 0x110d0, 14},
+		{0x110d1, 0x110e8, 1},
+		{0x110f0, 0x110f9, 1},
+		{0x11100, 0x11134, 1},
+		{0x11136, 0x1113f, 1},
+		{0x11144, 0x11147, 1},
+		{0x11150, 0x11173, 1},
+		{0x11176, 0x11180, 10},
+		{0x11181, 0x111c4, 1},
+		{0x111c9, 0x111cc, 1},
+		{0x111ce, 0x111da, 1},
+		{0x111dc, 0x11200, 36},
+		{0x11201, 0x11211, 1},
+		{0x11213, 0x11237, 1},
+		{0x1123e, 0x11280, 66},
+		{0x11281, 0x11286, 1},
+		{0x11288, 0x1128a, 2},
+		{0x1128b, 0x1128d, 1},
+		{0x1128f, 0x1129d, 1},
+		{0x1129f, 0x112a8, 1},
+		{0x112b0, 0x112ea, 1},
+		{0x112f0, 0x112f9, 1},
+		{0x11300, 0x11303, 1},
+		{0x11305, 0x1130c, 1},
+		{0x1130f, 0x11310, 1},
+		{0x11313, 0x11328, 1},
+		{0x1132a, 0x11330, 1},
+		{0x11332, 0x11333, 1},
+		{0x11335, 0x11339, 1},
+		{0x1133b, 0x11344, 1},
+		{0x11347, 0x11348, 1},
+		{0x1134b, 0x1134d, 1},
+		{0x11350, 0x11357, 7},
+		{0x1135d, 0x11363, 1},
+		{0x11366, 0x1136c, 1},
+		{0x11370, 0x11374, 1},
+		{0x11400, 0x1144a, 1},
+		{0x11450, 0x11459, 1},
+		{0x1145e, 0x11461, 1},
+		{0x11480, 0x114c5, 1},
+		{0x114c7, 0x114d0, 9},
+		{0x114d1, 0x114d9, 1},
+		{0x11580, 0x115b5, 1},
+		{0x115b8, 0x115c0, 1},
+		{0x115d8, 0x115dd, 1},
+		{0x11600, 0x11640, 1},
+		{0x11644, 0x11650, 12},
+		{0x11651, 0x11659, 1},
+		{0x11680, 0x116b8, 1},
+		{0x116c0, 0x116c9, 1},
+		{0x11700, 0x1171a, 1},
+		{0x1171d, 0x1172b, 1},
+		{0x11730, 0x11739, 1},
+		{0x11740, 0x11746, 1},
+		{0x11800, 0x1183a, 1},
+		{0x118c0, 0x118e9, 1},
+		{0x118ff, 0x11906, 1},
+		{0x11909, 0x1190c, 3},
+		{0x1190d, 0x11913, 1},
+		{0x11915, 0x11916, 1},
+		{0x11918, 0x11935, 1},
+		{0x11937, 0x11938, 1},
+		{0x1193b, 0x11943, 1},
+		{0x11950, 0x11959, 1},
+		{0x119a0, 0x119a7, 1},
+		{0x119aa, 0x119d7, 1},
+		{0x119da, 0x119e1, 1},
+		{0x119e3, 0x119e4, 1},
+		{0x11a00, 0x11a3e, 1},
+		{0x11a47, 0x11a50, 9},
+		{0x11a51, 0x11a99, 1},
+		{0x11a9d, 0x11ab0, 19},
+		{0x11ab1, 0x11af8, 1},
+		{0x11c00, 0x11c08, 1},
+		{0x11c0a, 0x11c36, 1},
+		{0x11c38, 0x11c40, 1},
+		{0x11c50, 0x11c59, 1},
+		{0x11c72, 0x11c8f, 1},
+		{0x11c92, 0x11ca7, 1},
+		{0x11ca9, 0x11cb6, 1},
+		{0x11d00, 0x11d06, 1},
+		{0x11d08, 0x11d09, 1},
+		{0x11d0b, 0x11d36, 1},
+		{0x11d3a, 0x11d3c, 2},
+		{0x11d3d, 0x11d3f, 2},
+		{0x11d40, 0x11d47, 1},
+		{0x11d50, 0x11d59, 1},
+		{0x11d60, 0x11d65, 1},
+		{0x11d67, 0x11d68, 1},
+		{0x11d6a, 0x11d8e, 1},
+		{0x11d90, 0x11d91, 1},
+		{0x11d93, 0x11d98, 1},
+		{0x11da0, 0x11da9, 1},
+		{0x11ee0, 0x11ef6, 1},
+		{0x11fb0, 0x12000, 80},
+		{0x12001, 0x12399, 1},
+		{0x12480, 0x12543, 1},
+		{0x12f90, 0x12ff0, 1},
+		{0x13000, 0x1342e, 1},
+		{0x14400, 0x14646, 1},
+		{0x16800, 0x16a38, 1},
+		{0x16a40, 0x16a5e, 1},
+		{0x16a60, 0x16a69, 1},
+		{0x16a70, 0x16abe, 1},
+		{0x16ac0, 0x16ac9, 1},
+		{0x16ad0, 0x16aed, 1},
+		{0x16af0, 0x16af4, 1},
+		{0x16b00, 0x16b36, 1},
+		{0x16b40, 0x16b43, 1},
+		{0x16b50, 0x16b59, 1},
+		{0x16b63, 0x16b77, 1},
+		{0x16b7d, 0x16b8f, 1},
+		{0x16e60, 0x16e7f, 1},
+		{0x16f00, 0x16f4a, 1},
+		{0x16f4f, 0x16f87, 1},
+		{0x16f8f, 0x16f9f, 1},
+		{0x16fe0, 0x16fe1, 1},
+		{0x16fe3, 0x16fe4, 1},
+		{0x16ff0, 0x16ff1, 1},
+		{0x17000, 0x187f7, 1},
+		{0x18800, 0x18cd5, 1},
+		{0x18d00, 0x18d08, 1},
+		{0x1aff0, 0x1aff3, 1},
+		{0x1aff5, 0x1affb, 1},
+		{0x1affd, 0x1affe, 1},
+		{0x1b000, 0x1b122, 1},
+		{0x1b150, 0x1b152, 1},
+		{0x1b164, 0x1b167, 1},
+		{0x1b170, 0x1b2fb, 1},
+		{0x1bc00, 0x1bc6a, 1},
+		{0x1bc70, 0x1bc7c, 1},
+		{0x1bc80, 0x1bc88, 1},
+		{0x1bc90, 0x1bc99, 1},
+		{0x1bc9d, 0x1bc9e, 1},
+		{0x1cf00, 0x1cf2d, 1},
+		{0x1cf30, 0x1cf46, 1},
+		{0x1da00, 0x1da36, 1},
+		{0x1da3b, 0x1da6c, 1},
+		{0x1da75, 0x1da84, 15},
+		{0x1da9b, 0x1da9f, 1},
+		{0x1daa1, 0x1daaf, 1},
+		{0x1df00, 0x1df1e, 1},
+		{0x1e000, 0x1e006, 1},
+		{0x1e008, 0x1e018, 1},
+		{0x1e01b, 0x1e021, 1},
+		{0x1e023, 0x1e024, 1},
+		{0x1e026, 0x1e02a, 1},
+		{0x1e100, 0x1e12c, 1},
+		{0x1e130, 0x1e13d, 1},
+		{0x1e140, 0x1e149, 1},
+		{0x1e14e, 0x1e14e, 1},
+		{0x1e290, 0x1e2ae, 1},
+		{0x1e2c0, 0x1e2f9, 1},
+		{0x1e7e0, 0x1e7e6, 1},
+		{0x1e7e8, 0x1e7eb, 1},
+		{0x1e7ed, 0x1e7ee, 1},
+		{0x1e7f0, 0x1e7fe, 1},
+		{0x1e800, 0x1e8c4, 1},
+		{0x1e8d0, 0x1e8d6, 1},
+		{0x1e922, 0x1e94b, 1},
+		{0x1e950, 0x1e959, 1},
+		{0x20000, 0x2a6df, 1},
+		{0x2a700, 0x2b738, 1},
+		{0x2b740, 0x2b81d, 1},
+		{0x2b820, 0x2cea1, 1},
+		{0x2ceb0, 0x2ebe0, 1},
+		{0x30000, 0x3134a, 1},
+	},
+	LatinOffset: 2,
+}
+
+// joining type L or D, for the zwnj rule
+var idnaJoinLD = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0620, 0x0626, 6},
+		{0x0628, 0x062a, 2},
+		{0x062b, 0x062e, 1},
+		{0x0633, 0x063f, 1},
+		{0x0641, 0x0647, 1},
+		{0x0649, 0x064a, 1},
+		{0x066e, 0x066f, 1},
+		{0x0679, 0x0687, 1},
+		{0x069a, 0x06bf, 1},
+		{0x06c1, 0x06c2, 1},
+		{0x06cc, 0x06d0, 2},
+		{0x06d1, 0x06fa, 41},
+		{0x06fb, 0x06fc, 1},
+		{0x06ff, 0x0712, 19},
+		{0x0713, 0x0714, 1},
+		{0x071a, 0x071d, 1},
+		{0x071f, 0x0727, 1},
+		{0x0729, 0x072d, 2},
+		{0x072e, 0x074e, 32},
+		{0x074f, 0x0758, 1},
+		{0x075c, 0x076a, 1},
+		{0x076d, 0x0770, 1},
+		{0x0772, 0x0775, 3},
+		{0x0776, 0x0777, 1},
+		{0x077a, 0x077f, 1},
+		{0x07ca, 0x07ea, 1},
+		{0x0841, 0x0845, 1},
+		{0x0848, 0x084a, 2},
+		{0x084b, 0x0853, 1},
+		{0x0855, 0x0860, 11},
+		{0x0862, 0x0865, 1},
+		{0x0868, 0x0886, 30},
+		{0x0889, 0x088d, 1},
+		{0x08a0, 0x08a9, 1},
+		{0x08af, 0x08b0, 1},
+		{0x08b3, 0x08b8, 1},
+		{0x08ba, 0x08c8, 1},
+		{0x1820, 0x1878, 1},
+		{0x1887, 0x18a8, 1},
+		{0x18aa, 0x18aa, 1},
+		{0xa840, 0xa872, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10ac0, 0x10ac4, 1},
+		{0x10acd, 0x10ad3, 6},
+		{0x10ad4, 0x10adc, 1},
+		{0x10ade, 0x10ae0, 1},
+		{0x10b80, 0x10b82, 2},
+		{0x10b86, 0x10b88, 1},
+		{0x10b8a, 0x10b8b, 1},
+		{0x10b8d, 0x10b90, 3},
+		{0x10d00, 0x10d21, 1},
+		{0x10d23, 0x10d23, 1},
+		{0x10f30, 0x10f32, 1},
+		{0x10f34, 0x10f44, 1},
+		{0x10f70, 0x10f73, 1},
+		{0x10f76, 0x10f81, 1},
+		{0x10fb0, 0x10fb2, 2},
+		{0x10fb3, 0x10fb8, 5},
+		{0x10fbb, 0x10fbc, 1},
+		{0x10fbe, 0x10fbf, 1},
+		{0x10fc1, 0x10fc4, 3},
+		{0x1e922, 0x1e943, 1},
+	},
+}
+
+// joining type R or D, for the zwnj rule
+var idnaJoinRD = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0620, 0x0622, 2},
+		{0x0623, 0x063f, 1},
+		{0x0641, 0x064a, 1},
+		{0x066e, 0x066f, 1},
+		{0x0671, 0x0673, 1},
+		{0x0679, 0x06d3, 1},
+		{0x06d5, 0x06ee, 25},
+		{0x06ef, 0x06fa, 11},
+		{0x06fb, 0x06fc, 1},
+		{0x06ff, 0x0710, 17},
+		{0x0712, 0x072f, 1},
+		{0x074d, 0x077f, 1},
+		{0x07ca, 0x07ea, 1},
+		{0x0840, 0x0858, 1},
+		{0x0860, 0x0862, 2},
+		{0x0863, 0x0865, 1},
+		{0x0867, 0x086a, 1},
+		{0x0870, 0x0882, 1},
+		{0x0886, 0x0889, 3},
+		{0x088a, 0x088e, 1},
+		{0x08a0, 0x08ac, 1},
+		{0x08ae, 0x08c8, 1},
+		{0x1820, 0x1878, 1},
+		{0x1887, 0x18a8, 1},
+		{0x18aa, 0x18aa, 1},
+		{0xa840, 0xa871, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10ac0, 0x10ac5, 1},
+		{0x10ac7, 0x10ac9, 2},
+		{0x10aca, 0x10ace, 4},
+		{0x10acf, 0x10ad6, 1},
+		{0x10ad8, 0x10ae1, 1},
+		{0x10ae4, 0x10b80, 156},
+		{0x10b81, 0x10b91, 1},
+		{0x10d01, 0x10d23, 1},
+		{0x10f30, 0x10f44, 1},
+		{0x10f70, 0x10f81, 1},
+		{0x10fb0, 0x10fb2, 2},
+		{0x10fb3, 0x10fb6, 1},
+		{0x10fb8, 0x10fbf, 1},
+		{0x10fc1, 0x10fc4, 1},
+		{0x1e922, 0x1e943, 1},
+	},
+}
+
+// joining type T, for the zwnj rule
+var idnaJoinT = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0300, 0x033f, 1},
+		{0x0342, 0x0346, 4},
+		{0x0347, 0x034e, 1},
+		{0x0350, 0x036f, 1},
+		{0x0483, 0x0487, 1},
+		{0x0591, 0x05bd, 1},
+		{0x05bf, 0x05c1, 2},
+		{0x05c2, 0x05c4, 2},
+		{0x05c5, 0x05c7, 2},
+		{0x0610, 0x061a, 1},
+		{0x064b, 0x065f, 1},
+		{0x0670, 0x06d6, 102},
+		{0x06d7, 0x06dc, 1},
+		{0x06df, 0x06e4, 1},
+		{0x06e7, 0x06e8, 1},
+		{0x06ea, 0x06ed, 1},
+		{0x0711, 0x0730, 31},
+		{0x0731, 0x074a, 1},
+		{0x07a6, 0x07b0, 1},
+		{0x07eb, 0x07f3, 1},
+		{0x07fd, 0x0816, 25},
+		{0x0817, 0x0819, 1},
+		{0x081b, 0x0823, 1},
+		{0x0825, 0x0827, 1},
+		{0x0829, 0x082d, 1},
+		{0x0859, 0x085b, 1},
+		{0x0898, 0x089f, 1},
+		{0x08ca, 0x08e1, 1},
+		{0x08e3, 0x0902, 1},
+		{0x093a, 0x093c, 2},
+		{0x0941, 0x0948, 1},
+		{0x094d, 0x0951, 4},
+		{0x0952, 0x0957, 1},
+		{0x0962, 0x0963, 1},
+		{0x0981, 0x09bc, 59},
+		{0x09c1, 0x09c4, 1},
+		{0x09cd, 0x09e2, 21},
+		{0x09e3, 0x09fe, 27},
+		{0x0a01, 0x0a02, 1},
+		{0x0a3c, 0x0a41, 5},
+		{0x0a42, 0x0a47, 5},
+		{0x0a48, 0x0a4b, 3},
+		{0x0a4c, 0x0a4d, 1},
+		{0x0a51, 0x0a70, 31},
+		{0x0a71, 0x0a75, 4},
+		{0x0a81, 0x0a82, 1},
+		{0x0abc, 0x0ac1, 5},
+		{0x0ac2, 0x0ac5, 1},
+		{0x0ac7, 0x0ac8, 1},
+		{0x0acd, 0x0ae2, 21},
+		{0x0ae3, 0x0afa, 23},
+		{0x0afb, 0x0aff, 1},
+		{0x0b01, 0x0b3c, 59},
+		{0x0b3f, 0x0b41, 2},
+		{0x0b42, 0x0b44, 1},
+		{0x0b4d, 0x0b55, 8},
+		{0x0b56, 0x0b62, 12},
+		{0x0b63, 0x0b82, 31},
+		{0x0bc0, 0x0bcd, 13},
+		{0x0c00, 0x0c04, 4},
+		{0x0c3c, 0x0c3e, 2},
+		{0x0c3f, 0x0c40, 1},
+		{0x0c46, 0x0c48, 1},
+		{0x0c4a, 0x0c4d, 1},
+		{0x0c55, 0x0c56, 1},
+		{0x0c62, 0x0c63, 1},
+		{0x0c81, 0x0cbc, 59},
+		{0x0cbf, 0x0cc6, 7},
+		{0x0ccc, 0x0ccd, 1},
+		{0x0ce2, 0x0ce3, 1},
+		{0x0d00, 0x0d01, 1},
+		{0x0d3b, 0x0d3c, 1},
+		{0x0d41, 0x0d44, 1},
+		{0x0d4d, 0x0d62, 21},
+		{0x0d63, 0x0d81, 30},
+		{0x0dca, 0x0dd2, 8},
+		{0x0dd3, 0x0dd4, 1},
+		{0x0dd6, 0x0e31, 91},
+		{0x0e34, 0x0e3a, 1},
+		{0x0e47, 0x0e4e, 1},
+		{0x0eb1, 0x0eb4, 3},
+		{0x0eb5, 0x0ebc, 1},
+		{0x0ec8, 0x0ecd, 1},
+		{0x0f18, 0x0f19, 1},
+		{0x0f35, 0x0f39, 2},
+		{0x0f71, 0x0f72, 1},
+		{0x0f74, 0x0f7a, 6},
+		{0x0f7b, 0x0f7e, 1},
+		{0x0f80, 0x0f82, 2},
+		{0x0f83, 0x0f84, 1},
+		{0x0f86, 0x0f87, 1},
+		{0x0f8d, 0x0f92, 1},
+		{0x0f94, 0x0f97, 1},
+		{0x0f99, 0x0f9c, 1},
+		{0x0f9e, 0x0fa1, 1},
+		{0x0fa3, 0x0fa6, 1},
+		{0x0fa8, 0x0fab, 1},
+		{0x0fad, 0x0fb8, 1},
+		{0x0fba, 0x0fbc, 1},
+		{0x0fc6, 0x102d, 103},
+		{0x102e, 0x1030, 1},
+		{0x1032, 0x1037, 1},
+		{0x1039, 0x103a, 1},
+		{0x103d, 0x103e, 1},
+		{0x1058, 0x1059, 1},
+		{0x105e, 0x1060, 1},
+		{0x1071, 0x1074, 1},
+		{0x1082, 0x1085, 3},
+		{0x1086, 0x108d, 7},
+		{0x109d, 0x109d, 1},
+		{0x135d, 0x135f, 1},
+		{0x1712, 0x1714, 1},
+		{0x1732, 0x1733, 1},
+		{0x1752, 0x1753, 1},
+		{0x1772, 0x1773, 1},
+		{0x17b7, 0x17bd, 1},
+		{0x17c6, 0x17c9, 3},
+		{0x17ca, 0x17d3, 1},
+		{0x17dd, 0x1885, 168},
+		{0x1886, 0x18a9, 35},
+		{0x1920, 0x1922, 1},
+		{0x1927, 0x1928, 1},
+		{0x1932, 0x1939, 7},
+		{0x193a, 0x193b, 1},
+		{0x1a17, 0x1a18, 1},
+		{0x1a1b, 0x1a56, 59},
+		{0x1a58, 0x1a5e, 1},
+		{0x1a60, 0x1a62, 2},
+		{0x1a65, 0x1a6c, 1},
+		{0x1a73, 0x1a7c, 1},
+		{0x1a7f, 0x1ab0, 49},
+		{0x1ab1, 0x1abd, 1},
+		{0x1abf, 0x1ace, 1},
+		{0x1b00, 0x1b03, 1},
+		{0x1b34, 0x1b36, 2},
+		{0x1b37, 0x1b3a, 1},
+		{0x1b3c, 0x1b42, 6},
+		{0x1b6b, 0x1b73, 1},
+		{0x1b80, 0x1b81, 1},
+		{0x1ba2, 0x1ba5, 1},
+		{0x1ba8, 0x1ba9, 1},
+		{0x1bab, 0x1bad, 1},
+		{0x1be6, 0x1be8, 2},
+		{0x1be9, 0x1bed, 4},
+		{0x1bef, 0x1bf1, 1},
+		{0x1c2c, 0x1c33, 1},
+		{0x1c36, 0x1c37, 1},
+		{0x1cd0, 0x1cd2, 1},
+		{0x1cd4, 0x1ce0, 1},
+		{0x1ce2, 0x1ce8, 1},
+		{0x1ced, 0x1cf4, 7},
+		{0x1cf8, 0x1cf9, 1},
+		{0x1dc0, 0x1dff, 1},
+		{0x2cef, 0x2cf1, 1},
+		{0x2d7f, 0x2de0, 97},
+		{0x2de1, 0x2dff, 1},
+		{0x302a, 0x302d, 1},
+		{0x3099, 0x309a, 1},
+		{0xa66f, 0xa674, 5},
+		{0xa675, 0xa67d, 1},
+		{0xa69e, 0xa69f, 1},
+		{0xa6f0, 0xa6f1, 1},
+		{0xa802, 0xa806, 4},
+		{0xa80b, 0xa825, 26},
+		{0xa826, 0xa82c, 6},
+		{0xa8c4, 0xa8c5, 1},
+		{0xa8e0, 0xa8f1, 1},
+		{0xa8ff, 0xa926, 39},
+		{0xa927, 0xa92d, 1},
+		{0xa947, 0xa951, 1},
+		{0xa980, 0xa982, 1},
+		{0xa9b3, 0xa9b6, 3},
+		{0xa9b7, 0xa9b9, 1},
+		{0xa9bc, 0xa9bd, 1},
+		{0xa9e5, 0xaa29, 68},
+		{0xaa2a, 0xaa2e, 1},
+		{0xaa31, 0xaa32, 1},
+		{0xaa35, 0xaa36, 1},
+		{0xaa43, 0xaa4c, 9},
+		{0xaa7c, 0xaab0, 52},
+		{0xaab2, 0xaab4, 1},
+		{0xaab7, 0xaab8, 1},
+		{0xaabe, 0xaabf, 1},
+		{0xaac1, 0xaaec, 43},
+		{0xaaed, 0xaaf6, 9},
+		{0xabe5, 0xabe8, 3},
+		{0xabed, 0xabed, 1},
+		{0xfb1e, 0xfb1e, 1},
+		{0xfe20, 0xfe2f, 1},
+	},
+	R32: []unicode.Range32{
+		{0x101fd, 0x102e0, 227},
+		{0x10376, 0x1037a, 1},
+		{0x10a01, 0x10a03, 1},
+		{0x10a05, 0x10a06, 1},
+		{0x10a0c, 0x10a0f, 1},
+		{0x10a38, 0x10a3a, 1},
+		{0x10a3f, 0x10ae5, 166},
+		{0x10ae6, 0x10ae6, 1},
+		{0x10d24, 0x10d27, 1},
+		{0x10eab, 0x10eac, 1},
+		{0x10f46, 0x10f50, 1},
+		{0x10f82, 0x10f85, 1},
+		{0x11001, 0x11038, 55},
+		{0x11039, 0x11046, 1},
+		{0x11070, 0x11073, 3},
+		{0x11074, 0x1107f, 11},
+		{0x11080, 0x11081, 1},
+		{0x110b3, 0x110b6, 1},
+		{0x110b9, 0x110ba, 1},
+		{0x110c2, 0x11100, 62},
+		{0x11101, 0x11102, 1},
+		{0x11127, 0x1112b, 1},
+		{0x1112d, 0x11134, 1},
+		{0x11173, 0x11180, 13},
+		{0x11181, 0x111b6, 53},
+		{0x111b7, 0x111be, 1},
+		{0x111c9, 0x111cc, 1},
+		{0x111cf, 0x1122f, 96},
+		{0x11230, 0x11231, 1},
+		{0x11234, 0x11236, 2},
+		{0x11237, 0x1123e, 7},
+		{0x112df, 0x112e3, 4},
+		{0x112e4, 0x112ea, 1},
+		{0x11300, 0x11301, 1},
+		{0x1133b, 0x1133c, 1},
+		{0x11340, 0x11366, 38},
+		{0x11367, 0x1136c, 1},
+		{0x11370, 0x11374, 1},
+		{0x11438, 0x1143f, 1},
+		{0x11442, 0x11444, 1},
+		{0x11446, 0x1145e, 24},
+		{0x114b3, 0x114b8, 1},
+		{0x114ba, 0x114bf, 5},
+		{0x114c0, 0x114c2, 2},
+		{0x114c3, 0x115b2, 239},
+		{0x115b3, 0x115b5, 1},
+		{0x115bc, 0x115bd, 1},
+		{0x115bf, 0x115c0, 1},
+		{0x115dc, 0x115dd, 1},
+		{0x11633, 0x1163a, 1},
+		{0x1163d, 0x1163f, 2},
+		{0x11640, 0x116ab, 107},
+		{0x116ad, 0x116b0, 3},
+		{0x116b1, 0x116b5, 1},
+		{0x116b7, 0x1171d, 102},
+		{0x1171e, 0x1171f, 1},
+		{0x11722, 0x11725, 1},
+		{0x11727, 0x1172b, 1},
+		{0x1182f, 0x11837, 1},
+		{0x11839, 0x1183a, 1},
+		{0x1193b, 0x1193c, 1},
+		{0x1193e, 0x11943, 5},
+		{0x119d4, 0x119d7, 1},
+		{0x119da, 0x119db, 1},
+		{0x119e0, 0x11a01, 33},
+		{0x11a02, 0x11a0a, 1},
+		{0x11a33, 0x11a38, 1},
+		{0x11a3b, 0x11a3e, 1},
+		{0x11a47, 0x11a51, 10},
+		{0x11a52, 0x11a56, 1},
+		{0x11a59, 0x11a5b, 1},
+		{0x11a8a, 0x11a96, 1},
+		{0x11a98, 0x11a99, 1},
+		{0x11c30, 0x11c36, 1},
+		{0x11c38, 0x11c3d, 1},
+		{0x11c3f, 0x11c92, 83},
+		{0x11c93, 0x11ca7, 1},
+		{0x11caa, 0x11cb0, 1},
+		{0x11cb2, 0x11cb3, 1},
+		{0x11cb5, 0x11cb6, 1},
+		{0x11d31, 0x11d36, 1},
+		{0x11d3a, 0x11d3c, 2},
+		{0x11d3d, 0x11d3f, 2},
+		{0x11d40, 0x11d45, 1},
+		{0x11d47, 0x11d90, 73},
+		{0x11d91, 0x11d95, 4},
+		{0x11d97, 0x11d97, 1},
+		{0x11ef3, 0x11ef4, 1},
+		{0x16af0, 0x16af4, 1},
+		{0x16b30, 0x16b36, 1},
+		{0x16f4f, 0x16f8f, 64},
+		{0x16f90, 0x16f92, 1},
+		{0x16fe4, 0x16fe4, 1},
+		{0x1bc9d, 0x1bc9e, 1},
+		{0x1cf00, 0x1cf2d, 1},
+		{0x1cf30, 0x1cf46, 1},
+		{0x1da00, 0x1da36, 1},
+		{0x1da3b, 0x1da6c, 1},
+		{0x1da75, 0x1da84, 15},
+		{0x1da9b, 0x1da9f, 1},
+		{0x1daa1, 0x1daaf, 1},
+		{0x1e000, 0x1e006, 1},
+		{0x1e008, 0x1e018, 1},
+		{0x1e01b, 0x1e021, 1},
+		{0x1e023, 0x1e024, 1},
+		{0x1e026, 0x1e02a, 1},
+		{0x1e130, 0x1e136, 1},
+		{0x1e2ae, 0x1e2ec, 62},
+		{0x1e2ed, 0x1e2ef, 1},
+		{0x1e8d0, 0x1e8d6, 1},
+		{0x1e944, 0x1e94b, 1},
+	},
+}
+
+// bidi class L, of the allowed code points
+var idnaBidiL = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0061, 0x007a, 1},
+		{0x00df, 0x00f6, 1},
+		{0x00f8, 0x00ff, 1},
+		{0x0101, 0x0131, 2},
+		{0x0135, 0x0137, 2},
+		{0x0138, 0x013e, 2},
+		{0x0142, 0x0148, 2},
+		{0x014b, 0x0177, 2},
+		{0x017a, 0x0180, 2},
+		{0x0183, 0x0185, 2},
+		{0x0188, 0x018c, 4},
+		{0x018d, 0x0192, 5},
+		{0x0195, 0x0199, 4},
+		{0x019a, 0x019b, 1},
+		{0x019e, 0x01a1, 3},
+		{0x01a3, 0x01a5, 2},
+		{0x01a8, 0x01aa, 2},
+		{0x01ab, 0x01ad, 2},
+		{0x01b0, 0x01b4, 4},
+		{0x01b6, 0x01b9, 3},
+		{0x01ba, 0x01bb, 1},
+		{0x01bd, 0x01c3, 1},
+		{0x01ce, 0x01dc, 2},
+		{0x01dd, 0x01ef, 2},
+		{0x01f0, 0x01f5, 5},
+		{0x01f9, 0x0233, 2},
+		{0x0234, 0x0239, 1},
+		{0x023c, 0x023f, 3},
+		{0x0240, 0x0242, 2},
+		{0x0247, 0x024f, 2},
+		{0x0250, 0x02af, 1},
+		{0x02bb, 0x02c1, 1},
+		{0x02d0, 0x02d1, 1},
+		{0x02ee, 0x0371, 131},
+		{0x0373, 0x037b, 4},
+		{0x037c, 0x037d, 1},
+		{0x0390, 0x03ac, 28},
+		{0x03ad, 0x03ce, 1},
+		{0x03d7, 0x03ef, 2},
+		{0x03f3, 0x03f8, 5},
+		{0x03fb, 0x03fc, 1},
+		{0x0430, 0x045f, 1},
+		{0x0461, 0x0481, 2},
+		{0x048b, 0x04bf, 2},
+		{0x04c2, 0x04ce, 2},
+		{0x04cf, 0x052f, 2},
+		{0x0559, 0x0560, 7},
+		{0x0561, 0x0586, 1},
+		{0x0588, 0x0588, 1},
+		{0x0903, 0x0939, 1},
+		{0x093b, 0x093d, 2},
+		{0x093e, 0x0940, 1},
+		{0x0949, 0x094c, 1},
+		{0x094e, 0x0950, 1},
+		{0x0960, 0x0961, 1},
+		{0x0966, 0x096f, 1},
+		{0x0971, 0x0980, 1},
+		{0x0982, 0x0983, 1},
+		{0x0985, 0x098c, 1},
+		{0x098f, 0x0990, 1},
+		{0x0993, 0x09a8, 1},
+		{0x09aa, 0x09b0, 1},
+		{0x09b2, 0x09b6, 4},
+		{0x09b7, 0x09b9, 1},
+		{0x09bd, 0x09c0, 1},
+		{0x09c7, 0x09c8, 1},
+		{0x09cb, 0x09cc, 1},
+		{0x09ce, 0x09e0, 9},
+		{0x09e1, 0x09e6, 5},
+		{0x09e7, 0x09f1, 1},
+		{0x09fc, 0x0a03, 7},
+		{0x0a05, 0x0a0a, 1},
+		{0x0a0f, 0x0a10, 1},
+		{0x0a13, 0x0a28, 1},
+		{0x0a2a, 0x0a30, 1},
+		{0x0a32, 0x0a38, 3},
+		{0x0a39, 0x0a3e, 5},
+		{0x0a3f, 0x0a40, 1},
+		{0x0a5c, 0x0a66, 10},
+		{0x0a67, 0x0a6f, 1},
+		{0x0a72, 0x0a74, 1},
+		{0x0a83, 0x0a85, 2},
+		{0x0a86, 0x0a8d, 1},
+		{0x0a8f, 0x0a91, 1},
+		{0x0a93, 0x0aa8, 1},
+		{0x0aaa, 0x0ab0, 1},
+		{0x0ab2, 0x0ab3, 1},
+		{0x0ab5, 0x0ab9, 1},
+		{0x0abd, 0x0ac0, 1},
+		{0x0ac9, 0x0acb, 2},
+		{0x0acc, 0x0ad0, 4},
+		{0x0ae0, 0x0ae1, 1},
+		{0x0ae6, 0x0aef, 1},
+		{0x0af9, 0x0b02, 9},
+		{0x0b03, 0x0b05, 2},
+		{0x0b06, 0x0b0c, 1},
+		{0x0b0f, 0x0b10, 1},
+		{0x0b13, 0x0b28, 1},
+		{0x0b2a, 0x0b30, 1},
+		{0x0b32, 0x0b33, 1},
+		{0x0b35, 0x0b39, 1},
+		{0x0b3d, 0x0b3e, 1},
+		{0x0b40, 0x0b47, 7},
+		{0x0b48, 0x0b4b, 3},
+		{0x0b4c, 0x0b57, 11},
+		{0x0b5f, 0x0b61, 1},
+		{0x0b66, 0x0b6f, 1},
+		{0x0b71, 0x0b83, 18},
+		{0x0b85, 0x0b8a, 1},
+		{0x0b8e, 0x0b90, 1},
+		{0x0b92, 0x0b95, 1},
+		{0x0b99, 0x0b9a, 1},
+		{0x0b9c, 0x0b9e, 2},
+		{0x0b9f, 0x0ba3, 4},
+		{0x0ba4, 0x0ba8, 4},
+		{0x0ba9, 0x0baa, 1},
+		{0x0bae, 0x0bb9, 1},
+		{0x0bbe, 0x0bbf, 1},
+		{0x0bc1, 0x0bc2, 1},
+		{0x0bc6, 0x0bc8, 1},
+		{0x0bca, 0x0bcc, 1},
+		{0x0bd0, 0x0bd7, 7},
+		{0x0be6, 0x0bef, 1},
+		{0x0c01, 0x0c03, 1},
+		{0x0c05, 0x0c0c, 1},
+		{0x0c0e, 0x0c10, 1},
+		{0x0c12, 0x0c28, 1},
+		{0x0c2a, 0x0c39, 1},
+		{0x0c3d, 0x0c41, 4},
+		{0x0c42, 0x0c44, 1},
+		{0x0c58, 0x0c5a, 1},
+		{0x0c5d, 0x0c60, 3},
+		{0x0c61, 0x0c66, 5},
+		{0x0c67, 0x0c6f, 1},
+		{0x0c80, 0x0c82, 2},
+		{0x0c83, 0x0c85, 2},
+		{0x0c86, 0x0c8c, 1},
+		{0x0c8e, 0x0c90, 1},
+		{0x0c92, 0x0ca8, 1},
+		{0x0caa, 0x0cb3, 1},
+		{0x0cb5, 0x0cb9, 1},
+		{0x0cbd, 0x0cc4, 1},
+		{0x0cc6, 0x0cc8, 1},
+		{0x0cca, 0x0ccb, 1},
+		{0x0cd5, 0x0cd6, 1},
+		{0x0cdd, 0x0cde, 1},
+		{0x0ce0, 0x0ce1, 1},
+		{0x0ce6, 0x0cef, 1},
+		{0x0cf1, 0x0cf2, 1},
+		{0x0d02, 0x0d0c, 1},
+		{0x0d0e, 0x0d10, 1},
+		{0x0d12, 0x0d3a, 1},
+		{0x0d3d, 0x0d40, 1},
+		{0x0d46, 0x0d48, 1},
+		{0x0d4a, 0x0d4c, 1},
+		{0x0d4e, 0x0d54, 6},
+		{0x0d55, 0x0d57, 1},
+		{0x0d5f, 0x0d61, 1},
+		{0x0d66, 0x0d6f, 1},
+		{0x0d7a, 0x0d7f, 1},
+		{0x0d82, 0x0d83, 1},
+		{0x0d85, 0x0d96, 1},
+		{0x0d9a, 0x0db1, 1},
+		{0x0db3, 0x0dbb, 1},
+		{0x0dbd, 0x0dc0, 3},
+		{0x0dc1, 0x0dc6, 1},
+		{0x0dcf, 0x0dd1, 1},
+		{0x0dd8, 0x0ddf, 1},
+		{0x0de6, 0x0def, 1},
+		{0x0df2, 0x0df3, 1},
+		{0x0e01, 0x0e30, 1},
+		{0x0e32, 0x0e40, 14},
+		{0x0e41, 0x0e46, 1},
+		{0x0e50, 0x0e59, 1},
+		{0x0e81, 0x0e82, 1},
+		{0x0e84, 0x0e86, 2},
+		{0x0e87, 0x0e8a, 1},
+		{0x0e8c, 0x0ea3, 1},
+		{0x0ea5, 0x0ea7, 2},
+		{0x0ea8, 0x0eb0, 1},
+		{0x0eb2, 0x0ebd, 11},
+		{0x0ec0, 0x0ec4, 1},
+		{0x0ec6, 0x0ed0, 10},
+		{0x0ed1, 0x0ed9, 1},
+		{0x0ede, 0x0edf, 1},
+		{0x0f00, 0x0f0b, 11},
+		{0x0f20, 0x0f29, 1},
+		{0x0f3e, 0x0f42, 1},
+		{0x0f44, 0x0f47, 1},
+		{0x0f49, 0x0f4c, 1},
+		{0x0f4e, 0x0f51, 1},
+		{0x0f53, 0x0f56, 1},
+		{0x0f58, 0x0f5b, 1},
+		{0x0f5d, 0x0f68, 1},
+		{0x0f6a, 0x0f6c, 1},
+		{0x0f7f, 0x0f88, 9},
+		{0x0f89, 0x0f8c, 1},
+		{0x1000, 0x102c, 1},
+		{0x1031, 0x1038, 7},
+		{0x103b, 0x103c, 1},
+		{0x103f, 0x1049, 1},
+		{0x1050, 0x1057, 1},
+		{0x105a, 0x105d, 1},
+		{0x1061, 0x1070, 1},
+		{0x1075, 0x1081, 1},
+		{0x1083, 0x1084, 1},
+		{0x1087, 0x108c, 1},
+		{0x108e, 0x109c, 1},
+		{0x10d0, 0x10fa, 1},
+		{0x10fd, 0x10ff, 1},
+		{0x1200, 0x1248, 1},
+		{0x124a, 0x124d, 1},
+		{0x1250, 0x1256, 1},
+		{0x1258, 0x125a, 2},
+		{0x125b, 0x125d, 1},
+		{0x1260, 0x1288, 1},
+		{0x128a, 0x128d, 1},
+		{0x1290, 0x12b0, 1},
+		{0x12b2, 0x12b5, 1},
+		{0x12b8, 0x12be, 1},
+		{0x12c0, 0x12c2, 2},
+		{0x12c3, 0x12c5, 1},
+		{0x12c8, 0x12d6, 1},
+		{0x12d8, 0x1310, 1},
+		{0x1312, 0x1315, 1},
+		{0x1318, 0x135a, 1},
+		{0x1380, 0x138f, 1},
+		{0x13a0, 0x13f5, 1},
+		{0x1401, 0x166c, 1},
+		{0x166f, 0x167f, 1},
+		{0x1681, 0x169a, 1},
+		{0x16a0, 0x16ea, 1},
+		{0x16f1, 0x16f8, 1},
+		{0x1700, 0x1711, 1},
+		{0x1715, 0x171f, 10},
+		{0x1720, 0x1731, 1},
+		{0x1734, 0x1740, 12},
+		{0x1741, 0x1751, 1},
+		{0x1760, 0x176c, 1},
+		{0x176e, 0x1770, 1},
+		{0x1780, 0x17b3, 1},
+		{0x17b6, 0x17be, 8},
+		{0x17bf, 0x17c5, 1},
+		{0x17c7, 0x17c8, 1},
+		{0x17d7, 0x17dc, 5},
+		{0x17e0, 0x17e9, 1},
+		{0x1810, 0x1819, 1},
+		{0x1820, 0x1878, 1},
+		{0x1880, 0x1884, 1},
+		{0x1887, 0x18a8, 1},
+		{0x18aa, 0x18b0, 6},
+		{0x18b1, 0x18f5, 1},
+		{0x1900, 0x191e, 1},
+		{0x1923, 0x1926, 1},
+		{0x1929, 0x192b, 1},
+		{0x1930, 0x1931, 1},
+		{0x1933, 0x1938, 1},
+		{0x1946, 0x196d, 1},
+		{0x1970, 0x1974, 1},
+		{0x1980, 0x19ab, 1},
+		{0x19b0, 0x19c9, 1},
+		{0x19d0, 0x19d9, 1},
+		{0x1a00, 0x1a16, 1},
+		{0x1a19, 0x1a1a, 1},
+		{0x1a20, 0x1a55, 1},
+		{0x1a57, 0x1a61, 10},
+		{0x1a63, 0x1a64, 1},
+		{0x1a6d, 0x1a72, 1},
+		{0x1a80, 0x1a89, 1},
+		{0x1a90, 0x1a99, 1},
+		{0x1aa7, 0x1b04, 93},
+		{0x1b05, 0x1b33, 1},
+		{0x1b35, 0x1b3b, 6},
+		{0x1b3d, 0x1b41, 1},
+		{0x1b43, 0x1b4c, 1},
+		{0x1b50, 0x1b59, 1},
+		{0x1b82, 0x1ba1, 1},
+		{0x1ba6, 0x1ba7, 1},
+		{0x1baa, 0x1bae, 4},
+		{0x1baf, 0x1be5, 1},
+		{0x1be7, 0x1bea, 3},
+		{0x1beb, 0x1bec, 1},
+		{0x1bee, 0x1bf2, 4},
+		{0x1bf3, 0x1c00, 13},
+		{0x1c01, 0x1c2b, 1},
+		{0x1c34, 0x1c35, 1},
+		{0x1c40, 0x1c49, 1},
+		{0x1c4d, 0x1c7d, 1},
+		{0x1ce1, 0x1ce9, 8},
+		{0x1cea, 0x1cec, 1},
+		{0x1cee, 0x1cf3, 1},
+		{0x1cf5, 0x1cf7, 1},
+		{0x1cfa, 0x1d00, 6},
+		{0x1d01, 0x1d2b, 1},
+		{0x1d2f, 0x1d3b, 12},
+		{0x1d4e, 0x1d6b, 29},
+		{0x1d6c, 0x1d77, 1},
+		{0x1d79, 0x1d9a, 1},
+		{0x1e01, 0x1e95, 2},
+		{0x1e96, 0x1e99, 1},
+		{0x1e9c, 0x1e9d, 1},
+		{0x1e9f, 0x1eff, 2},
+		{0x1f00, 0x1f07, 1},
+		{0x1f10, 0x1f15, 1},
+		{0x1f20, 0x1f27, 1},
+		{0x1f30, 0x1f37, 1},
+		{0x1f40, 0x1f45, 1},
+		{0x1f50, 0x1f57, 1},
+		{0x1f60, 0x1f67, 1},
+		{0x1f70, 0x1f7c, 2},
+		{0x1fb0, 0x1fb1, 1},
+		{0x1fb6, 0x1fc6, 16},
+		{0x1fd0, 0x1fd2, 1},
+		{0x1fd6, 0x1fd7, 1},
+		{0x1fe0, 0x1fe2, 1},
+		{0x1fe4, 0x1fe7, 1},
+		{0x1ff6, 0x1ff6, 1},
+		{0x214e, 0x2184, 54},
+		{0x2c30, 0x2c5f, 1},
+		{0x2c61, 0x2c65, 4},
+		{0x2c66, 0x2c6c, 2},
+		{0x2c71, 0x2c73, 2},
+		{0x2c74, 0x2c76, 2},
+		{0x2c77, 0x2c7b, 1},
+		{0x2c81, 0x2ce3, 2},
+		{0x2ce4, 0x2cec, 8},
+		{0x2cee, 0x2cf3, 5},
+		{0x2d00, 0x2d25, 1},
+		{0x2d27, 0x2d2d, 6},
+		{0x2d30, 0x2d67, 1},
+		{0x2d80, 0x2d96, 1},
+		{0x2da0, 0x2da6, 1},
+		{0x2da8, 0x2dae, 1},
+		{0x2db0, 0x2db6, 1},
+		{0x2db8, 0x2dbe, 1},
+		{0x2dc0, 0x2dc6, 1},
+		{0x2dc8, 0x2dce, 1},
+		{0x2dd0, 0x2dd6, 1},
+		{0x2dd8, 0x2dde, 1},
+		{0x3005, 0x3007, 1},
+		{0x303c, 0x3041, 5},
+		{0x3042, 0x3096, 1},
+		{0x309d, 0x309e, 1},
+		{0x30a1, 0x30fa, 1},
+		{0x30fc, 0x30fe, 1},
+		{0x3105, 0x312f, 1},
+		{0x31a0, 0x31bf, 1},
+		{0x31f0, 0x31ff, 1},
+		{0x3400, 0x4dbf, 1},
+		{0x4e00, 0xa48c, 1},
+		{0xa4d0, 0xa4fd, 1},
+		{0xa500, 0xa60c, 1},
+		{0xa610, 0xa62b, 1},
+		{0xa641, 0xa66d, 2},
+		{0xa66e, 0xa681, 19},
+		{0xa683, 0xa69b, 2},
+		{0xa6a0, 0xa6e5, 1},
+		{0xa723, 0xa72f, 2},
+		{0xa730, 0xa731, 1},
+		{0xa733, 0xa771, 2},
+		{0xa772, 0xa778, 1},
+		{0xa77a, 0xa77c, 2},
+		{0xa77f, 0xa787, 2},
+		{0xa78c, 0xa78e, 2},
+		{0xa78f, 0xa793, 2},
+		{0xa794, 0xa795, 1},
+		{0xa797, 0xa7a9, 2},
+		{0xa7af, 0xa7b5, 6},
+		{0xa7b7, 0xa7c3, 2},
+		{0xa7c8, 0xa7ca, 2},
+		{0xa7d1, 0xa7d9, 2},
+		{0xa7f6, 0xa7f7, 1},
+		{0xa7fa, 0xa801, 1},
+		{0xa803, 0xa805, 1},
+		{0xa807, 0xa80a, 1},
+		{0xa80c, 0xa824, 1},
+		{0xa827, 0xa840, 25},
+		{0xa841, 0xa873, 1},
+		{0xa880, 0xa8c3, 1},
+		{0xa8d0, 0xa8d9, 1},
+		{0xa8f2, 0xa8f7, 1},
+		{0xa8fb, 0xa8fd, 2},
+		{0xa8fe, 0xa900, 2},
+		{0xa901, 0xa925, 1},
+		{0xa930, 0xa946, 1},
+		{0xa952, 0xa953, 1},
+		{0xa983, 0xa9b2, 1},
+		{0xa9b4, 0xa9b5, 1},
+		{0xa9ba, 0xa9bb, 1},
+		{0xa9be, 0xa9c0, 1},
+		{0xa9cf, 0xa9d9, 1},
+		{0xa9e0, 0xa9e4, 1},
+		{0xa9e6, 0xa9fe, 1},
+		{0xaa00, 0xaa28, 1},
+		{0xaa2f, 0xaa30, 1},
+		{0xaa33, 0xaa34, 1},
+		{0xaa40, 0xaa42, 1},
+		{0xaa44, 0xaa4b, 1},
+		{0xaa4d, 0xaa50, 3},
+		{0xaa51, 0xaa59, 1},
+		{0xaa60, 0xaa76, 1},
+		{0xaa7a, 0xaa7b, 1},
+		{0xaa7d, 0xaaaf, 1},
+		{0xaab1, 0xaab5, 4},
+		{0xaab6, 0xaab9, 3},
+		{0xaaba, 0xaabd, 1},
+		{0xaac0, 0xaac2, 2},
+		{0xaadb, 0xaadd, 1},
+		{0xaae0, 0xaaeb, 1},
+		{0xaaee, 0xaaef, 1},
+		{0xaaf2, 0xaaf5, 1},
+		{0xab01, 0xab06, 1},
+		{0xab09, 0xab0e, 1},
+		{0xab11, 0xab16, 1},
+		{0xab20, 0xab26, 1},
+		{0xab28, 0xab2e, 1},
+		{0xab30, 0xab5a, 1},
+		{0xab60, 0xab68, 1},
+		{0xabc0, 0xabe4, 1},
+		{0xabe6, 0xabe7, 1},
+		{0xabe9, 0xabea, 1},
+		{0xabec, 0xabf0, 4},
+		{0xabf1, 0xabf9, 1},
+		{0xac00, 0xd7a3, 1},
+		{0xfa0e, 0xfa0f, 1},
+		{0xfa11, 0xfa13, 2},
+		{0xfa14, 0xfa1f, 11},
+		{0xfa21, 0xfa23, 2},
+		{0xfa24, 0xfa27, 3},
+		{0xfa28, 0xfa29, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10000, 0x1000b, 1},
+		{0x1000d, 0x10026, 1},
+		{0x10028, 0x1003a, 1},
+		{0x1003c, 0x1003d, 1},
+		{0x1003f, 0x1004d, 1},
+		{0x10050, 0x1005d, 1},
+		{0x10080, 0x100fa, 1},
+		{0x10280, 0x1029c, 1},
+		{0x102a0, 0x102d0, 1},
+		{0x10300, 0x1031f, 1},
+		{0x1032d, 0x10340, 1},
+		{0x10342, 0x10349, 1},
+		{0x10350, 0x10375, 1},
+		{0x10380, 0x1039d, 1},
+		{0x103a0, 0x103c3, 1},
+		{0x103c8, 0x103cf, 1},
+		{0x10428, 0x1049d, 1},
+		{0x104a0, 0x104a9, 1},
+		{0x104d8, 0x104fb, 1},
+		{0x10500, 0x10527, 1},
+		{0x10530, 0x10563, 1},
+		{0x10597, 0x105a1, 1},
+		{0x105a3, 0x105b1, 1},
+		{0x105b3, 0x105b9, 1},
+		{0x105bb, 0x105bc, 1},
+		{0x10600, 0x10736, 1},
+		{0x10740, 0x10755, 1},
+		{0x10760, 0x10767, 1},
+		{0x10780, 0x10780, 1},
+		{0x11000, 0x11002, 2},
+		{0x11003, 0x11037, 1},
+		{0x11066, 0x1106f, 1},
+		{0x11071, 0x11072, 1},
+		{0x11075, 0x11082, 13},
+		{0x11083, 0x110b2, 1},
+		{0x110b7, 0x110b8, 1},
+		{0x110d0, 0x110e8, 1},
+		{0x110f0, 0x110f9, 1},
+		{0x11103, 0x11126, 1},
+		{0x1112c, 0x11136, 10},
+		{0x11137, 0x1113f, 1},
+		{0x11144, 0x11147, 1},
+		{0x11150, 0x11172, 1},
+		{0x11176, 0x11182, 12},
+		{0x11183, 0x111b5, 1},
+		{0x111bf, 0x111c4, 1},
+		{0x111ce, 0x111d0, 2},
+		{0x111d1, 0x111da, 1},
+		{0x111dc, 0x11200, 36},
+		{0x11201, 0x11211, 1},
+		{0x11213, 0x1122e, 1},
+		{0x11232, 0x11233, 1},
+		{0x11235, 0x11280, 75},
+		{0x11281, 0x11286, 1},
+		{0x11288, 0x1128a, 2},
+		{0x1128b, 0x1128d, 1},
+		{0x1128f, 0x1129d, 1},
+		{0x1129f, 0x112a8, 1},
+		{0x112b0, 0x112de, 1},
+		{0x112e0, 0x112e2, 1},
+		{0x112f0, 0x112f9, 1},
+		{0x11302, 0x11303, 1},
+		{0x11305, 0x1130c, 1},
+		{0x1130f, 0x11310, 1},
+		{0x11313, 0x11328, 1},
+		{0x1132a, 0x11330, 1},
+		{0x11332, 0x11333, 1},
+		{0x11335, 0x11339, 1},
+		{0x1133d, 0x1133f, 1},
+		{0x11341, 0x11344, 1},
+		{0x11347, 0x11348, 1},
+		{0x1134b, 0x1134d, 1},
+		{0x11350, 0x11357, 7},
+		{0x1135d, 0x11363, 1},
+		{0x11400, 0x11437, 1},
+		{0x11440, 0x11441, 1},
+		{0x11445, 0x11447, 2},
+		{0x11448, 0x1144a, 1},
+		{0x11450, 0x11459, 1},
+		{0x1145f, 0x11461, 1},
+		{0x11480, 0x114b2, 1},
+		{0x114b9, 0x114bb, 2},
+		{0x114bc, 0x114be, 1},
+		{0x114c1, 0x114c4, 3},
+		{0x114c5, 0x114c7, 2},
+		{0x114d0, 0x114d9, 1},
+		{0x11580, 0x115b1, 1},
+		{0x115b8, 0x115bb, 1},
+		{0x115be, 0x115d8, 26},
+		{0x115d9, 0x115db, 1},
+		{0x11600, 0x11632, 1},
+		{0x1163b, 0x1163c, 1},
+		{0x1163e, 0x11644, 6},
+		{0x11650, 0x11659, 1},
+		{0x11680, 0x116aa, 1},
+		{0x116ac, 0x116ae, 2},
+		{0x116af, 0x116b6, 7},
+		{0x116b8, 0x116c0, 8},
+		{0x116c1, 0x116c9, 1},
+		{0x11700, 0x1171a, 1},
+		{0x11720, 0x11721, 1},
+		{0x11726, 0x11730, 10},
+		{0x11731, 0x11739, 1},
+		{0x11740, 0x11746, 1},
+		{0x11800, 0x1182e, 1},
+		{0x11838, 0x118c0, 136},
+		{0x118c1, 0x118e9, 1},
+		{0x118ff, 0x11906, 1},
+		{0x11909, 0x1190c, 3},
+		{0x1190d, 0x11913, 1},
+		{0x11915, 0x11916, 1},
+		{0x11918, 0x11935, 1},
+		{0x11937, 0x11938, 1},
+		{0x1193d, 0x1193f, 2},
+		{0x11940, 0x11942, 1},
+		{0x11950, 0x11959, 1},
+		{0x119a0, 0x119a7, 1},
+		{0x119aa, 0x119d3, 1},
+		{0x119dc, 0x119df, 1},
+		{0x119e1, 0x119e3, 2},
+		{0x119e4, 0x11a00, 28},
+		{0x11a07, 0x11a08, 1},
+		{0x11a0b, 0x11a32, 1},
+		{0x11a39, 0x11a3a, 1},
+		{0x11a50, 0x11a57, 7},
+		{0x11a58, 0x11a5c, 4},
+		{0x11a5d, 0x11a89, 1},
+		{0x11a97, 0x11a9d, 6},
+		{0x11ab0, 0x11af8, 1},
+		{0x11c00, 0x11c08, 1},
+		{0x11c0a, 0x11c2f, 1},
+		{0x11c3e, 0x11c40, 1},
+		{0x11c50, 0x11c59, 1},
+		{0x11c72, 0x11c8f, 1},
+		{0x11ca9, 0x11cb1, 8},
+		{0x11cb4, 0x11d00, 76},
+		{0x11d01, 0x11d06, 1},
+		{0x11d08, 0x11d09, 1},
+		{0x11d0b, 0x11d30, 1},
+		{0x11d46, 0x11d50, 10},
+		{0x11d51, 0x11d59, 1},
+		{0x11d60, 0x11d65, 1},
+		{0x11d67, 0x11d68, 1},
+		{0x11d6a, 0x11d8e, 1},
+		{0x11d93, 0x11d94, 1},
+		{0x11d96, 0x11d98, 2},
+		{0x11da0, 0x11da9, 1},
+		{0x11ee0, 0x11ef2, 1},
+		{0x11ef5, 0x11ef6, 1},
+		{0x11fb0, 0x12000, 80},
+		{0x12001, 0x12399, 1},
+		{0x12480, 0x12543, 1},
+		{0x12f90, 0x12ff0, 1},
+		{0x13000, 0x1342e, 1},
+		{0x14400, 0x14646, 1},
+		{0x16800, 0x16a38, 1},
+		{0x16a40, 0x16a5e, 1},
+		{0x16a60, 0x16a69, 1},
+		{0x16a70, 0x16abe, 1},
+		{0x16ac0, 0x16ac9, 1},
+		{0x16ad0, 0x16aed, 1},
+		{0x16b00, 0x16b2f, 1},
+		{0x16b40, 0x16b43, 1},
+		{0x16b50, 0x16b59, 1},
+		{0x16b63, 0x16b77, 1},
+		{0x16b7d, 0x16b8f, 1},
+		{0x16e60, 0x16e7f, 1},
+		{0x16f00, 0x16f4a, 1},
+		{0x16f50, 0x16f87, 1},
+		{0x16f93, 0x16f9f, 1},
+		{0x16fe0, 0x16fe1, 1},
+		{0x16fe3, 0x16ff0, 13},
+		{0x16ff1, 0x17000, 15},
+		{0x17001, 0x187f7, 1},
+		{0x18800, 0x18cd5, 1},
+		{0x18d00, 0x18d08, 1},
+		{0x1aff0, 0x1aff3, 1},
+		{0x1aff5, 0x1affb, 1},
+		{0x1affd, 0x1affe, 1},
+		{0x1b000, 0x1b122, 1},
+		{0x1b150, 0x1b152, 1},
+		{0x1b164, 0x1b167, 1},
+		{0x1b170, 0x1b2fb, 1},
+		{0x1bc00, 0x1bc6a, 1},
+		{0x1bc70, 0x1bc7c, 1},
+		{0x1bc80, 0x1bc88, 1},
+		{0x1bc90, 0x1bc99, 1},
+		{0x1df00, 0x1df1e, 1},
+		{0x1e100, 0x1e12c, 1},
+		{0x1e137, 0x1e13d, 1},
+		{0x1e140, 0x1e149, 1},
+		{0x1e14e, 0x1e14e, 1},
+		{0x1e290, 0x1e2ad, 1},
+		{0x1e2c0, 0x1e2eb, 1},
+		{0x1e2f0, 0x1e2f9, 1},
+		{0x1e7e0, 0x1e7e6, 1},
+		{0x1e7e8, 0x1e7eb, 1},
+		{0x1e7ed, 0x1e7ee, 1},
+		{0x1e7f0, 0x1e7fe, 1},
+		{0x20000, 0x2a6df, 1},
+		{0x2a700, 0x2b738, 1},
+		{0x2b740, 0x2b81d, 1},
+		{0x2b820, 0x2cea1, 1},
+		{0x2ceb0, 0x2ebe0, 1},
+		{0x30000, 0x3134a, 1},
+	},
+	LatinOffset: 3,
+}
+
+// bidi class R or AL, of the allowed code points
+var idnaBidiRTL = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x05d0, 0x05ea, 1},
+		{0x05ef, 0x05f4, 1},
+		{0x0620, 0x063f, 1},
+		{0x0641, 0x064a, 1},
+		{0x066e, 0x066f, 1},
+		{0x0671, 0x0674, 1},
+		{0x0679, 0x06d3, 1},
+		{0x06d5, 0x06e5, 16},
+		{0x06e6, 0x06ee, 8},
+		{0x06ef, 0x06fa, 11},
+		{0x06fb, 0x06ff, 1},
+		{0x0710, 0x0712, 2},
+		{0x0713, 0x072f, 1},
+		{0x074d, 0x07a5, 1},
+		{0x07b1, 0x07c0, 15},
+		{0x07c1, 0x07ea, 1},
+		{0x07f4, 0x07f5, 1},
+		{0x0800, 0x0815, 1},
+		{0x081a, 0x0824, 10},
+		{0x0828, 0x0840, 24},
+		{0x0841, 0x0858, 1},
+		{0x0860, 0x086a, 1},
+		{0x0870, 0x0887, 1},
+		{0x0889, 0x088e, 1},
+		{0x08a0, 0x08c9, 1},
+		{0xfe73, 0xfe73, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10800, 0x10805, 1},
+		{0x10808, 0x1080a, 2},
+		{0x1080b, 0x10835, 1},
+		{0x10837, 0x10838, 1},
+		{0x1083c, 0x1083f, 3},
+		{0x10840, 0x10855, 1},
+		{0x10860, 0x10876, 1},
+		{0x10880, 0x1089e, 1},
+		{0x108e0, 0x108f2, 1},
+		{0x108f4, 0x108f5, 1},
+		{0x10900, 0x10915, 1},
+		{0x10920, 0x10939, 1},
+		{0x10980, 0x109b7, 1},
+		{0x109be, 0x109bf, 1},
+		{0x10a00, 0x10a10, 16},
+		{0x10a11, 0x10a13, 1},
+		{0x10a15, 0x10a17, 1},
+		{0x10a19, 0x10a35, 1},
+		{0x10a60, 0x10a7c, 1},
+		{0x10a80, 0x10a9c, 1},
+		{0x10ac0, 0x10ac7, 1},
+		{0x10ac9, 0x10ae4, 1},
+		{0x10b00, 0x10b35, 1},
+		{0x10b40, 0x10b55, 1},
+		{0x10b60, 0x10b72, 1},
+		{0x10b80, 0x10b91, 1},
+		{0x10c00, 0x10c48, 1},
+		{0x10cc0, 0x10cf2, 1},
+		{0x10d00, 0x10d23, 1},
+		{0x10e80, 0x10ea9, 1},
+		{0x10eb0, 0x10eb1, 1},
+		{0x10f00, 0x10f1c, 1},
+		{0x10f27, 0x10f30, 9},
+		{0x10f31, 0x10f45, 1},
+		{0x10f70, 0x10f81, 1},
+		{0x10fb0, 0x10fc4, 1},
+		{0x10fe0, 0x10ff6, 1},
+		{0x1e800, 0x1e8c4, 1},
+		{0x1e922, 0x1e943, 1},
+		{0x1e94b, 0x1e950, 5},
+		{0x1e951, 0x1e959, 1},
+	},
+}
+
+// bidi class AN, of the allowed code points
+var idnaBidiAN = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0660, 0x0669, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10d30, 0x10d39, 1},
+	},
+}
+
+// bidi class EN, of the allowed code points
+var idnaBidiEN = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0030, 0x0039, 1},
+		{0x06f0, 0x06f9, 1},
+	},
+	LatinOffset: 1,
+}
+
+// bidi class NSM, of the allowed code points
+var idnaBidiNSM = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0300, 0x033f, 1},
+		{0x0342, 0x0346, 4},
+		{0x0347, 0x034e, 1},
+		{0x0350, 0x036f, 1},
+		{0x0483, 0x0487, 1},
+		{0x0591, 0x05bd, 1},
+		{0x05bf, 0x05c1, 2},
+		{0x05c2, 0x05c4, 2},
+		{0x05c5, 0x05c7, 2},
+		{0x0610, 0x061a, 1},
+		{0x064b, 0x065f, 1},
+		{0x0670, 0x06d6, 102},
+		{0x06d7, 0x06dc, 1},
+		{0x06df, 0x06e4, 1},
+		{0x06e7, 0x06e8, 1},
+		{0x06ea, 0x06ed, 1},
+		{0x0711, 0x0730, 31},
+		{0x0731, 0x074a, 1},
+		{0x07a6, 0x07b0, 1},
+		{0x07eb, 0x07f3, 1},
+		{0x07fd, 0x0816, 25},
+		{0x0817, 0x0819, 1},
+		{0x081b, 0x0823, 1},
+		{0x0825, 0x0827, 1},
+		{0x0829, 0x082d, 1},
+		{0x0859, 0x085b, 1},
+		{0x0898, 0x089f, 1},
+		{0x08ca, 0x08e1, 1},
+		{0x08e3, 0x0902, 1},
+		{0x093a, 0x093c, 2},
+		{0x0941, 0x0948, 1},
+		{0x094d, 0x0951, 4},
+		{0x0952, 0x0957, 1},
+		{0x0962, 0x0963, 1},
+		{0x0981, 0x09bc, 59},
+		{0x09c1, 0x09c4, 1},
+		{0x09cd, 0x09e2, 21},
+		{0x09e3, 0x09fe, 27},
+		{0x0a01, 0x0a02, 1},
+		{0x0a3c, 0x0a41, 5},
+		{0x0a42, 0x0a47, 5},
+		{0x0a48, 0x0a4b, 3},
+		{0x0a4c, 0x0a4d, 1},
+		{0x0a51, 0x0a70, 31},
+		{0x0a71, 0x0a75, 4},
+		{0x0a81, 0x0a82, 1},
+		{0x0abc, 0x0ac1, 5},
+		{0x0ac2, 0x0ac5, 1},
+		{0x0ac7, 0x0ac8, 1},
+		{0x0acd, 0x0ae2, 21},
+		{0x0ae3, 0x0afa, 23},
+		{0x0afb, 0x0aff, 1},
+		{0x0b01, 0x0b3c, 59},
+		{0x0b3f, 0x0b41, 2},
+		{0x0b42, 0x0b44, 1},
+		{0x0b4d, 0x0b55, 8},
+		{0x0b56, 0x0b62, 12},
+		{0x0b63, 0x0b82, 31},
+		{0x0bc0, 0x0bcd, 13},
+		{0x0c00, 0x0c04, 4},
+		{0x0c3c, 0x0c3e, 2},
+		{0x0c3f, 0x0c40, 1},
+		{0x0c46, 0x0c48, 1},
+		{0x0c4a, 0x0c4d, 1},
+		{0x0c55, 0x0c56, 1},
+		{0x0c62, 0x0c63, 1},
+		{0x0c81, 0x0cbc, 59},
+		{0x0ccc, 0x0ccd, 1},
+		{0x0ce2, 0x0ce3, 1},
+		{0x0d00, 0x0d01, 1},
+		{0x0d3b, 0x0d3c, 1},
+		{0x0d41, 0x0d44, 1},
+		{0x0d4d, 0x0d62, 21},
+		{0x0d63, 0x0d81, 30},
+		{0x0dca, 0x0dd2, 8},
+		{0x0dd3, 0x0dd4, 1},
+		{0x0dd6, 0x0e31, 91},
+		{0x0e34, 0x0e3a, 1},
+		{0x0e47, 0x0e4e, 1},
+		{0x0eb1, 0x0eb4, 3},
+		{0x0eb5, 0x0ebc, 1},
+		{0x0ec8, 0x0ecd, 1},
+		{0x0f18, 0x0f19, 1},
+		{0x0f35, 0x0f39, 2},
+		{0x0f71, 0x0f72, 1},
+		{0x0f74, 0x0f7a, 6},
+		{0x0f7b, 0x0f7e, 1},
+		{0x0f80, 0x0f82, 2},
+		{0x0f83, 0x0f84, 1},
+		{0x0f86, 0x0f87, 1},
+		{0x0f8d, 0x0f92, 1},
+		{0x0f94, 0x0f97, 1},
+		{0x0f99, 0x0f9c, 1},
+		{0x0f9e, 0x0fa1, 1},
+		{0x0fa3, 0x0fa6, 1},
+		{0x0fa8, 0x0fab, 1},
+		{0x0fad, 0x0fb8, 1},
+		{0x0fba, 0x0fbc, 1},
+		{0x0fc6, 0x102d, 103},
+		{0x102e, 0x1030, 1},
+		{0x1032, 0x1037, 1},
+		{0x1039, 0x103a, 1},
+		{0x103d, 0x103e, 1},
+		{0x1058, 0x1059, 1},
+		{0x105e, 0x1060, 1},
+		{0x1071, 0x1074, 1},
+		{0x1082, 0x1085, 3},
+		{0x1086, 0x108d, 7},
+		{0x109d, 0x109d, 1},
+		{0x135d, 0x135f, 1},
+		{0x1712, 0x1714, 1},
+		{0x1732, 0x1733, 1},
+		{0x1752, 0x1753, 1},
+		{0x1772, 0x1773, 1},
+		{0x17b7, 0x17bd, 1},
+		{0x17c6, 0x17c9, 3},
+		{0x17ca, 0x17d3, 1},
+		{0x17dd, 0x1885, 168},
+		{0x1886, 0x18a9, 35},
+		{0x1920, 0x1922, 1},
+		{0x1927, 0x1928, 1},
+		{0x1932, 0x1939, 7},
+		{0x193a, 0x193b, 1},
+		{0x1a17, 0x1a18, 1},
+		{0x1a1b, 0x1a56, 59},
+		{0x1a58, 0x1a5e, 1},
+		{0x1a60, 0x1a62, 2},
+		{0x1a65, 0x1a6c, 1},
+		{0x1a73, 0x1a7c, 1},
+		{0x1a7f, 0x1ab0, 49},
+		{0x1ab1, 0x1abd, 1},
+		{0x1abf, 0x1ace, 1},
+		{0x1b00, 0x1b03, 1},
+		{0x1b34, 0x1b36, 2},
+		{0x1b37, 0x1b3a, 1},
+		{0x1b3c, 0x1b42, 6},
+		{0x1b6b, 0x1b73, 1},
+		{0x1b80, 0x1b81, 1},
+		{0x1ba2, 0x1ba5, 1},
+		{0x1ba8, 0x1ba9, 1},
+		{0x1bab, 0x1bad, 1},
+		{0x1be6, 0x1be8, 2},
+		{0x1be9, 0x1bed, 4},
+		{0x1bef, 0x1bf1, 1},
+		{0x1c2c, 0x1c33, 1},
+		{0x1c36, 0x1c37, 1},
+		{0x1cd0, 0x1cd2, 1},
+		{0x1cd4, 0x1ce0, 1},
+		{0x1ce2, 0x1ce8, 1},
+		{0x1ced, 0x1cf4, 7},
+		{0x1cf8, 0x1cf9, 1},
+		{0x1dc0, 0x1dff, 1},
+		{0x2cef, 0x2cf1, 1},
+		{0x2d7f, 0x2de0, 97},
+		{0x2de1, 0x2dff, 1},
+		{0x302a, 0x302d, 1},
+		{0x3099, 0x309a, 1},
+		{0xa66f, 0xa674, 5},
+		{0xa675, 0xa67d, 1},
+		{0xa69e, 0xa69f, 1},
+		{0xa6f0, 0xa6f1, 1},
+		{0xa802, 0xa806, 4},
+		{0xa80b, 0xa825, 26},
+		{0xa826, 0xa82c, 6},
+		{0xa8c4, 0xa8c5, 1},
+		{0xa8e0, 0xa8f1, 1},
+		{0xa8ff, 0xa926, 39},
+		{0xa927, 0xa92d, 1},
+		{0xa947, 0xa951, 1},
+		{0xa980, 0xa982, 1},
+		{0xa9b3, 0xa9b6, 3},
+		{0xa9b7, 0xa9b9, 1},
+		{0xa9bc, 0xa9bd, 1},
+		{0xa9e5, 0xaa29, 68},
+		{0xaa2a, 0xaa2e, 1},
+		{0xaa31, 0xaa32, 1},
+		{0xaa35, 0xaa36, 1},
+		{0xaa43, 0xaa4c, 9},
+		{0xaa7c, 0xaab0, 52},
+		{0xaab2, 0xaab4, 1},
+		{0xaab7, 0xaab8, 1},
+		{0xaabe, 0xaabf, 1},
+		{0xaac1, 0xaaec, 43},
+		{0xaaed, 0xaaf6, 9},
+		{0xabe5, 0xabe8, 3},
+		{0xabed, 0xabed, 1},
+		{0xfb1e, 0xfb1e, 1},
+		{0xfe20, 0xfe2f, 1},
+	},
+	R32: []unicode.Range32{
+		{0x101fd, 0x102e0, 227},
+		{0x10376, 0x1037a, 1},
+		{0x10a01, 0x10a03, 1},
+		{0x10a05, 0x10a06, 1},
+		{0x10a0c, 0x10a0f, 1},
+		{0x10a38, 0x10a3a, 1},
+		{0x10a3f, 0x10ae5, 166},
+		{0x10ae6, 0x10ae6, 1},
+		{0x10d24, 0x10d27, 1},
+		{0x10eab, 0x10eac, 1},
+		{0x10f46, 0x10f50, 1},
+		{0x10f82, 0x10f85, 1},
+		{0x11001, 0x11038, 55},
+		{0x11039, 0x11046, 1},
+		{0x11070, 0x11073, 3},
+		{0x11074, 0x1107f, 11},
+		{0x11080, 0x11081, 1},
+		{0x110b3, 0x110b6, 1},
+		{0x110b9, 0x110ba, 1},
+		{0x110c2, 0x11100, 62},
+		{0x11101, 0x11102, 1},
+		{0x11127, 0x1112b, 1},
+		{0x1112d, 0x11134, 1},
+		{0x11173, 0x11180, 13},
+		{0x11181, 0x111b6, 53},
+		{0x111b7, 0x111be, 1},
+		{0x111c9, 0x111cc, 1},
+		{0x111cf, 0x1122f, 96},
+		{0x11230, 0x11231, 1},
+		{0x11234, 0x11236, 2},
+		{0x11237, 0x1123e, 7},
+		{0x112df, 0x112e3, 4},
+		{0x112e4, 0x112ea, 1},
+		{0x11300, 0x11301, 1},
+		{0x1133b, 0x1133c, 1},
+		{0x11340, 0x11366, 38},
+		{0x11367, 0x1136c, 1},
+		{0x11370, 0x11374, 1},
+		{0x11438, 0x1143f, 1},
+		{0x11442, 0x11444, 1},
+		{0x11446, 0x1145e, 24},
+		{0x114b3, 0x114b8, 1},
+		{0x114ba, 0x114bf, 5},
+		{0x114c0, 0x114c2, 2},
+		{0x114c3, 0x115b2, 239},
+		{0x115b3, 0x115b5, 1},
+		{0x115bc, 0x115bd, 1},
+		{0x115bf, 0x115c0, 1},
+		{0x115dc, 0x115dd, 1},
+		{0x11633, 0x1163a, 1},
+		{0x1163d, 0x1163f, 2},
+		{0x11640, 0x116ab, 107},
+		{0x116ad, 0x116b0, 3},
+		{0x116b1, 0x116b5, 1},
+		{0x116b7, 0x1171d, 102},
+		{0x1171e, 0x1171f, 1},
+		{0x11722, 0x11725, 1},
+		{0x11727, 0x1172b, 1},
+		{0x1182f, 0x11837, 1},
+		{0x11839, 0x1183a, 1},
+		{0x1193b, 0x1193c, 1},
+		{0x1193e, 0x11943, 5},
+		{0x119d4, 0x119d7, 1},
+		{0x119da, 0x119db, 1},
+		{0x119e0, 0x11a01, 33},
+		{0x11a02, 0x11a06, 1},
+		{0x11a09, 0x11a0a, 1},
+		{0x11a33, 0x11a38, 1},
+		{0x11a3b, 0x11a3e, 1},
+		{0x11a47, 0x11a51, 10},
+		{0x11a52, 0x11a56, 1},
+		{0x11a59, 0x11a5b, 1},
+		{0x11a8a, 0x11a96, 1},
+		{0x11a98, 0x11a99, 1},
+		{0x11c30, 0x11c36, 1},
+		{0x11c38, 0x11c3d, 1},
+		{0x11c92, 0x11ca7, 1},
+		{0x11caa, 0x11cb0, 1},
+		{0x11cb2, 0x11cb3, 1},
+		{0x11cb5, 0x11cb6, 1},
+		{0x11d31, 0x11d36, 1},
+		{0x11d3a, 0x11d3c, 2},
+		{0x11d3d, 0x11d3f, 2},
+		{0x11d40, 0x11d45, 1},
+		{0x11d47, 0x11d90, 73},
+		{0x11d91, 0x11d95, 4},
+		{0x11d97, 0x11d97, 1},
+		{0x11ef3, 0x11ef4, 1},
+		{0x16af0, 0x16af4, 1},
+		{0x16b30, 0x16b36, 1},
+		{0x16f4f, 0x16f8f, 64},
+		{0x16f90, 0x16f92, 1},
+		{0x16fe4, 0x16fe4, 1},
+		{0x1bc9d, 0x1bc9e, 1},
+		{0x1cf00, 0x1cf2d, 1},
+		{0x1cf30, 0x1cf46, 1},
+		{0x1da00, 0x1da36, 1},
+		{0x1da3b, 0x1da6c, 1},
+		{0x1da75, 0x1da84, 15},
+		{0x1da9b, 0x1da9f, 1},
+		{0x1daa1, 0x1daaf, 1},
+		{0x1e000, 0x1e006, 1},
+		{0x1e008, 0x1e018, 1},
+		{0x1e01b, 0x1e021, 1},
+		{0x1e023, 0x1e024, 1},
+		{0x1e026, 0x1e02a, 1},
+		{0x1e130, 0x1e136, 1},
+		{0x1e2ae, 0x1e2ec, 62},
+		{0x1e2ed, 0x1e2ef, 1},
+		{0x1e8d0, 0x1e8d6, 1},
+		{0x1e944, 0x1e94a, 1},
+	},
+}
+
+// bidi class ES, CS, ET, ON or BN, of the allowed code points
+var idnaBidiON = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x002d, 0x00b7, 138},
+		{0x02b9, 0x02ba, 1},
+		{0x02c6, 0x02cf, 1},
+		{0x02ec, 0x0375, 137},
+		{0x200c, 0x200d, 1},
+		{0x2e2f, 0x2e2f, 1},
+		{0x30fb, 0x30fb, 1},
+		{0xa67f, 0xa717, 152},
+		{0xa718, 0xa71f, 1},
+		{0xa788, 0xa788, 1},
+	},
+	LatinOffset: 1,
+}
+
+// the nonzero canonical combining classes, in ranges of one class
+var idnaCombining = []idnaClassRange{
+	{0x0300, 0x0314, 230},
+	{0x0315, 0x0315, 232},
+	{0x0316, 0x0319, 220},
+	{0x031a, 0x031a, 232},
+	{0x031b, 0x031b, 216},
+	{0x031c, 0x0320, 220},
+	{0x0321, 0x0322, 202},
+	{0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202},
+	{0x0329, 0x0333, 220},
+	{0x0334, 0x0338, 1},
+	{0x0339, 0x033c, 220},
+	{0x033d, 0x0344, 230},
+	{0x0345, 0x0345, 240},
+	{0x0346, 0x0346, 230},
+	{0x0347, 0x0349, 220},
+	{0x034a, 0x034c, 230},
+	{0x034d, 0x034e, 220},
+	{0x0350, 0x0352, 230},
+	{0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230},
+	{0x0358, 0x0358, 232},
+	{0x0359, 0x035a, 220},
+	{0x035b, 0x035b, 230},
+	{0x035c, 0x035c, 233},
+	{0x035d, 0x035e, 234},
+	{0x035f, 0x035f, 233},
+	{0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233},
+	{0x0363, 0x036f, 230},
+	{0x0483, 0x0487, 230},
+	{0x0591, 0x0591, 220},
+	{0x0592, 0x0595, 230},
+	{0x0596, 0x0596, 220},
+	{0x0597, 0x0599, 230},
+	{0x059a, 0x059a, 222},
+	{0x059b, 0x059b, 220},
+	{0x059c, 0x05a1, 230},
+	{0x05a2, 0x05a7, 220},
+	{0x05a8, 0x05a9, 230},
+	{0x05aa, 0x05aa, 220},
+	{0x05ab, 0x05ac, 230},
+	{0x05ad, 0x05ad, 222},
+	{0x05ae, 0x05ae, 228},
+	{0x05af, 0x05af, 230},
+	{0x05b0, 0x05b0, 10},
+	{0x05b1, 0x05b1, 11},
+	{0x05b2, 0x05b2, 12},
+	{0x05b3, 0x05b3, 13},
+	{0x05b4, 0x05b4, 14},
+	{0x05b5, 0x05b5, 15},
+	{0x05b6, 0x05b6, 16},
+	{0x05b7, 0x05b7, 17},
+	{0x05b8, 0x05b8, 18},
+	{0x05b9, 0x05ba, 19},
+	{0x05bb, 0x05bb, 20},
+	{0x05bc, 0x05bc, 21},
+	{0x05bd, 0x05bd, 22},
+	{0x05bf, 0x05bf, 23},
+	{0x05c1, 0x05c1, 24},
+	{0x05c2, 0x05c2, 25},
+	{0x05c4, 0x05c4, 230},
+	{0x05c5, 0x05c5, 220},
+	{0x05c7, 0x05c7, 18},
+	{0x0610, 0x0617, 230},
+	{0x0618, 0x0618, 30},
+	{0x0619, 0x0619, 31},
+	{0x061a, 0x061a, 32},
+	{0x064b, 0x064b, 27},
+	{0x064c, 0x064c, 28},
+	{0x064d, 0x064d, 29},
+	{0x064e, 0x064e, 30},
+	{0x064f, 0x064f, 31},
+	{0x0650, 0x0650, 32},
+	{0x0651, 0x0651, 33},
+	{0x0652, 0x0652, 34},
+	{0x0653, 0x0654, 230},
+	{0x0655, 0x0656, 220},
+	{0x0657, 0x065b, 230},
+	{0x065c, 0x065c, 220},
+	{0x065d, 0x065e, 230},
+	{0x065f, 0x065f, 220},
+	{0x0670, 0x0670, 35},
+	{0x06d6, 0x06dc, 230},
+	{0x06df, 0x06e2, 230},
+	{0x06e3, 0x06e3, 220},
+	{0x06e4, 0x06e4, 230},
+	{0x06e7, 0x06e8, 230},
+	{0x06ea, 0x06ea, 220},
+	{0x06eb, 0x06ec, 230},
+	{0x06ed, 0x06ed, 220},
+	{0x0711, 0x0711, 36},
+	{0x0730, 0x0730, 230},
+	{0x0731, 0x0731, 220},
+	{0x0732, 0x0733, 230},
+	{0x0734, 0x0734, 220},
+	{0x0735, 0x0736, 230},
+	{0x0737, 0x0739, 220},
+	{0x073a, 0x073a, 230},
+	{0x073b, 0x073c, 220},
+	{0x073d, 0x073d, 230},
+	{0x073e, 0x073e, 220},
+	{0x073f, 0x0741, 230},
+	{0x0742, 0x0742, 220},
+	{0x0743, 0x0743, 230},
+	{0x0744, 0x0744, 220},
+	{0x0745, 0x0745, 230},
+	{0x0746, 0x0746, 220},
+	{0x0747, 0x0747, 230},
+	{0x0748, 0x0748, 220},
+	{0x0749, 0x074a, 230},
+	{0x07eb, 0x07f1, 230},
+	{0x07f2, 0x07f2, 220},
+	{0x07f3, 0x07f3, 230},
+	{0x07fd, 0x07fd, 220},
+	{0x0816, 0x0819, 230},
+	{0x081b, 0x0823, 230},
+	{0x0825, 0x0827, 230},
+	{0x0829, 0x082d, 230},
+	{0x0859, 0x085b, 220},
+	{0x0898, 0x0898, 230},
+	{0x0899, 0x089b, 220},
+	{0x089c, 0x089f, 230},
+	{0x08ca, 0x08ce, 230},
+	{0x08cf, 0x08d3, 220},
+	{0x08d4, 0x08e1, 230},
+	{0x08e3, 0x08e3, 220},
+	{0x08e4, 0x08e5, 230},
+	{0x08e6, 0x08e6, 220},
+	{0x08e7, 0x08e8, 230},
+	{0x08e9, 0x08e9, 220},
+	{0x08ea, 0x08ec, 230},
+	{0x08ed, 0x08ef, 220},
+	{0x08f0, 0x08f0, 27},
+	{0x08f1, 0x08f1, 28},
+	{0x08f2, 0x08f2, 29},
+	{0x08f3, 0x08f5, 230},
+	{0x08f6, 0x08f6, 220},
+	{0x08f7, 0x08f8, 230},
+	{0x08f9, 0x08fa, 220},
+	{0x08fb, 0x08ff, 230},
+	{0x093c, 0x093c, 7},
+	{0x094d, 0x094d, 9},
+	{0x0951, 0x0951, 230},
+	{0x0952, 0x0952, 220},
+	{0x0953, 0x0954, 230},
+	{0x09bc, 0x09bc, 7},
+	{0x09cd, 0x09cd, 9},
+	{0x09fe, 0x09fe, 230},
+	{0x0a3c, 0x0a3c, 7},
+	{0x0a4d, 0x0a4d, 9},
+	{0x0abc, 0x0abc, 7},
+	{0x0acd, 0x0acd, 9},
+	{0x0b3c, 0x0b3c, 7},
+	{0x0b4d, 0x0b4d, 9},
+	{0x0bcd, 0x0bcd, 9},
+	{0x0c3c, 0x0c3c, 7},
+	{0x0c4d, 0x0c4d, 9},
+	{0x0c55, 0x0c55, 84},
+	{0x0c56, 0x0c56, 91},
+	{0x0cbc, 0x0cbc, 7},
+	{0x0ccd, 0x0ccd, 9},
+	{0x0d3b, 0x0d3c, 9},
+	{0x0d4d, 0x0d4d, 9},
+	{0x0dca, 0x0dca, 9},
+	{0x0e38, 0x0e39, 103},
+	{0x0e3a, 0x0e3a, 9},
+	{0x0e48, 0x0e4b, 107},
+	{0x0eb8, 0x0eb9, 118},
+	{0x0eba, 0x0eba, 9},
+	{0x0ec8, 0x0ecb, 122},
+	{0x0f18, 0x0f19, 220},
+	{0x0f35, 0x0f35, 220},
+	{0x0f37, 0x0f37, 220},
+	{0x0f39, 0x0f39, 216},
+	{0x0f71, 0x0f71, 129},
+	{0x0f72, 0x0f72, 130},
+	{0x0f74, 0x0f74, 132},
+	{0x0f7a, 0x0f7d, 130},
+	{0x0f80, 0x0f80, 130},
+	{0x0f82, 0x0f83, 230},
+	{0x0f84, 0x0f84, 9},
+	{0x0f86, 0x0f87, 230},
+	{0x0fc6, 0x0fc6, 220},
+	{0x1037, 0x1037, 7},
+	{0x1039, 0x103a, 9},
+	{0x108d, 0x108d, 220},
+	{0x135d, 0x135f, 230},
+	{0x1714, 0x1715, 9},
+	{0x1734, 0x1734, 9},
+	{0x17d2, 0x17d2, 9},
+	{0x17dd, 0x17dd, 230},
+	{0x18a9, 0x18a9, 228},
+	{0x1939, 0x1939, 222},
+	{0x193a, 0x193a, 230},
+	{0x193b, 0x193b, 220},
+	{0x1a17, 0x1a17, 230},
+	{0x1a18, 0x1a18, 220},
+	{0x1a60, 0x1a60, 9},
+	{0x1a75, 0x1a7c, 230},
+	{0x1a7f, 0x1a7f, 220},
+	{0x1ab0, 0x1ab4, 230},
+	{0x1ab5, 0x1aba, 220},
+	{0x1abb, 0x1abc, 230},
+	{0x1abd, 0x1abd, 220},
+	{0x1abf, 0x1ac0, 220},
+	{0x1ac1, 0x1ac2, 230},
+	{0x1ac3, 0x1ac4, 220},
+	{0x1ac5, 0x1ac9, 230},
+	{0x1aca, 0x1aca, 220},
+	{0x1acb, 0x1ace, 230},
+	{0x1b34, 0x1b34, 7},
+	{0x1b44, 0x1b44, 9},
+	{0x1b6b, 0x1b6b, 230},
+	{0x1b6c, 0x1b6c, 220},
+	{0x1b6d, 0x1b73, 230},
+	{0x1baa, 0x1bab, 9},
+	{0x1be6, 0x1be6, 7},
+	{0x1bf2, 0x1bf3, 9},
+	{0x1c37, 0x1c37, 7},
+	{0x1cd0, 0x1cd2, 230},
+	{0x1cd4, 0x1cd4, 1},
+	{0x1cd5, 0x1cd9, 220},
+	{0x1cda, 0x1cdb, 230},
+	{0x1cdc, 0x1cdf, 220},
+	{0x1ce0, 0x1ce0, 230},
+	{0x1ce2, 0x1ce8, 1},
+	{0x1ced, 0x1ced, 220},
+	{0x1cf4, 0x1cf4, 230},
+	{0x1cf8, 0x1cf9, 230},
+	{0x1dc0, 0x1dc1, 230},
+	{0x1dc2, 0x1dc2, 220},
+	{0x1dc3, 0x1dc9, 230},
+	{0x1dca, 0x1dca, 220},
+	{0x1dcb, 0x1dcc, 230},
+	{0x1dcd, 0x1dcd, 234},
+	{0x1dce, 0x1dce, 214},
+	{0x1dcf, 0x1dcf, 220},
+	{0x1dd0, 0x1dd0, 202},
+	{0x1dd1, 0x1df5, 230},
+	{0x1df6, 0x1df6, 232},
+	{0x1df7, 0x1df8, 228},
+	{0x1df9, 0x1df9, 220},
+	{0x1dfa, 0x1dfa, 218},
+	{0x1dfb, 0x1dfb, 230},
+	{0x1dfc, 0x1dfc, 233},
+	{0x1dfd, 0x1dfd, 220},
+	{0x1dfe, 0x1dfe, 230},
+	{0x1dff, 0x1dff, 220},
+	{0x20d0, 0x20d1, 230},
+	{0x20d2, 0x20d3, 1},
+	{0x20d4, 0x20d7, 230},
+	{0x20d8, 0x20da, 1},
+	{0x20db, 0x20dc, 230},
+	{0x20e1, 0x20e1, 230},
+	{0x20e5, 0x20e6, 1},
+	{0x20e7, 0x20e7, 230},
+	{0x20e8, 0x20e8, 220},
+	{0x20e9, 0x20e9, 230},
+	{0x20ea, 0x20eb, 1},
+	{0x20ec, 0x20ef, 220},
+	{0x20f0, 0x20f0, 230},
+	{0x2cef, 0x2cf1, 230},
+	{0x2d7f, 0x2d7f, 9},
+	{0x2de0, 0x2dff, 230},
+	{0x302a, 0x302a, 218},
+	{0x302b, 0x302b, 228},
+	{0x302c, 0x302c, 232},
+	{0x302d, 0x302d, 222},
+	{0x302e, 0x302f, 224},
+	{0x3099, 0x309a, 8},
+	{0xa66f, 0xa66f, 230},
+	{0xa674, 0xa67d, 230},
+	{0xa69e, 0xa69f, 230},
+	{0xa6f0, 0xa6f1, 230},
+	{0xa806, 0xa806, 9},
+	{0xa82c, 0xa82c, 9},
+	{0xa8c4, 0xa8c4, 9},
+	{0xa8e0, 0xa8f1, 230},
+	{0xa92b, 0xa92d, 220},
+	{0xa953, 0xa953, 9},
+	{0xa9b3, 0xa9b3, 7},
+	{0xa9c0, 0xa9c0, 9},
+	{0xaab0, 0xaab0, 230},
+	{0xaab2, 0xaab3, 230},
+	{0xaab4, 0xaab4, 220},
+	{0xaab7, 0xaab8, 230},
+	{0xaabe, 0xaabf, 230},
+	{0xaac1, 0xaac1, 230},
+	{0xaaf6, 0xaaf6, 9},
+	{0xabed, 0xabed, 9},
+	{0xfb1e, 0xfb1e, 26},
+	{0xfe20, 0xfe26, 230},
+	{0xfe27, 0xfe2d, 220},
+	{0xfe2e, 0xfe2f, 230},
+	{0x101fd, 0x101fd, 220},
+	{0x102e0, 0x102e0, 220},
+	{0x10376, 0x1037a, 230},
+	{0x10a0d, 0x10a0d, 220},
+	{0x10a0f, 0x10a0f, 230},
+	{0x10a38, 0x10a38, 230},
+	{0x10a39, 0x10a39, 1},
+	{0x10a3a, 0x10a3a, 220},
+	{0x10a3f, 0x10a3f, 9},
+	{0x10ae5, 0x10ae5, 230},
+	{0x10ae6, 0x10ae6, 220},
+	{0x10d24, 0x10d27, 230},
+	{0x10eab, 0x10eac, 230},
+	{0x10f46, 0x10f47, 220},
+	{0x10f48, 0x10f4a, 230},
+	{0x10f4b, 0x10f4b, 220},
+	{0x10f4c, 0x10f4c, 230},
+	{0x10f4d, 0x10f50, 220},
+	{0x10f82, 0x10f82, 230},
+	{0x10f83, 0x10f83, 220},
+	{0x10f84, 0x10f84, 230},
+	{0x10f85, 0x10f85, 220},
+	{0x11046, 0x11046, 9},
+	{0x11070, 0x11070, 9},
+	{0x1107f, 0x1107f, 9},
+	{0x110b9, 0x110b9, 9},
+	{0x110ba, 0x110ba, 7},
+	{0x11100, 0x11102, 230},
+	{0x11133, 0x11134, 9},
+	{0x11173, 0x11173, 7},
+	{0x111c0, 0x111c0, 9},
+	{0x111ca, 0x111ca, 7},
+	{0x11235, 0x11235, 9},
+	{0x11236, 0x11236, 7},
+	{0x112e9, 0x112e9, 7},
+	{0x112ea, 0x112ea, 9},
+	{0x1133b, 0x1133c, 7},
+	{0x1134d, 0x1134d, 9},
+	{0x11366, 0x1136c, 230},
+	{0x11370, 0x11374, 230},
+	{0x11442, 0x11442, 9},
+	{0x11446, 0x11446, 7},
+	{0x1145e, 0x1145e, 230},
+	{0x114c2, 0x114c2, 9},
+	{0x114c3, 0x114c3, 7},
+	{0x115bf, 0x115bf, 9},
+	{0x115c0, 0x115c0, 7},
+	{0x1163f, 0x1163f, 9},
+	{0x116b6, 0x116b6, 9},
+	{0x116b7, 0x116b7, 7},
+	{0x1172b, 0x1172b, 9},
+	{0x11839, 0x11839, 9},
+	{0x1183a, 0x1183a, 7},
+	{0x1193d, 0x1193e, 9},
+	{0x11943, 0x11943, 7},
+	{0x119e0, 0x119e0, 9},
+	{0x11a34, 0x11a34, 9},
+	{0x11a47, 0x11a47, 9},
+	{0x11a99, 0x11a99, 9},
+	{0x11c3f, 0x11c3f, 9},
+	{0x11d42, 0x11d42, 7},
+	{0x11d44, 0x11d45, 9},
+	{0x11d97, 0x11d97, 9},
+	{0x16af0, 0x16af4, 1},
+	{0x16b30, 0x16b36, 230},
+	{0x16ff0, 0x16ff1, 6},
+	{0x1bc9e, 0x1bc9e, 1},
+	{0x1d165, 0x1d166, 216},
+	{0x1d167, 0x1d169, 1},
+	{0x1d16d, 0x1d16d, 226},
+	{0x1d16e, 0x1d172, 216},
+	{0x1d17b, 0x1d182, 220},
+	{0x1d185, 0x1d189, 230},
+	{0x1d18a, 0x1d18b, 220},
+	{0x1d1aa, 0x1d1ad, 230},
+	{0x1d242, 0x1d244, 230},
+	{0x1e000, 0x1e006, 230},
+	{0x1e008, 0x1e018, 230},
+	{0x1e01b, 0x1e021, 230},
+	{0x1e023, 0x1e024, 230},
+	{0x1e026, 0x1e02a, 230},
+	{0x1e130, 0x1e136, 230},
+	{0x1e2ae, 0x1e2ae, 230},
+	{0x1e2ec, 0x1e2ef, 230},
+	{0x1e8d0, 0x1e8d6, 220},
+	{0x1e944, 0x1e949, 230},
+	{0x1e94a, 0x1e94a, 7},
+}
+
+// the canonical decompositions of the allowed code points
+var idnaDecomp = map[rune]string{
+	0x00e0:  "\u0061\u0300",
+	0x00e1:  "\u0061\u0301",
+	0x00e2:  "\u0061\u0302",
+	0x00e3:  "\u0061\u0303",
+	0x00e4:  "\u0061\u0308",
+	0x00e5:  "\u0061\u030a",
+	0x00e7:  "\u0063\u0327",
+	0x00e8:  "\u0065\u0300",
+	0x00e9:  "\u0065\u0301",
+	0x00ea:  "\u0065\u0302",
+	0x00eb:  "\u0065\u0308",
+	0x00ec:  "\u0069\u0300",
+	0x00ed:  "\u0069\u0301",
+	0x00ee:  "\u0069\u0302",
+	0x00ef:  "\u0069\u0308",
+	0x00f1:  "\u006e\u0303",
+	0x00f2:  "\u006f\u0300",
+	0x00f3:  "\u006f\u0301",
+	0x00f4:  "\u006f\u0302",
+	0x00f5:  "\u006f\u0303",
+	0x00f6:  "\u006f\u0308",
+	0x00f9:  "\u0075\u0300",
+	0x00fa:  "\u0075\u0301",
+	0x00fb:  "\u0075\u0302",
+	0x00fc:  "\u0075\u0308",
+	0x00fd:  "\u0079\u0301",
+	0x00ff:  "\u0079\u0308",
+	0x0101:  "\u0061\u0304",
+	0x0103:  "\u0061\u0306",
+	0x0105:  "\u0061\u0328",
+	0x0107:  "\u0063\u0301",
+	0x0109:  "\u0063\u0302",
+	0x010b:  "\u0063\u0307",
+	0x010d:  "\u0063\u030c",
+	0x010f:  "\u0064\u030c",
+	0x0113:  "\u0065\u0304",
+	0x0115:  "\u0065\u0306",
+	0x0117:  "\u0065\u0307",
+	0x0119:  "\u0065\u0328",
+	0x011b:  "\u0065\u030c",
+	0x011d:  "\u0067\u0302",
+	0x011f:  "\u0067\u0306",
+	0x0121:  "\u0067\u0307",
+	0x0123:  "\u0067\u0327",
+	0x0125:  "\u0068\u0302",
+	0x0129:  "\u0069\u0303",
+	0x012b:  "\u0069\u0304",
+	0x012d:  "\u0069\u0306",
+	0x012f:  "\u0069\u0328",
+	0x0135:  "\u006a\u0302",
+	0x0137:  "\u006b\u0327",
+	0x013a:  "\u006c\u0301",
+	0x013c:  "\u006c\u0327",
+	0x013e:  "\u006c\u030c",
+	0x0144:  "\u006e\u0301",
+	0x0146:  "\u006e\u0327",
+	0x0148:  "\u006e\u030c",
+	0x014d:  "\u006f\u0304",
+	0x014f:  "\u006f\u0306",
+	0x0151:  "\u006f\u030b",
+	0x0155:  "\u0072\u0301",
+	0x0157:  "\u0072\u0327",
+	0x0159:  "\u0072\u030c",
+	0x015b:  "\u0073\u0301",
+	0x015d:  "\u0073\u0302",
+	0x015f:  "\u0073\u0327",
+	0x0161:  "\u0073\u030c",
+	0x0163:  "\u0074\u0327",
+	0x0165:  "\u0074\u030c",
+	0x0169:  "\u0075\u0303",
+	0x016b:  "\u0075\u0304",
+	0x016d:  "\u0075\u0306",
+	0x016f:  "\u0075\u030a",
+	0x0171:  "\u0075\u030b",
+	0x0173:  "\u0075\u0328",
+	0x0175:  "\u0077\u0302",
+	0x0177:  "\u0079\u0302",
+	0x017a:  "\u007a\u0301",
+	0x017c:  "\u007a\u0307",
+	0x017e:  "\u007a\u030c",
+	0x01a1:  "\u006f\u031b",
+	0x01b0:  "\u0075\u031b",
+	0x01ce:  "\u0061\u030c",
+	0x01d0:  "\u0069\u030c",
+	0x01d2:  "\u006f\u030c",
+	0x01d4:  "\u0075\u030c",
+	0x01d6:  "\u0075\u0308\u0304",
+	0x01d8:  "\u0075\u0308\u0301",
+	0x01da:  "\u0075\u0308\u030c",
+	0x01dc:  "\u0075\u0308\u0300",
+	0x01df:  "\u0061\u0308\u0304",
+	0x01e1:  "\u0061\u0307\u0304",
+	0x01e3:  "\u00e6\u0304",
+	0x01e7:  "\u0067\u030c",
+	0x01e9:  "\u006b\u030c",
+	0x01eb:  "\u006f\u0328",
+	0x01ed:  "\u006f\u0328\u0304",
+	0x01ef:  "\u0292\u030c",
+	0x01f0:  "\u006a\u030c",
+	0x01f5:  "\u0067\u0301",
+	0x01f9:  "\u006e\u0300",
+	0x01fb:  "\u0061\u030a\u0301",
+	0x01fd:  "\u00e6\u0301",
+	0x01ff:  "\u00f8\u0301",
+	0x0201:  "\u0061\u030f",
+	0x0203:  "\u0061\u0311",
+	0x0205:  "\u0065\u030f",
+	0x0207:  "\u0065\u0311",
+	0x0209:  "\u0069\u030f",
+	0x020b:  "\u0069\u0311",
+	0x020d:  "\u006f\u030f",
+	0x020f:  "\u006f\u0311",
+	0x0211:  "\u0072\u030f",
+	0x0213:  "\u0072\u0311",
+	0x0215:  "\u0075\u030f",
+	0x0217:  "\u0075\u0311",
+	0x0219:  "\u0073\u0326",
+	0x021b:  "\u0074\u0326",
+	0x021f:  "\u0068\u030c",
+	0x0227:  "\u0061\u0307",
+	0x0229:  "\u0065\u0327",
+	0x022b:  "\u006f\u0308\u0304",
+	0x022d:  "\u006f\u0303\u0304",
+	0x022f:  "\u006f\u0307",
+	0x0231:  "\u006f\u0307\u0304",
+	0x0233:  "\u0079\u0304",
+	0x0390:  "\u03b9\u0308\u0301",
+	0x03ac:  "\u03b1\u0301",
+	0x03ad:  "\u03b5\u0301",
+	0x03ae:  "\u03b7\u0301",
+	0x03af:  "\u03b9\u0301",
+	0x03b0:  "\u03c5\u0308\u0301",
+	0x03ca:  "\u03b9\u0308",
+	0x03cb:  "\u03c5\u0308",
+	0x03cc:  "\u03bf\u0301",
+	0x03cd:  "\u03c5\u0301",
+	0x03ce:  "\u03c9\u0301",
+	0x0439:  "\u0438\u0306",
+	0x0450:  "\u0435\u0300",
+	0x0451:  "\u0435\u0308",
+	0x0453:  "\u0433\u0301",
+	0x0457:  "\u0456\u0308",
+	0x045c:  "\u043a\u0301",
+	0x045d:  "\u0438\u0300",
+	0x045e:  "\u0443\u0306",
+	0x0477:  "\u0475\u030f",
+	0x04c2:  "\u0436\u0306",
+	0x04d1:  "\u0430\u0306",
+	0x04d3:  "\u0430\u0308",
+	0x04d7:  "\u0435\u0306",
+	0x04db:  "\u04d9\u0308",
+	0x04dd:  "\u0436\u0308",
+	0x04df:  "\u0437\u0308",
+	0x04e3:  "\u0438\u0304",
+	0x04e5:  "\u0438\u0308",
+	0x04e7:  "\u043e\u0308",
+	0x04eb:  "\u04e9\u0308",
+	0x04ed:  "\u044d\u0308",
+	0x04ef:  "\u0443\u0304",
+	0x04f1:  "\u0443\u0308",
+	0x04f3:  "\u0443\u030b",
+	0x04f5:  "\u0447\u0308",
+	0x04f9:  "\u044b\u0308",
+	0x0622:  "\u0627\u0653",
+	0x0623:  "\u0627\u0654",
+	0x0624:  "\u0648\u0654",
+	0x0625:  "\u0627\u0655",
+	0x0626:  "\u064a\u0654",
+	0x06c0:  "\u06d5\u0654",
+	0x06c2:  "\u06c1\u0654",
+	0x06d3:  "\u06d2\u0654",
+	0x0929:  "\u0928\u093c",
+	0x0931:  "\u0930\u093c",
+	0x0934:  "\u0933\u093c",
+	0x09cb:  "\u09c7\u09be",
+	0x09cc:  "\u09c7\u09d7",
+	0x0b48:  "\u0b47\u0b56",
+	0x0b4b:  "\u0b47\u0b3e",
+	0x0b4c:  "\u0b47\u0b57",
+	0x0b94:  "\u0b92\u0bd7",
+	0x0bca:  "\u0bc6\u0bbe",
+	0x0bcb:  "\u0bc7\u0bbe",
+	0x0bcc:  "\u0bc6\u0bd7",
+	0x0c48:  "\u0c46\u0c56",
+	0x0cc0:  "\u0cbf\u0cd5",
+	0x0cc7:  "\u0cc6\u0cd5",
+	0x0cc8:  "\u0cc6\u0cd6",
+	0x0cca:  "\u0cc6\u0cc2",
+	0x0ccb:  "\u0cc6\u0cc2\u0cd5",
+	0x0d4a:  "\u0d46\u0d3e",
+	0x0d4b:  "\u0d47\u0d3e",
+	0x0d4c:  "\u0d46\u0d57",
+	0x0dda:  "\u0dd9\u0dca",
+	0x0ddc:  "\u0dd9\u0dcf",
+	0x0ddd:  "\u0dd9\u0dcf\u0dca",
+	0x0dde:  "\u0dd9\u0ddf",
+	0x1026:  "\u1025\u102e",
+	0x1b06:  "\u1b05\u1b35",
+	0x1b08:  "\u1b07\u1b35",
+	0x1b0a:  "\u1b09\u1b35",
+	0x1b0c:  "\u1b0b\u1b35",
+	0x1b0e:  "\u1b0d\u1b35",
+	0x1b12:  "\u1b11\u1b35",
+	0x1b3b:  "\u1b3a\u1b35",
+	0x1b3d:  "\u1b3c\u1b35",
+	0x1b40:  "\u1b3e\u1b35",
+	0x1b41:  "\u1b3f\u1b35",
+	0x1b43:  "\u1b42\u1b35",
+	0x1e01:  "\u0061\u0325",
+	0x1e03:  "\u0062\u0307",
+	0x1e05:  "\u0062\u0323",
+	0x1e07:  "\u0062\u0331",
+	0x1e09:  "\u0063\u0327\u0301",
+	0x1e0b:  "\u0064\u0307",
+	0x1e0d:  "\u0064\u0323",
+	0x1e0f:  "\u0064\u0331",
+	0x1e11:  "\u0064\u0327",
+	0x1e13:  "\u0064\u032d",
+	0x1e15:  "\u0065\u0304\u0300",
+	0x1e17:  "\u0065\u0304\u0301",
+	0x1e19:  "\u0065\u032d",
+	0x1e1b:  "\u0065\u0330",
+	0x1e1d:  "\u0065\u0327\u0306",
+	0x1e1f:  "\u0066\u0307",
+	0x1e21:  "\u0067\u0304",
+	0x1e23:  "\u0068\u0307",
+	0x1e25:  "\u0068\u0323",
+	0x1e27:  "\u0068\u0308",
+	0x1e29:  "\u0068\u0327",
+	0x1e2b:  "\u0068\u032e",
+	0x1e2d:  "\u0069\u0330",
+	0x1e2f:  "\u0069\u0308\u0301",
+	0x1e31:  "\u006b\u0301",
+	0x1e33:  "\u006b\u0323",
+	0x1e35:  "\u006b\u0331",
+	0x1e37:  "\u006c\u0323",
+	0x1e39:  "\u006c\u0323\u0304",
+	0x1e3b:  "\u006c\u0331",
+	0x1e3d:  "\u006c\u032d",
+	0x1e3f:  "\u006d\u0301",
+	0x1e41:  "\u006d\u0307",
+	0x1e43:  "\u006d\u0323",
+	0x1e45:  "\u006e\u0307",
+	0x1e47:  "\u006e\u0323",
+	0x1e49:  "\u006e\u0331",
+	0x1e4b:  "\u006e\u032d",
+	0x1e4d:  "\u006f\u0303\u0301",
+	0x1e4f:  "\u006f\u0303\u0308",
+	0x1e51:  "\u006f\u0304\u0300",
+	0x1e53:  "\u006f\u0304\u0301",
+	0x1e55:  "\u0070\u0301",
+	0x1e57:  "\u0070\u0307",
+	0x1e59:  "\u0072\u0307",
+	0x1e5b:  "\u0072\u0323",
+	0x1e5d:  "\u0072\u0323\u0304",
+	0x1e5f:  "\u0072\u0331",
+	0x1e61:  "\u0073\u0307",
+	0x1e63:  "\u0073\u0323",
+	0x1e65:  "\u0073\u0301\u0307",
+	0x1e67:  "\u0073\u030c\u0307",
+	0x1e69:  "\u0073\u0323\u0307",
+	0x1e6b:  "\u0074\u0307",
+	0x1e6d:  "\u0074\u0323",
+	0x1e6f:  "\u0074\u0331",
+	0x1e71:  "\u0074\u032d",
+	0x1e73:  "\u0075\u0324",
+	0x1e75:  "\u0075\u0330",
+	0x1e77:  "\u0075\u032d",
+	0x1e79:  "\u0075\u0303\u0301",
+	0x1e7b:  "\u0075\u0304\u0308",
+	0x1e7d:  "\u0076\u0303",
+	0x1e7f:  "\u0076\u0323",
+	0x1e81:  "\u0077\u0300",
+	0x1e83:  "\u0077\u0301",
+	0x1e85:  "\u0077\u0308",
+	0x1e87:  "\u0077\u0307",
+	0x1e89:  "\u0077\u0323",
+	0x1e8b:  "\u0078\u0307",
+	0x1e8d:  "\u0078\u0308",
+	0x1e8f:  "\u0079\u0307",
+	0x1e91:  "\u007a\u0302",
+	0x1e93:  "\u007a\u0323",
+	0x1e95:  "\u007a\u0331",
+	0x1e96:  "\u0068\u0331",
+	0x1e97:  "\u0074\u0308",
+	0x1e98:  "\u0077\u030a",
+	0x1e99:  "\u0079\u030a",
+	0x1ea1:  "\u0061\u0323",
+	0x1ea3:  "\u0061\u0309",
+	0x1ea5:  "\u0061\u0302\u0301",
+	0x1ea7:  "\u0061\u0302\u0300",
+	0x1ea9:  "\u0061\u0302\u0309",
+	0x1eab:  "\u0061\u0302\u0303",
+	0x1ead:  "\u0061\u0323\u0302",
+	0x1eaf:  "\u0061\u0306\u0301",
+	0x1eb1:  "\u0061\u0306\u0300",
+	0x1eb3:  "\u0061\u0306\u0309",
+	0x1eb5:  "\u0061\u0306\u0303",
+	0x1eb7:  "\u0061\u0323\u0306",
+	0x1eb9:  "\u0065\u0323",
+	0x1ebb:  "\u0065\u0309",
+	0x1ebd:  "\u0065\u0303",
+	0x1ebf:  "\u0065\u0302\u0301",
+	0x1ec1:  "\u0065\u0302\u0300",
+	0x1ec3:  "\u0065\u0302\u0309",
+	0x1ec5:  "\u0065\u0302\u0303",
+	0x1ec7:  "\u0065\u0323\u0302",
+	0x1ec9:  "\u0069\u0309",
+	0x1ecb:  "\u0069\u0323",
+	0x1ecd:  "\u006f\u0323",
+	0x1ecf:  "\u006f\u0309",
+	0x1ed1:  "\u006f\u0302\u0301",
+	0x1ed3:  "\u006f\u0302\u0300",
+	0x1ed5:  "\u006f\u0302\u0309",
+	0x1ed7:  "\u006f\u0302\u0303",
+	0x1ed9:  "\u006f\u0323\u0302",
+	0x1edb:  "\u006f\u031b\u0301",
+	0x1edd:  "\u006f\u031b\u0300",
+	0x1edf:  "\u006f\u031b\u0309",
+	0x1ee1:  "\u006f\u031b\u0303",
+	0x1ee3:  "\u006f\u031b\u0323",
+	0x1ee5:  "\u0075\u0323",
+	0x1ee7:  "\u0075\u0309",
+	0x1ee9:  "\u0075\u031b\u0301",
+	0x1eeb:  "\u0075\u031b\u0300",
+	0x1eed:  "\u0075\u031b\u0309",
+	0x1eef:  "\u0075\u031b\u0303",
+	0x1ef1:  "\u0075\u031b\u0323",
+	0x1ef3:  "\u0079\u0300",
+	0x1ef5:  "\u0079\u0323",
+	0x1ef7:  "\u0079\u0309",
+	0x1ef9:  "\u0079\u0303",
+	0x1f00:  "\u03b1\u0313",
+	0x1f01:  "\u03b1\u0314",
+	0x1f02:  "\u03b1\u0313\u0300",
+	0x1f03:  "\u03b1\u0314\u0300",
+	0x1f04:  "\u03b1\u0313\u0301",
+	0x1f05:  "\u03b1\u0314\u0301",
+	0x1f06:  "\u03b1\u0313\u0342",
+	0x1f07:  "\u03b1\u0314\u0342",
+	0x1f10:  "\u03b5\u0313",
+	0x1f11:  "\u03b5\u0314",
+	0x1f12:  "\u03b5\u0313\u0300",
+	0x1f13:  "\u03b5\u0314\u0300",
+	0x1f14:  "\u03b5\u0313\u0301",
+	0x1f15:  "\u03b5\u0314\u0301",
+	0x1f20:  "\u03b7\u0313",
+	0x1f21:  "\u03b7\u0314",
+	0x1f22:  "\u03b7\u0313\u0300",
+	0x1f23:  "\u03b7\u0314\u0300",
+	0x1f24:  "\u03b7\u0313\u0301",
+	0x1f25:  "\u03b7\u0314\u0301",
+	0x1f26:  "\u03b7\u0313\u0342",
+	0x1f27:  "\u03b7\u0314\u0342",
+	0x1f30:  "\u03b9\u0313",
+	0x1f31:  "\u03b9\u0314",
+	0x1f32:  "\u03b9\u0313\u0300",
+	0x1f33:  "\u03b9\u0314\u0300",
+	0x1f34:  "\u03b9\u0313\u0301",
+	0x1f35:  "\u03b9\u0314\u0301",
+	0x1f36:  "\u03b9\u0313\u0342",
+	0x1f37:  "\u03b9\u0314\u0342",
+	0x1f40:  "\u03bf\u0313",
+	0x1f41:  "\u03bf\u0314",
+	0x1f42:  "\u03bf\u0313\u0300",
+	0x1f43:  "\u03bf\u0314\u0300",
+	0x1f44:  "\u03bf\u0313\u0301",
+	0x1f45:  "\u03bf\u0314\u0301",
+	0x1f50:  "\u03c5\u0313",
+	0x1f51:  "\u03c5\u0314",
+	0x1f52:  "\u03c5\u0313\u0300",
+	0x1f53:  "\u03c5\u0314\u0300",
+	0x1f54:  "\u03c5\u0313\u0301",
+	0x1f55:  "\u03c5\u0314\u0301",
+	0x1f56:  "\u03c5\u0313\u0342",
+	0x1f57:  "\u03c5\u0314\u0342",
+	0x1f60:  "\u03c9\u0313",
+	0x1f61:  "\u03c9\u0314",
+	0x1f62:  "\u03c9\u0313\u0300",
+	0x1f63:  "\u03c9\u0314\u0300",
+	0x1f64:  "\u03c9\u0313\u0301",
+	0x1f65:  "\u03c9\u0314\u0301",
+	0x1f66:  "\u03c9\u0313\u0342",
+	0x1f67:  "\u03c9\u0314\u0342",
+	0x1f70:  "\u03b1\u0300",
+	0x1f72:  "\u03b5\u0300",
+	0x1f74:  "\u03b7\u0300",
+	0x1f76:  "\u03b9\u0300",
+	0x1f78:  "\u03bf\u0300",
+	0x1f7a:  "\u03c5\u0300",
+	0x1f7c:  "\u03c9\u0300",
+	0x1fb0:  "\u03b1\u0306",
+	0x1fb1:  "\u03b1\u0304",
+	0x1fb6:  "\u03b1\u0342",
+	0x1fc6:  "\u03b7\u0342",
+	0x1fd0:  "\u03b9\u0306",
+	0x1fd1:  "\u03b9\u0304",
+	0x1fd2:  "\u03b9\u0308\u0300",
+	0x1fd6:  "\u03b9\u0342",
+	0x1fd7:  "\u03b9\u0308\u0342",
+	0x1fe0:  "\u03c5\u0306",
+	0x1fe1:  "\u03c5\u0304",
+	0x1fe2:  "\u03c5\u0308\u0300",
+	0x1fe4:  "\u03c1\u0313",
+	0x1fe5:  "\u03c1\u0314",
+	0x1fe6:  "\u03c5\u0342",
+	0x1fe7:  "\u03c5\u0308\u0342",
+	0x1ff6:  "\u03c9\u0342",
+	0x304c:  "\u304b\u3099",
+	0x304e:  "\u304d\u3099",
+	0x3050:  "\u304f\u3099",
+	0x3052:  "\u3051\u3099",
+	0x3054:  "\u3053\u3099",
+	0x3056:  "\u3055\u3099",
+	0x3058:  "\u3057\u3099",
+	0x305a:  "\u3059\u3099",
+	0x305c:  "\u305b\u3099",
+	0x305e:  "\u305d\u3099",
+	0x3060:  "\u305f\u3099",
+	0x3062:  "\u3061\u3099",
+	0x3065:  "\u3064\u3099",
+	0x3067:  "\u3066\u3099",
+	0x3069:  "\u3068\u3099",
+	0x3070:  "\u306f\u3099",
+	0x3071:  "\u306f\u309a",
+	0x3073:  "\u3072\u3099",
+	0x3074:  "\u3072\u309a",
+	0x3076:  "\u3075\u3099",
+	0x3077:  "\u3075\u309a",
+	0x3079:  "\u3078\u3099",
+	0x307a:  "\u3078\u309a",
+	0x307c:  "\u307b\u3099",
+	0x307d:  "\u307b\u309a",
+	0x3094:  "\u3046\u3099",
+	0x309e:  "\u309d\u3099",
+	0x30ac:  "\u30ab\u3099",
+	0x30ae:  "\u30ad\u3099",
+	0x30b0:  "\u30af\u3099",
+	0x30b2:  "\u30b1\u3099",
+	0x30b4:  "\u30b3\u3099",
+	0x30b6:  "\u30b5\u3099",
+	0x30b8:  "\u30b7\u3099",
+	0x30ba:  "\u30b9\u3099",
+	0x30bc:  "\u30bb\u3099",
+	0x30be:  "\u30bd\u3099",
+	0x30c0:  "\u30bf\u3099",
+	0x30c2:  "\u30c1\u3099",
+	0x30c5:  "\u30c4\u3099",
+	0x30c7:  "\u30c6\u3099",
+	0x30c9:  "\u30c8\u3099",
+	0x30d0:  "\u30cf\u3099",
+	0x30d1:  "\u30cf\u309a",
+	0x30d3:  "\u30d2\u3099",
+	0x30d4:  "\u30d2\u309a",
+	0x30d6:  "\u30d5\u3099",
+	0x30d7:  "\u30d5\u309a",
+	0x30d9:  "\u30d8\u3099",
+	0x30da:  "\u30d8\u309a",
+	0x30dc:  "\u30db\u3099",
+	0x30dd:  "\u30db\u309a",
+	0x30f4:  "\u30a6\u3099",
+	0x30f7:  "\u30ef\u3099",
+	0x30f8:  "\u30f0\u3099",
+	0x30f9:  "\u30f1\u3099",
+	0x30fa:  "\u30f2\u3099",
+	0x30fe:  "\u30fd\u3099",
+	0x1109a: "\U00011099\U000110ba",
+	0x1109c: "\U0001109b\U000110ba",
+	0x110ab: "\U000110a5\U000110ba",
+	0x1112e: "\U00011131\U00011127",
+	0x1112f: "\U00011132\U00011127",
+	0x1134b: "\U00011347\U0001133e",
+	0x1134c: "\U00011347\U00011357",
+	0x114bb: "\U000114b9\U000114ba",
+	0x114bc: "\U000114b9\U000114b0",
+	0x114be: "\U000114b9\U000114bd",
+	0x115ba: "\U000115b8\U000115af",
+	0x115bb: "\U000115b9\U000115af",
+	0x11938: "\U00011935\U00011930",
+}
+
+// the primary composites of pairs of allowed code points
+var idnaCompose = map[[2]rune]rune{
+	{0x0061, 0x0300}:   0x00e0,
+	{0x0061, 0x0301}:   0x00e1,
+	{0x0061, 0x0302}:   0x00e2,
+	{0x0061, 0x0303}:   0x00e3,
+	{0x0061, 0x0304}:   0x0101,
+	{0x0061, 0x0306}:   0x0103,
+	{0x0061, 0x0307}:   0x0227,
+	{0x0061, 0x0308}:   0x00e4,
+	{0x0061, 0x0309}:   0x1ea3,
+	{0x0061, 0x030a}:   0x00e5,
+	{0x0061, 0x030c}:   0x01ce,
+	{0x0061, 0x030f}:   0x0201,
+	{0x0061, 0x0311}:   0x0203,
+	{0x0061, 0x0323}:   0x1ea1,
+	{0x0061, 0x0325}:   0x1e01,
+	{0x0061, 0x0328}:   0x0105,
+	{0x0062, 0x0307}:   0x1e03,
+	{0x0062, 0x0323}:   0x1e05,
+	{0x0062, 0x0331}:   0x1e07,
+	{0x0063, 0x0301}:   0x0107,
+	{0x0063, 0x0302}:   0x0109,
+	{0x0063, 0x0307}:   0x010b,
+	{0x0063, 0x030c}:   0x010d,
+	{0x0063, 0x0327}:   0x00e7,
+	{0x0064, 0x0307}:   0x1e0b,
+	{0x0064, 0x030c}:   0x010f,
+	{0x0064, 0x0323}:   0x1e0d,
+	{0x0064, 0x0327}:   0x1e11,
+	{0x0064, 0x032d}:   0x1e13,
+	{0x0064, 0x0331}:   0x1e0f,
+	{0x0065, 0x0300}:   0x00e8,
+	{0x0065, 0x0301}:   0x00e9,
+	{0x0065, 0x0302}:   0x00ea,
+	{0x0065, 0x0303}:   0x1ebd,
+	{0x0065, 0x0304}:   0x0113,
+	{0x0065, 0x0306}:   0x0115,
+	{0x0065, 0x0307}:   0x0117,
+	{0x0065, 0x0308}:   0x00eb,
+	{0x0065, 0x0309}:   0x1ebb,
+	{0x0065, 0x030c}:   0x011b,
+	{0x0065, 0x030f}:   0x0205,
+	{0x0065, 0x0311}:   0x0207,
+	{0x0065, 0x0323}:   0x1eb9,
+	{0x0065, 0x0327}:   0x0229,
+	{0x0065, 0x0328}:   0x0119,
+	{0x0065, 0x032d}:   0x1e19,
+	{0x0065, 0x0330}:   0x1e1b,
+	{0x0066, 0x0307}:   0x1e1f,
+	{0x0067, 0x0301}:   0x01f5,
+	{0x0067, 0x0302}:   0x011d,
+	{0x0067, 0x0304}:   0x1e21,
+	{0x0067, 0x0306}:   0x011f,
+	{0x0067, 0x0307}:   0x0121,
+	{0x0067, 0x030c}:   0x01e7,
+	{0x0067, 0x0327}:   0x0123,
+	{0x0068, 0x0302}:   0x0125,
+	{0x0068, 0x0307}:   0x1e23,
+	{0x0068, 0x0308}:   0x1e27,
+	{0x0068, 0x030c}:   0x021f,
+	{0x0068, 0x0323}:   0x1e25,
+	{0x0068, 0x0327}:   0x1e29,
+	{0x0068, 0x032e}:   0x1e2b,
+	{0x0068, 0x0331}:   0x1e96,
+	{0x0069, 0x0300}:   0x00ec,
+	{0x0069, 0x0301}:   0x00ed,
+	{0x0069, 0x0302}:   0x00ee,
+	{0x0069, 0x0303}:   0x0129,
+	{0x0069, 0x0304}:   0x012b,
+	{0x0069, 0x0306}:   0x012d,
+	{0x0069, 0x0308}:   0x00ef,
+	{0x0069, 0x0309}:   0x1ec9,
+	{0x0069, 0x030c}:   0x01d0,
+	{0x0069, 0x030f}:   0x0209,
+	{0x0069, 0x0311}:   0x020b,
+	{0x0069, 0x0323}:   0x1ecb,
+	{0x0069, 0x0328}:   0x012f,
+	{0x0069, 0x0330}:   0x1e2d,
+	{0x006a, 0x0302}:   0x0135,
+	{0x006a, 0x030c}:   0x01f0,
+	{0x006b, 0x0301}:   0x1e31,
+	{0x006b, 0x030c}:   0x01e9,
+	{0x006b, 0x0323}:   0x1e33,
+	{0x006b, 0x0327}:   0x0137,
+	{0x006b, 0x0331}:   0x1e35,
+	{0x006c, 0x0301}:   0x013a,
+	{0x006c, 0x030c}:   0x013e,
+	{0x006c, 0x0323}:   0x1e37,
+	{0x006c, 0x0327}:   0x013c,
+	{0x006c, 0x032d}:   0x1e3d,
+	{0x006c, 0x0331}:   0x1e3b,
+	{0x006d, 0x0301}:   0x1e3f,
+	{0x006d, 0x0307}:   0x1e41,
+	{0x006d, 0x0323}:   0x1e43,
+	{0x006e, 0x0300}:   0x01f9,
+	{0x006e, 0x0301}:   0x0144,
+	{0x006e, 0x0303}:   0x00f1,
+	{0x006e, 0x0307}:   0x1e45,
+	{0x006e, 0x030c}:   0x0148,
+	{0x006e, 0x0323}:   0x1e47,
+	{0x006e, 0x0327}:   0x0146,
+	{0x006e, 0x032d}:   0x1e4b,
+	{0x006e, 0x0331}:   0x1e49,
+	{0x006f, 0x0300}:   0x00f2,
+	{0x006f, 0x0301}:   0x00f3,
+	{0x006f, 0x0302}:   0x00f4,
+	{0x006f, 0x0303}:   0x00f5,
+	{0x006f, 0x0304}:   0x014d,
+	{0x006f, 0x0306}:   0x014f,
+	{0x006f, 0x0307}:   0x022f,
+	{0x006f, 0x0308}:   0x00f6,
+	{0x006f, 0x0309}:   0x1ecf,
+	{0x006f, 0x030b}:   0x0151,
+	{0x006f, 0x030c}:   0x01d2,
+	{0x006f, 0x030f}:   0x020d,
+	{0x006f, 0x0311}:   0x020f,
+	{0x006f, 0x031b}:   0x01a1,
+	{0x006f, 0x0323}:   0x1ecd,
+	{0x006f, 0x0328}:   0x01eb,
+	{0x0070, 0x0301}:   0x1e55,
+	{0x0070, 0x0307}:   0x1e57,
+	{0x0072, 0x0301}:   0x0155,
+	{0x0072, 0x0307}:   0x1e59,
+	{0x0072, 0x030c}:   0x0159,
+	{0x0072, 0x030f}:   0x0211,
+	{0x0072, 0x0311}:   0x0213,
+	{0x0072, 0x0323}:   0x1e5b,
+	{0x0072, 0x0327}:   0x0157,
+	{0x0072, 0x0331}:   0x1e5f,
+	{0x0073, 0x0301}:   0x015b,
+	{0x0073, 0x0302}:   0x015d,
+	{0x0073, 0x0307}:   0x1e61,
+	{0x0073, 0x030c}:   0x0161,
+	{0x0073, 0x0323}:   0x1e63,
+	{0x0073, 0x0326}:   0x0219,
+	{0x0073, 0x0327}:   0x015f,
+	{0x0074, 0x0307}:   0x1e6b,
+	{0x0074, 0x0308}:   0x1e97,
+	{0x0074, 0x030c}:   0x0165,
+	{0x0074, 0x0323}:   0x1e6d,
+	{0x0074, 0x0326}:   0x021b,
+	{0x0074, 0x0327}:   0x0163,
+	{0x0074, 0x032d}:   0x1e71,
+	{0x0074, 0x0331}:   0x1e6f,
+	{0x0075, 0x0300}:   0x00f9,
+	{0x0075, 0x0301}:   0x00fa,
+	{0x0075, 0x0302}:   0x00fb,
+	{0x0075, 0x0303}:   0x0169,
+	{0x0075, 0x0304}:   0x016b,
+	{0x0075, 0x0306}:   0x016d,
+	{0x0075, 0x0308}:   0x00fc,
+	{0x0075, 0x0309}:   0x1ee7,
+	{0x0075, 0x030a}:   0x016f,
+	{0x0075, 0x030b}:   0x0171,
+	{0x0075, 0x030c}:   0x01d4,
+	{0x0075, 0x030f}:   0x0215,
+	{0x0075, 0x0311}:   0x0217,
+	{0x0075, 0x031b}:   0x01b0,
+	{0x0075, 0x0323}:   0x1ee5,
+	{0x0075, 0x0324}:   0x1e73,
+	{0x0075, 0x0328}:   0x0173,
+	{0x0075, 0x032d}:   0x1e77,
+	{0x0075, 0x0330}:   0x1e75,
+	{0x0076, 0x0303}:   0x1e7d,
+	{0x0076, 0x0323}:   0x1e7f,
+	{0x0077, 0x0300}:   0x1e81,
+	{0x0077, 0x0301}:   0x1e83,
+	{0x0077, 0x0302}:   0x0175,
+	{0x0077, 0x0307}:   0x1e87,
+	{0x0077, 0x0308}:   0x1e85,
+	{0x0077, 0x030a}:   0x1e98,
+	{0x0077, 0x0323}:   0x1e89,
+	{0x0078, 0x0307}:   0x1e8b,
+	{0x0078, 0x0308}:   0x1e8d,
+	{0x0079, 0x0300}:   0x1ef3,
+	{0x0079, 0x0301}:   0x00fd,
+	{0x0079, 0x0302}:   0x0177,
+	{0x0079, 0x0303}:   0x1ef9,
+	{0x0079, 0x0304}:   0x0233,
+	{0x0079, 0x0307}:   0x1e8f,
+	{0x0079, 0x0308}:   0x00ff,
+	{0x0079, 0x0309}:   0x1ef7,
+	{0x0079, 0x030a}:   0x1e99,
+	{0x0079, 0x0323}:   0x1ef5,
+	{0x007a, 0x0301}:   0x017a,
+	{0x007a, 0x0302}:   0x1e91,
+	{0x007a, 0x0307}:   0x017c,
+	{0x007a, 0x030c}:   0x017e,
+	{0x007a, 0x0323}:   0x1e93,
+	{0x007a, 0x0331}:   0x1e95,
+	{0x00e2, 0x0300}:   0x1ea7,
+	{0x00e2, 0x0301}:   0x1ea5,
+	{0x00e2, 0x0303}:   0x1eab,
+	{0x00e2, 0x0309}:   0x1ea9,
+	{0x00e4, 0x0304}:   0x01df,
+	{0x00e5, 0x0301}:   0x01fb,
+	{0x00e6, 0x0301}:   0x01fd,
+	{0x00e6, 0x0304}:   0x01e3,
+	{0x00e7, 0x0301}:   0x1e09,
+	{0x00ea, 0x0300}:   0x1ec1,
+	{0x00ea, 0x0301}:   0x1ebf,
+	{0x00ea, 0x0303}:   0x1ec5,
+	{0x00ea, 0x0309}:   0x1ec3,
+	{0x00ef, 0x0301}:   0x1e2f,
+	{0x00f4, 0x0300}:   0x1ed3,
+	{0x00f4, 0x0301}:   0x1ed1,
+	{0x00f4, 0x0303}:   0x1ed7,
+	{0x00f4, 0x0309}:   0x1ed5,
+	{0x00f5, 0x0301}:   0x1e4d,
+	{0x00f5, 0x0304}:   0x022d,
+	{0x00f5, 0x0308}:   0x1e4f,
+	{0x00f6, 0x0304}:   0x022b,
+	{0x00f8, 0x0301}:   0x01ff,
+	{0x00fc, 0x0300}:   0x01dc,
+	{0x00fc, 0x0301}:   0x01d8,
+	{0x00fc, 0x0304}:   0x01d6,
+	{0x00fc, 0x030c}:   0x01da,
+	{0x0103, 0x0300}:   0x1eb1,
+	{0x0103, 0x0301}:   0x1eaf,
+	{0x0103, 0x0303}:   0x1eb5,
+	{0x0103, 0x0309}:   0x1eb3,
+	{0x0113, 0x0300}:   0x1e15,
+	{0x0113, 0x0301}:   0x1e17,
+	{0x014d, 0x0300}:   0x1e51,
+	{0x014d, 0x0301}:   0x1e53,
+	{0x015b, 0x0307}:   0x1e65,
+	{0x0161, 0x0307}:   0x1e67,
+	{0x0169, 0x0301}:   0x1e79,
+	{0x016b, 0x0308}:   0x1e7b,
+	{0x01a1, 0x0300}:   0x1edd,
+	{0x01a1, 0x0301}:   0x1edb,
+	{0x01a1, 0x0303}:   0x1ee1,
+	{0x01a1, 0x0309}:   0x1edf,
+	{0x01a1, 0x0323}:   0x1ee3,
+	{0x01b0, 0x0300}:   0x1eeb,
+	{0x01b0, 0x0301}:   0x1ee9,
+	{0x01b0, 0x0303}:   0x1eef,
+	{0x01b0, 0x0309}:   0x1eed,
+	{0x01b0, 0x0323}:   0x1ef1,
+	{0x01eb, 0x0304}:   0x01ed,
+	{0x0227, 0x0304}:   0x01e1,
+	{0x0229, 0x0306}:   0x1e1d,
+	{0x022f, 0x0304}:   0x0231,
+	{0x0292, 0x030c}:   0x01ef,
+	{0x03b1, 0x0300}:   0x1f70,
+	{0x03b1, 0x0301}:   0x03ac,
+	{0x03b1, 0x0304}:   0x1fb1,
+	{0x03b1, 0x0306}:   0x1fb0,
+	{0x03b1, 0x0313}:   0x1f00,
+	{0x03b1, 0x0314}:   0x1f01,
+	{0x03b1, 0x0342}:   0x1fb6,
+	{0x03b5, 0x0300}:   0x1f72,
+	{0x03b5, 0x0301}:   0x03ad,
+	{0x03b5, 0x0313}:   0x1f10,
+	{0x03b5, 0x0314}:   0x1f11,
+	{0x03b7, 0x0300}:   0x1f74,
+	{0x03b7, 0x0301}:   0x03ae,
+	{0x03b7, 0x0313}:   0x1f20,
+	{0x03b7, 0x0314}:   0x1f21,
+	{0x03b7, 0x0342}:   0x1fc6,
+	{0x03b9, 0x0300}:   0x1f76,
+	{0x03b9, 0x0301}:   0x03af,
+	{0x03b9, 0x0304}:   0x1fd1,
+	{0x03b9, 0x0306}:   0x1fd0,
+	{0x03b9, 0x0308}:   0x03ca,
+	{0x03b9, 0x0313}:   0x1f30,
+	{0x03b9, 0x0314}:   0x1f31,
+	{0x03b9, 0x0342}:   0x1fd6,
+	{0x03bf, 0x0300}:   0x1f78,
+	{0x03bf, 0x0301}:   0x03cc,
+	{0x03bf, 0x0313}:   0x1f40,
+	{0x03bf, 0x0314}:   0x1f41,
+	{0x03c1, 0x0313}:   0x1fe4,
+	{0x03c1, 0x0314}:   0x1fe5,
+	{0x03c5, 0x0300}:   0x1f7a,
+	{0x03c5, 0x0301}:   0x03cd,
+	{0x03c5, 0x0304}:   0x1fe1,
+	{0x03c5, 0x0306}:   0x1fe0,
+	{0x03c5, 0x0308}:   0x03cb,
+	{0x03c5, 0x0313}:   0x1f50,
+	{0x03c5, 0x0314}:   0x1f51,
+	{0x03c5, 0x0342}:   0x1fe6,
+	{0x03c9, 0x0300}:   0x1f7c,
+	{0x03c9, 0x0301}:   0x03ce,
+	{0x03c9, 0x0313}:   0x1f60,
+	{0x03c9, 0x0314}:   0x1f61,
+	{0x03c9, 0x0342}:   0x1ff6,
+	{0x03ca, 0x0300}:   0x1fd2,
+	{0x03ca, 0x0301}:   0x0390,
+	{0x03ca, 0x0342}:   0x1fd7,
+	{0x03cb, 0x0300}:   0x1fe2,
+	{0x03cb, 0x0301}:   0x03b0,
+	{0x03cb, 0x0342}:   0x1fe7,
+	{0x0430, 0x0306}:   0x04d1,
+	{0x0430, 0x0308}:   0x04d3,
+	{0x0433, 0x0301}:   0x0453,
+	{0x0435, 0x0300}:   0x0450,
+	{0x0435, 0x0306}:   0x04d7,
+	{0x0435, 0x0308}:   0x0451,
+	{0x0436, 0x0306}:   0x04c2,
+	{0x0436, 0x0308}:   0x04dd,
+	{0x0437, 0x0308}:   0x04df,
+	{0x0438, 0x0300}:   0x045d,
+	{0x0438, 0x0304}:   0x04e3,
+	{0x0438, 0x0306}:   0x0439,
+	{0x0438, 0x0308}:   0x04e5,
+	{0x043a, 0x0301}:   0x045c,
+	{0x043e, 0x0308}:   0x04e7,
+	{0x0443, 0x0304}:   0x04ef,
+	{0x0443, 0x0306}:   0x045e,
+	{0x0443, 0x0308}:   0x04f1,
+	{0x0443, 0x030b}:   0x04f3,
+	{0x0447, 0x0308}:   0x04f5,
+	{0x044b, 0x0308}:   0x04f9,
+	{0x044d, 0x0308}:   0x04ed,
+	{0x0456, 0x0308}:   0x0457,
+	{0x0475, 0x030f}:   0x0477,
+	{0x04d9, 0x0308}:   0x04db,
+	{0x04e9, 0x0308}:   0x04eb,
+	{0x0627, 0x0653}:   0x0622,
+	{0x0627, 0x0654}:   0x0623,
+	{0x0627, 0x0655}:   0x0625,
+	{0x0648, 0x0654}:   0x0624,
+	{0x064a, 0x0654}:   0x0626,
+	{0x06c1, 0x0654}:   0x06c2,
+	{0x06d2, 0x0654}:   0x06d3,
+	{0x06d5, 0x0654}:   0x06c0,
+	{0x0928, 0x093c}:   0x0929,
+	{0x0930, 0x093c}:   0x0931,
+	{0x0933, 0x093c}:   0x0934,
+	{0x09c7, 0x09be}:   0x09cb,
+	{0x09c7, 0x09d7}:   0x09cc,
+	{0x0b47, 0x0b3e}:   0x0b4b,
+	{0x0b47, 0x0b56}:   0x0b48,
+	{0x0b47, 0x0b57}:   0x0b4c,
+	{0x0b92, 0x0bd7}:   0x0b94,
+	{0x0bc6, 0x0bbe}:   0x0bca,
+	{0x0bc6, 0x0bd7}:   0x0bcc,
+	{0x0bc7, 0x0bbe}:   0x0bcb,
+	{0x0c46, 0x0c56}:   0x0c48,
+	{0x0cbf, 0x0cd5}:   0x0cc0,
+	{0x0cc6, 0x0cc2}:   0x0cca,
+	{0x0cc6, 0x0cd5}:   0x0cc7,
+	{0x0cc6, 0x0cd6}:   0x0cc8,
+	{0x0cca, 0x0cd5}:   0x0ccb,
+	{0x0d46, 0x0d3e}:   0x0d4a,
+	{0x0d46, 0x0d57}:   0x0d4c,
+	{0x0d47, 0x0d3e}:   0x0d4b,
+	{0x0dd9, 0x0dca}:   0x0dda,
+	{0x0dd9, 0x0dcf}:   0x0ddc,
+	{0x0dd9, 0x0ddf}:   0x0dde,
+	{0x0ddc, 0x0dca}:   0x0ddd,
+	{0x1025, 0x102e}:   0x1026,
+	{0x1b05, 0x1b35}:   0x1b06,
+	{0x1b07, 0x1b35}:   0x1b08,
+	{0x1b09, 0x1b35}:   0x1b0a,
+	{0x1b0b, 0x1b35}:   0x1b0c,
+	{0x1b0d, 0x1b35}:   0x1b0e,
+	{0x1b11, 0x1b35}:   0x1b12,
+	{0x1b3a, 0x1b35}:   0x1b3b,
+	{0x1b3c, 0x1b35}:   0x1b3d,
+	{0x1b3e, 0x1b35}:   0x1b40,
+	{0x1b3f, 0x1b35}:   0x1b41,
+	{0x1b42, 0x1b35}:   0x1b43,
+	{0x1e37, 0x0304}:   0x1e39,
+	{0x1e5b, 0x0304}:   0x1e5d,
+	{0x1e63, 0x0307}:   0x1e69,
+	{0x1ea1, 0x0302}:   0x1ead,
+	{0x1ea1, 0x0306}:   0x1eb7,
+	{0x1eb9, 0x0302}:   0x1ec7,
+	{0x1ecd, 0x0302}:   0x1ed9,
+	{0x1f00, 0x0300}:   0x1f02,
+	{0x1f00, 0x0301}:   0x1f04,
+	{0x1f00, 0x0342}:   0x1f06,
+	{0x1f01, 0x0300}:   0x1f03,
+	{0x1f01, 0x0301}:   0x1f05,
+	{0x1f01, 0x0342}:   0x1f07,
+	{0x1f10, 0x0300}:   0x1f12,
+	{0x1f10, 0x0301}:   0x1f14,
+	{0x1f11, 0x0300}:   0x1f13,
+	{0x1f11, 0x0301}:   0x1f15,
+	{0x1f20, 0x0300}:   0x1f22,
+	{0x1f20, 0x0301}:   0x1f24,
+	{0x1f20, 0x0342}:   0x1f26,
+	{0x1f21, 0x0300}:   0x1f23,
+	{0x1f21, 0x0301}:   0x1f25,
+	{0x1f21, 0x0342}:   0x1f27,
+	{0x1f30, 0x0300}:   0x1f32,
+	{0x1f30, 0x0301}:   0x1f34,
+	{0x1f30, 0x0342}:   0x1f36,
+	{0x1f31, 0x0300}:   0x1f33,
+	{0x1f31, 0x0301}:   0x1f35,
+	{0x1f31, 0x0342}:   0x1f37,
+	{0x1f40, 0x0300}:   0x1f42,
+	{0x1f40, 0x0301}:   0x1f44,
+	{0x1f41, 0x0300}:   0x1f43,
+	{0x1f41, 0x0301}:   0x1f45,
+	{0x1f50, 0x0300}:   0x1f52,
+	{0x1f50, 0x0301}:   0x1f54,
+	{0x1f50, 0x0342}:   0x1f56,
+	{0x1f51, 0x0300}:   0x1f53,
+	{0x1f51, 0x0301}:   0x1f55,
+	{0x1f51, 0x0342}:   0x1f57,
+	{0x1f60, 0x0300}:   0x1f62,
+	{0x1f60, 0x0301}:   0x1f64,
+	{0x1f60, 0x0342}:   0x1f66,
+	{0x1f61, 0x0300}:   0x1f63,
+	{0x1f61, 0x0301}:   0x1f65,
+	{0x1f61, 0x0342}:   0x1f67,
+	{0x3046, 0x3099}:   0x3094,
+	{0x304b, 0x3099}:   0x304c,
+	{0x304d, 0x3099}:   0x304e,
+	{0x304f, 0x3099}:   0x3050,
+	{0x3051, 0x3099}:   0x3052,
+	{0x3053, 0x3099}:   0x3054,
+	{0x3055, 0x3099}:   0x3056,
+	{0x3057, 0x3099}:   0x3058,
+	{0x3059, 0x3099}:   0x305a,
+	{0x305b, 0x3099}:   0x305c,
+	{0x305d, 0x3099}:   0x305e,
+	{0x305f, 0x3099}:   0x3060,
+	{0x3061, 0x3099}:   0x3062,
+	{0x3064, 0x3099}:   0x3065,
+	{0x3066, 0x3099}:   0x3067,
+	{0x3068, 0x3099}:   0x3069,
+	{0x306f, 0x3099}:   0x3070,
+	{0x306f, 0x309a}:   0x3071,
+	{0x3072, 0x3099}:   0x3073,
+	{0x3072, 0x309a}:   0x3074,
+	{0x3075, 0x3099}:   0x3076,
+	{0x3075, 0x309a}:   0x3077,
+	{0x3078, 0x3099}:   0x3079,
+	{0x3078, 0x309a}:   0x307a,
+	{0x307b, 0x3099}:   0x307c,
+	{0x307b, 0x309a}:   0x307d,
+	{0x309d, 0x3099}:   0x309e,
+	{0x30a6, 0x3099}:   0x30f4,
+	{0x30ab, 0x3099}:   0x30ac,
+	{0x30ad, 0x3099}:   0x30ae,
+	{0x30af, 0x3099}:   0x30b0,
+	{0x30b1, 0x3099}:   0x30b2,
+	{0x30b3, 0x3099}:   0x30b4,
+	{0x30b5, 0x3099}:   0x30b6,
+	{0x30b7, 0x3099}:   0x30b8,
+	{0x30b9, 0x3099}:   0x30ba,
+	{0x30bb, 0x3099}:   0x30bc,
+	{0x30bd, 0x3099}:   0x30be,
+	{0x30bf, 0x3099}:   0x30c0,
+	{0x30c1, 0x3099}:   0x30c2,
+	{0x30c4, 0x3099}:   0x30c5,
+	{0x30c6, 0x3099}:   0x30c7,
+	{0x30c8, 0x3099}:   0x30c9,
+	{0x30cf, 0x3099}:   0x30d0,
+	{0x30cf, 0x309a}:   0x30d1,
+	{0x30d2, 0x3099}:   0x30d3,
+	{0x30d2, 0x309a}:   0x30d4,
+	{0x30d5, 0x3099}:   0x30d6,
+	{0x30d5, 0x309a}:   0x30d7,
+	{0x30d8, 0x3099}:   0x30d9,
+	{0x30d8, 0x309a}:   0x30da,
+	{0x30db, 0x3099}:   0x30dc,
+	{0x30db, 0x309a}:   0x30dd,
+	{0x30ef, 0x3099}:   0x30f7,
+	{0x30f0, 0x3099}:   0x30f8,
+	{0x30f1, 0x3099}:   0x30f9,
+	{0x30f2, 0x3099}:   0x30fa,
+	{0x30fd, 0x3099}:   0x30fe,
+	{0x11099, 0x110ba}: 0x1109a,
+	{0x1109b, 0x110ba}: 0x1109c,
+	{0x110a5, 0x110ba}: 0x110ab,
+	{0x11131, 0x11127}: 0x1112e,
+	{0x11132, 0x11127}: 0x1112f,
+	{0x11347, 0x1133e}: 0x1134b,
+	{0x11347, 0x11357}: 0x1134c,
+	{0x114b9, 0x114b0}: 0x114bc,
+	{0x114b9, 0x114ba}: 0x114bb,
+	{0x114b9, 0x114bd}: 0x114be,
+	{0x115b8, 0x115af}: 0x115ba,
+	{0x115b9, 0x115af}: 0x115bb,
+	{0x11935, 0x11930}: 0x11938,
+}
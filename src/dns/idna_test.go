@@ -0,0 +1,84 @@
+package dns
+
+import "testing"
+
+func TestPunycode(t *testing.T) {
+	for _, c := range []struct{ u, p string }{
+		{"bücher", "bcher-kva"},
+		{"münchen", "mnchen-3ya"},
+		{"公司", "55qx5d"},
+		{"ελληνικά", "hxargifdar"},
+		{"他们为什么不说中文", "ihqwcrb4cv8a8dqg056pqjye"},
+	} {
+		p, e := punyEncode(c.u)
+		if e != nil || p != c.p {
+			t.Errorf("punyEncode(%s) = %s, %v; expecting %s", c.u, p, e, c.p)
+		}
+		u, e := punyDecode(c.p)
+		if e != nil || u != c.u {
+			t.Errorf("punyDecode(%s) = %s, %v; expecting %s", c.p, u, e, c.u)
+		}
+	}
+}
+
+func TestIDNName(t *testing.T) {
+	n, e := NewName("Bücher.例え。テスト")
+	if e != nil {
+		t.Fatal(e)
+	}
+	if n.String() != "xn--bcher-kva.xn--r8jz45g.xn--zckzah" {
+		t.Errorf("wrong ascii form: %s", n)
+	}
+	if n.Unicode() != "bücher.例え.テスト" {
+		t.Errorf("wrong unicode form: %s", n.Unicode())
+	}
+
+	_, reg := RegParts(Domain("www.公司.cn"))
+	if !reg.Equal(Domain("xn--55qx5d.cn")) {
+		t.Errorf("公司.cn should be a registrar, got %s", reg)
+	}
+
+	if _, e := NewName("a☃b.com"); e == nil {
+		t.Error("symbols should be rejected")
+	}
+	if Domain(`xn--bcher-kva.\233`).Unicode() != `bücher.\233` {
+		t.Error("wrong unicode form for raw octets")
+	}
+}
+
+func TestIDNA2008(t *testing.T) {
+	for _, c := range []struct{ u, a string }{
+		{"l·l.cat", "xn--ll-0ea.cat"},
+		{"क्\u200dष.in", "xn--11b2ezcw70k.in"},
+		{"می\u200cخواهم.ir", "xn--mgbn2ecje63gr19l.ir"},
+		{"אבג.com", "xn--4dbcd.com"},
+		{"việt.vn", "xn--vit-5kz.vn"},
+		{"q\u0301.test", "xn--q-xbb.test"},
+		{"ا١٢.test", "xn--mgb0jd.test"},
+	} {
+		n, e := NewName(c.u)
+		if e != nil || n.String() != c.a {
+			t.Errorf("%q: %v %v, expecting %s", c.u, n, e, c.a)
+		}
+	}
+
+	for _, s := range []string{
+		"ｅｘａｍｐｌｅ.com",  // full width
+		"ﬁ.com",        // a ligature
+		"\u212a.com",   // the kelvin sign
+		"e\u0301x.com", // not in nfc
+		"\u00e9\u0323.test",
+		"a\u200db.com", // a joiner with no virama
+		"a\u200cb.com",
+		"ab·c.com", // middle dot out of l·l
+		"ا١۱.test", // mixed arabic-indic digits
+		"אa.com",   // left to right letter in a right to left label
+		"א١1.test", // both kinds of numbers
+		"1a.אב",    // a bad label in a bidi name
+		"ü_b.test",
+	} {
+		if n, e := NewName(s); e == nil {
+			t.Errorf("%q: got %s, expecting an error", s, n)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package dns
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// reasons for a server being lame or broken for a zone
+const (
+	LAME_TIMEOUT = iota + 1
+	LAME_SERVFAIL
+	LAME_REFUSED
+	LAME_ERROR   // other error rcodes
+	LAME_NONAUTH // answers without being authoritative
+	LAME_UPWARD  // referral to a parent or unrelated zone
+	LAME_SELF    // referral to the zone itself
+)
+
+var lameStrs = map[int]string{
+	LAME_TIMEOUT:  "timeout",
+	LAME_SERVFAIL: "server-fail",
+	LAME_REFUSED:  "refused",
+	LAME_ERROR:    "error",
+	LAME_NONAUTH:  "non-auth",
+	LAME_UPWARD:   "upward-referral",
+	LAME_SELF:     "self-referral",
+}
+
+func LameStr(reason int) string {
+	ret, has := lameStrs[reason]
+	if has {
+		return ret
+	}
+	return fmt.Sprintf("lame%d", reason)
+}
+
+// how long a server is held down
+const (
+	_LAME_HOLDDOWN   = 15 * time.Minute // for lame delegations
+	_BROKEN_HOLDDOWN = 2 * time.Minute  // for time outs and failures
+)
+
+func holddown(reason int) time.Duration {
+	switch reason {
+	case LAME_TIMEOUT, LAME_SERVFAIL, LAME_ERROR:
+		return _BROKEN_HOLDDOWN
+	}
+	return _LAME_HOLDDOWN
+}
+
+// a server ip that is lame or broken for a zone
+type LameRecord struct {
+	Zone   *Name
+	Host   *IPv4
+	Reason int
+	Expire time.Time
+}
+
+func (r *LameRecord) String() string {
+	return fmt.Sprintf("%s @%s %s", r.Zone, r.Host, LameStr(r.Reason))
+}
+
+// a shared holddown table of lame servers, keyed by (zone, ip)
+type LameTable struct {
+	lock    sync.Mutex
+	entries map[string]*LameRecord
+}
+
+func NewLameTable() *LameTable {
+	return &LameTable{entries: make(map[string]*LameRecord)}
+}
+
+func lameKey(zone *Name, ip *IPv4) string {
+	return zone.String() + "@" + ip.String()
+}
+
+// marks a server as lame for the zone, and returns the record
+func (t *LameTable) Add(zone *Name, ip *IPv4, reason int) *LameRecord {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	ret := &LameRecord{
+		Zone:   zone,
+		Host:   ip,
+		Reason: reason,
+		Expire: time.Now().Add(holddown(reason)),
+	}
+	t.entries[lameKey(zone, ip)] = ret
+	return ret
+}
+
+// returns the record if the server is held down for the zone,
+// nil otherwise
+func (t *LameTable) Check(zone *Name, ip *IPv4) *LameRecord {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	key := lameKey(zone, ip)
+	ret := t.entries[key]
+	if ret == nil {
+		return nil
+	}
+	if ret.Expire.Before(time.Now()) {
+		delete(t.entries, key)
+		return nil
+	}
+	return ret
+}
+
+// lists all records that are still held down
+func (t *LameTable) List() []*LameRecord {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	ret := make([]*LameRecord, 0, len(t.entries))
+	for key, r := range t.entries {
+		if r.Expire.Before(now) {
+			delete(t.entries, key)
+			continue
+		}
+		ret = append(ret, r)
+	}
+	return ret
+}
+
+// checks if a response from a server of zone shows the server is lame
+// returns 0 if the response looks fine
+func lameReason(zone *Name, msg *Msg) int {
+	switch msg.Flags & F_RCODEMASK {
+	case RCODE_OKAY, RCODE_NAMEERROR:
+	case RCODE_SERVERFAIL:
+		return LAME_SERVFAIL
+	case RCODE_REFUSED:
+		return LAME_REFUSED
+	default:
+		return LAME_ERROR
+	}
+
+	if (msg.Flags & F_AA) == F_AA {
+		return 0
+	}
+
+	// not authoritative, must be a proper referral
+	ret := LAME_NONAUTH
+	for _, rr := range msg.Auth {
+		if rr.Class != IN || rr.Type != NS {
+			continue
+		}
+		if rr.Name.SubOf(zone) {
+			return 0 // a referral downwards
+		}
+		if rr.Name.Equal(zone) {
+			ret = LAME_SELF
+		} else if ret != LAME_SELF {
+			ret = LAME_UPWARD
+		}
+	}
+	return ret
+}
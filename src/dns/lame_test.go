@@ -0,0 +1,46 @@
+package dns
+
+import "testing"
+
+func TestLameReason(t *testing.T) {
+	zone := Domain("example.com")
+	ns := func(owner string) RR {
+		return RR{Domain(owner), NS, IN, 3600,
+			&RdName{Domain("ns.example.net")}}
+	}
+	o := func(flags uint16, auth []RR, expect int) {
+		msg := &Msg{Flags: F_RESPONSE | flags, Auth: auth}
+		r := lameReason(zone, msg)
+		if r != expect {
+			t.Errorf("lameReason = %s, expecting %s",
+				LameStr(r), LameStr(expect))
+		}
+	}
+
+	o(F_AA, nil, 0)
+	o(F_AA|RCODE_NAMEERROR, nil, 0)
+	o(RCODE_REFUSED, nil, LAME_REFUSED)
+	o(RCODE_SERVERFAIL, nil, LAME_SERVFAIL)
+	o(0, []RR{ns("sub.example.com")}, 0)
+	o(0, []RR{ns("example.com")}, LAME_SELF)
+	o(0, []RR{ns("com")}, LAME_UPWARD)
+	o(0, nil, LAME_NONAUTH)
+}
+
+func TestLameTable(t *testing.T) {
+	table := NewLameTable()
+	zone := Domain("example.com")
+	ip := ParseIP("192.0.2.1")
+
+	if table.Check(zone, ip) != nil {
+		t.Error("empty table has lame record")
+	}
+	table.Add(zone, ip, LAME_REFUSED)
+	r := table.Check(zone, ip)
+	if r == nil || r.Reason != LAME_REFUSED {
+		t.Error("lame record not found")
+	}
+	if table.Check(Domain("example.org"), ip) != nil {
+		t.Error("lame record should be per zone")
+	}
+}
@@ -0,0 +1,145 @@
+package dns
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// writing records in the master file format of rfc1035 section 5
+// types without a known presentation use the generic form of rfc3597
+
+// the absolute name with the final dot
+func masterName(n *Name) string {
+	if n.IsRoot() {
+		return "."
+	}
+	return n.OrigString() + "."
+}
+
+func masterType(t uint16) string {
+	if _, ok := typeStrs[t]; ok {
+		return strings.ToUpper(TypeStr(t))
+	}
+	return fmt.Sprintf("TYPE%d", t)
+}
+
+func masterClass(c uint16) string {
+	if _, ok := classStrs[c]; ok {
+		return strings.ToUpper(ClassStr(c))
+	}
+	return fmt.Sprintf("CLASS%d", c)
+}
+
+// quotes a character string, escaping quotes, backslashes and
+// non-printable bytes
+func quoteString(b []byte) string {
+	var buf strings.Builder
+	buf.WriteByte('"')
+	for _, c := range b {
+		switch {
+		case c == '"' || c == '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case c < ' ' || c > '~':
+			fmt.Fprintf(&buf, "\\%03d", c)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
+
+// the character strings of a txt record, nil if malformed
+func txtStrings(b []byte) []string {
+	ret := make([]string, 0, 1)
+	for len(b) > 0 {
+		n := int(b[0])
+		if len(b) < n+1 {
+			return nil
+		}
+		ret = append(ret, quoteString(b[1:n+1]))
+		b = b[n+1:]
+	}
+	return ret
+}
+
+func genericRdata(b []byte) []string {
+	ret := []string{`\#`, strconv.Itoa(len(b))}
+	if len(b) > 0 {
+		ret = append(ret, strings.ToUpper(hex.EncodeToString(b)))
+	}
+	return ret
+}
+
+// the rdata fields in the master file format
+func masterRdata(rr *RR) []string {
+	switch rd := rr.Rdata.(type) {
+	case *RdIP:
+		return []string{rd.IP.String()}
+	case *RdName:
+		return []string{masterName(rd.Name)}
+	case *RdMX:
+		return []string{strconv.Itoa(int(rd.Preference)),
+			masterName(rd.Exchange)}
+	case *RdSOA:
+		return []string{
+			masterName(rd.Mname),
+			masterName(rd.Rname),
+			strconv.FormatUint(uint64(rd.Serial), 10),
+			strconv.FormatUint(uint64(rd.Refresh), 10),
+			strconv.FormatUint(uint64(rd.Retry), 10),
+			strconv.FormatUint(uint64(rd.Expire), 10),
+			strconv.FormatUint(uint64(rd.Minimum), 10),
+		}
+	case *RdBytes:
+		if rr.Class == IN && rr.Type == AAAA && len(rd.Data) == net.IPv6len {
+			return []string{net.IP(rd.Data).String()}
+		}
+		if rr.Type == TXT {
+			if ret := txtStrings(rd.Data); len(ret) > 0 {
+				return ret
+			}
+		}
+		return genericRdata(rd.Data)
+	}
+
+	w := new(writer)
+	if err := rr.Rdata.writeTo(w); err != nil {
+		return genericRdata(nil)
+	}
+	return genericRdata(w.wire())
+}
+
+// the record as a line of a master file, without the line end
+func (rr *RR) MasterString() string {
+	fields := []string{
+		masterName(rr.Name),
+		strconv.FormatUint(uint64(rr.TTL), 10),
+		masterClass(rr.Class),
+		masterType(rr.Type),
+	}
+	fields = append(fields, masterRdata(rr)...)
+	return strings.Join(fields, " ")
+}
+
+// the rdata of the record in the master file format
+func (rr *RR) RdataString() string {
+	return strings.Join(masterRdata(rr), " ")
+}
+
+// writes the records in the master file format, one per line
+func WriteMaster(w io.Writer, rrs []RR) error {
+	out := bufio.NewWriter(w)
+	for i := range rrs {
+		if _, err := fmt.Fprintln(out, rrs[i].MasterString()); err != nil {
+			return err
+		}
+	}
+	return out.Flush()
+}
@@ -0,0 +1,492 @@
+package dns
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// reading records in the master file format of rfc1035 section 5
+
+// reads the records of a master file one by one
+type MasterReader struct {
+	scanner *bufio.Scanner
+	origin  *Name
+	ttl     uint32 // the default ttl, by $TTL or the last one seen
+	last    *Name  // the owner of the last record
+	line    int
+
+	// skips the records of unknown types, instead of failing
+	SkipUnknown bool
+}
+
+// a type without a known presentation format
+var errMasterUnknown = errors.New("unknown type")
+
+// origin is for relative names, nil for the root
+func NewMasterReader(in io.Reader, origin *Name) *MasterReader {
+	if origin == nil {
+		origin = rootName
+	}
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	return &MasterReader{scanner: scanner, origin: origin}
+}
+
+func (m *MasterReader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("master line %d: %s", m.line,
+		fmt.Sprintf(format, args...))
+}
+
+// splits a line into tokens, keeping the quotes of quoted strings;
+// returns the depth of the open parentheses after the line
+func masterTokens(s string, depth int, tokens []string) ([]string,
+	int, error) {
+	var tok strings.Builder
+	inTok, quoted := false, false
+	end := func() {
+		if inTok {
+			tokens = append(tokens, tok.String())
+			tok.Reset()
+			inTok = false
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s):
+			tok.WriteByte(c)
+			tok.WriteByte(s[i+1])
+			inTok = true
+			i++
+		case quoted:
+			tok.WriteByte(c)
+			if c == '"' {
+				quoted = false
+				end()
+			}
+		case c == '"':
+			end()
+			tok.WriteByte(c)
+			inTok, quoted = true, true
+		case c == ';':
+			end()
+			return tokens, depth, nil
+		case c == '(':
+			end()
+			depth++
+		case c == ')':
+			end()
+			if depth == 0 {
+				return nil, 0, fmt.Errorf("unbalanced parentheses")
+			}
+			depth--
+		case c == ' ' || c == '\t' || c == '\r':
+			end()
+		default:
+			tok.WriteByte(c)
+			inTok = true
+		}
+	}
+	if quoted {
+		return nil, 0, fmt.Errorf("unterminated quote")
+	}
+	end()
+	return tokens, depth, nil
+}
+
+// reads the tokens of the next entry, which can span lines in
+// parentheses; blank is true if the entry starts with a blank, which
+// means the owner of the last record
+func (m *MasterReader) entry() (tokens []string, blank bool, err error) {
+	depth := 0
+	first := true
+	for m.scanner.Scan() {
+		m.line++
+		s := m.scanner.Text()
+		if first {
+			blank = len(s) > 0 && (s[0] == ' ' || s[0] == '\t')
+		}
+		tokens, depth, err = masterTokens(s, depth, tokens)
+		if err != nil {
+			return nil, false, m.errorf("%s", err)
+		}
+		if len(tokens) == 0 && depth == 0 {
+			first = true
+			continue // empty line or comment
+		}
+		first = false
+		if depth == 0 {
+			return tokens, blank, nil
+		}
+	}
+	if err := m.scanner.Err(); err != nil {
+		return nil, false, err
+	}
+	if depth > 0 {
+		return nil, false, m.errorf("unbalanced parentheses")
+	}
+	return nil, false, io.EOF
+}
+
+// a name relative to the origin unless it ends with a dot
+func (m *MasterReader) name(s string) (*Name, error) {
+	if s == "@" {
+		return m.origin, nil
+	}
+	if s == "." {
+		return rootName, nil
+	}
+	if strings.HasSuffix(s, ".") && !strings.HasSuffix(s, "\\.") {
+		return NewName(strings.TrimSuffix(s, "."))
+	}
+	n, err := NewName(s)
+	if err != nil {
+		return nil, err
+	}
+	return n.Concat(m.origin)
+}
+
+// parses a ttl in seconds, or with units like 1h30m
+func parseTTL(s string) (uint32, bool) {
+	if i, err := strconv.ParseUint(s, 10, 32); err == nil {
+		return uint32(i), true
+	}
+	var ret, cur uint64
+	digits := false
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			cur = cur*10 + uint64(c-'0')
+			digits = true
+			continue
+		}
+		if !digits {
+			return 0, false
+		}
+		switch c {
+		case 's':
+		case 'm':
+			cur *= 60
+		case 'h':
+			cur *= 3600
+		case 'd':
+			cur *= 86400
+		case 'w':
+			cur *= 604800
+		default:
+			return 0, false
+		}
+		ret += cur
+		cur, digits = 0, false
+	}
+	if digits || ret > 0xffffffff {
+		return 0, false
+	}
+	return uint32(ret), true
+}
+
+// parses a type mnemonic or TYPEnnn
+func ParseType(s string) (uint16, bool) {
+	s = strings.ToLower(s)
+	for t, str := range typeStrs {
+		if str == s {
+			return t, true
+		}
+	}
+	if strings.HasPrefix(s, "type") {
+		if i, err := strconv.ParseUint(s[4:], 10, 16); err == nil {
+			return uint16(i), true
+		}
+	}
+	return 0, false
+}
+
+// parses a class mnemonic or CLASSnnn
+func ParseClass(s string) (uint16, bool) {
+	s = strings.ToLower(s)
+	for c, str := range classStrs {
+		if str == s {
+			return c, true
+		}
+	}
+	if strings.HasPrefix(s, "class") {
+		if i, err := strconv.ParseUint(s[5:], 10, 16); err == nil {
+			return uint16(i), true
+		}
+	}
+	return 0, false
+}
+
+func (m *MasterReader) directive(tokens []string) error {
+	switch strings.ToUpper(tokens[0]) {
+	case "$ORIGIN":
+		if len(tokens) < 2 {
+			return m.errorf("$ORIGIN without a name")
+		}
+		n, err := m.name(tokens[1])
+		if err != nil {
+			return m.errorf("%s", err)
+		}
+		m.origin = n
+	case "$TTL":
+		if len(tokens) < 2 {
+			return m.errorf("$TTL without a ttl")
+		}
+		ttl, ok := parseTTL(tokens[1])
+		if !ok {
+			return m.errorf("bad ttl %q", tokens[1])
+		}
+		m.ttl = ttl
+	default:
+		return m.errorf("unsupported directive %s", tokens[0])
+	}
+	return nil
+}
+
+// returns the next record, or io.EOF at the end
+func (m *MasterReader) Next() (*RR, error) {
+	for {
+		tokens, blank, err := m.entry()
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(tokens[0], "$") && !blank {
+			if err := m.directive(tokens); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		rr, err := m.record(tokens, blank)
+		if err == errMasterUnknown && m.SkipUnknown {
+			continue
+		}
+		if err == errMasterUnknown {
+			return nil, m.errorf("unsupported record %q",
+				strings.Join(tokens, " "))
+		}
+		return rr, err
+	}
+}
+
+func (m *MasterReader) record(tokens []string, blank bool) (*RR, error) {
+	rr := &RR{Class: IN, TTL: m.ttl}
+	if blank {
+		if m.last == nil {
+			return nil, m.errorf("no owner")
+		}
+		rr.Name = m.last
+	} else {
+		n, err := m.name(tokens[0])
+		if err != nil {
+			return nil, m.errorf("%s", err)
+		}
+		rr.Name = n
+		tokens = tokens[1:]
+	}
+
+	// the ttl and the class in any order before the type
+	for {
+		if len(tokens) == 0 {
+			return nil, m.errorf("no type")
+		}
+		if ttl, ok := parseTTL(tokens[0]); ok {
+			rr.TTL = ttl
+			m.ttl = ttl
+		} else if c, ok := ParseClass(tokens[0]); ok {
+			rr.Class = c
+		} else {
+			break
+		}
+		tokens = tokens[1:]
+	}
+	t, ok := ParseType(tokens[0])
+	if !ok {
+		return nil, errMasterUnknown
+	}
+	rr.Type = t
+
+	rd, err := m.rdata(rr, tokens[1:])
+	if err == errMasterUnknown {
+		m.last = rr.Name
+		return nil, err
+	}
+	if err != nil {
+		return nil, m.errorf("%s %s: %s", rr.Name, TypeStr(t), err)
+	}
+	rr.Rdata = rd
+	m.last = rr.Name
+	return rr, nil
+}
+
+// parses the generic rdata of rfc3597
+func parseGeneric(fields []string) (Rdata, error) {
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("no rdata length")
+	}
+	n, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(strings.Join(fields[2:], ""))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != n {
+		return nil, fmt.Errorf("rdata length mismatch")
+	}
+	return &RdBytes{b}, nil
+}
+
+// parses a quoted or plain character string
+func parseString(s string) ([]byte, error) {
+	if strings.HasPrefix(s, `"`) {
+		s = strings.TrimSuffix(s[1:], `"`)
+	}
+	ret := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 >= len(s) {
+			ret = append(ret, s[i])
+			continue
+		}
+		if i+3 < len(s) && isDigit(s[i+1]) && isDigit(s[i+2]) &&
+			isDigit(s[i+3]) {
+			c, _ := strconv.Atoi(s[i+1 : i+4])
+			if c > 255 {
+				return nil, fmt.Errorf("bad escape in %q", s)
+			}
+			ret = append(ret, byte(c))
+			i += 3
+			continue
+		}
+		ret = append(ret, s[i+1])
+		i++
+	}
+	if len(ret) > 255 {
+		return nil, fmt.Errorf("string too long")
+	}
+	return ret, nil
+}
+
+// parses the rdata fields of a record of the type
+func (m *MasterReader) rdata(rr *RR, fields []string) (Rdata, error) {
+	if len(fields) > 0 && fields[0] == `\#` {
+		return parseGeneric(fields)
+	}
+
+	need := func(n int) error {
+		if len(fields) != n {
+			return fmt.Errorf("%d rdata fields, expecting %d",
+				len(fields), n)
+		}
+		return nil
+	}
+
+	switch rr.Type {
+	case A:
+		if err := need(1); err != nil {
+			return nil, err
+		}
+		ip := ParseIP(fields[0])
+		if ip == nil {
+			return nil, fmt.Errorf("bad ipv4 address %q", fields[0])
+		}
+		return &RdIP{ip}, nil
+	case AAAA:
+		if err := need(1); err != nil {
+			return nil, err
+		}
+		ip := net.ParseIP(fields[0])
+		if ip == nil || ip.To4() != nil {
+			return nil, fmt.Errorf("bad ipv6 address %q", fields[0])
+		}
+		return &RdBytes{ip.To16()}, nil
+	case NS, CNAME, PTR, DNAME:
+		if err := need(1); err != nil {
+			return nil, err
+		}
+		n, err := m.name(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		return &RdName{n}, nil
+	case MX:
+		if err := need(2); err != nil {
+			return nil, err
+		}
+		pref, err := parseUint(fields[0], 16)
+		if err != nil {
+			return nil, err
+		}
+		n, err := m.name(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		return &RdMX{uint16(pref), n}, nil
+	case SOA:
+		if err := need(7); err != nil {
+			return nil, err
+		}
+		rd := new(RdSOA)
+		var err error
+		if rd.Mname, err = m.name(fields[0]); err != nil {
+			return nil, err
+		}
+		if rd.Rname, err = m.name(fields[1]); err != nil {
+			return nil, err
+		}
+		serial, err := parseUint(fields[2], 32)
+		if err != nil {
+			return nil, err
+		}
+		rd.Serial = uint32(serial)
+		timers := []*uint32{&rd.Refresh, &rd.Retry, &rd.Expire, &rd.Minimum}
+		for i, p := range timers {
+			v, ok := parseTTL(fields[3+i])
+			if !ok {
+				return nil, fmt.Errorf("bad soa timer %q", fields[3+i])
+			}
+			*p = v
+		}
+		return rd, nil
+	case TXT:
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("no strings")
+		}
+		var data []byte
+		for _, f := range fields {
+			s, err := parseString(f)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, byte(len(s)))
+			data = append(data, s...)
+		}
+		return &RdBytes{data}, nil
+	case DS:
+		return parseAnchorDS(fields)
+	case DNSKEY:
+		return parseAnchorKey(fields)
+	}
+	return nil, errMasterUnknown
+}
+
+// reads all the records of a master file
+func ReadMaster(in io.Reader, origin *Name) ([]RR, error) {
+	m := NewMasterReader(in, origin)
+	ret := make([]RR, 0, 64)
+	for {
+		rr, err := m.Next()
+		if err == io.EOF {
+			return ret, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, *rr)
+	}
+}
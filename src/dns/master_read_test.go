@@ -0,0 +1,77 @@
+package dns
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadMaster(t *testing.T) {
+	rrs, err := ReadMaster(strings.NewReader(`
+$ORIGIN m.test.
+$TTL 1h
+@   IN  SOA ns1 hostmaster (
+            2024010101 ; serial
+            2h 15m 2w 1d )
+    IN  NS  ns1
+    IN  NS  ns.other.test.
+ns1 300 A   192.0.2.1
+www IN 60 CNAME ns1
+txt     TXT "a \"quoted\" string" plain
+mail    MX  10 ns1.m.test.
+odd     TYPE1234 \# 2 abcd
+`), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rrs) != 8 {
+		t.Fatalf("%d records", len(rrs))
+	}
+
+	soa := rrs[0].Rdata.(*RdSOA)
+	if !rrs[0].Name.Equal(Domain("m.test")) || rrs[0].TTL != 3600 ||
+		!soa.Mname.Equal(Domain("ns1.m.test")) || soa.Serial != 2024010101 ||
+		soa.Refresh != 7200 || soa.Expire != 14*86400 || soa.Minimum != 86400 {
+		t.Errorf("soa: %s", rrs[0].MasterString())
+	}
+	if !rrs[1].Name.Equal(Domain("m.test")) ||
+		!rrs[2].Rdata.(*RdName).Name.Equal(Domain("ns.other.test")) {
+		t.Errorf("ns: %s, %s", rrs[1].MasterString(), rrs[2].MasterString())
+	}
+	if rrs[3].TTL != 300 || rrs[4].TTL != 60 || rrs[5].TTL != 60 {
+		t.Errorf("ttls: %d %d %d", rrs[3].TTL, rrs[4].TTL, rrs[5].TTL)
+	}
+	want := "\x11a \"quoted\" string\x05plain"
+	if got := string(rrs[5].Rdata.(*RdBytes).Data); got != want {
+		t.Errorf("txt: %q", got)
+	}
+	if rrs[7].Type != 1234 ||
+		!bytes.Equal(rrs[7].Rdata.(*RdBytes).Data, []byte{0xab, 0xcd}) {
+		t.Errorf("generic: %s", rrs[7].MasterString())
+	}
+
+	// what is written reads back the same
+	var buf bytes.Buffer
+	if err := WriteMaster(&buf, rrs); err != nil {
+		t.Fatal(err)
+	}
+	again, err := ReadMaster(&buf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameRRs(rrs, again) {
+		t.Error("records changed after writing and reading")
+	}
+
+	for _, bad := range []string{
+		"a.test. A 192.0.2.1 (",
+		"a.test. 300 IN A 2001:db8::1",
+		"a.test. 300 IN FOO bar",
+		"$INCLUDE other.zone",
+		"  A 192.0.2.1",
+	} {
+		if _, err := ReadMaster(strings.NewReader(bad), nil); err == nil {
+			t.Errorf("%q parsed", bad)
+		}
+	}
+}
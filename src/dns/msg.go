@@ -90,21 +90,35 @@ func (m *Msg) ForEachIN(f func(*RR, int)) {
 }
 
 var typeStrs = map[uint16]string{
-	A:     "a",
-	NS:    "ns",
-	MD:    "md",
-	MF:    "mf",
-	CNAME: "cname",
-	SOA:   "soa",
-	MB:    "mb",
-	MG:    "mg",
-	MR:    "mr",
-	NULL:  "null",
-	HINFO: "hinfo",
-	MINFO: "minfo",
-	MX:    "mx",
-	TXT:   "txt",
-	AAAA:  "aaaa",
+	A:          "a",
+	NS:         "ns",
+	MD:         "md",
+	MF:         "mf",
+	CNAME:      "cname",
+	SOA:        "soa",
+	MB:         "mb",
+	MG:         "mg",
+	MR:         "mr",
+	NULL:       "null",
+	WKS:        "wks",
+	PTR:        "ptr",
+	HINFO:      "hinfo",
+	MINFO:      "minfo",
+	MX:         "mx",
+	TXT:        "txt",
+	AAAA:       "aaaa",
+	DNAME:      "dname",
+	OPT:        "opt",
+	DS:         "ds",
+	RRSIG:      "rrsig",
+	NSEC:       "nsec",
+	DNSKEY:     "dnskey",
+	NSEC3:      "nsec3",
+	NSEC3PARAM: "nsec3param",
+	TSIG:       "tsig",
+	IXFR:       "ixfr",
+	AXFR:       "axfr",
+	ANY:        "any",
 }
 
 func TypeStr(t uint16) string {
@@ -116,10 +130,12 @@ func TypeStr(t uint16) string {
 }
 
 var classStrs = map[uint16]string{
-	IN: "in",
-	CS: "cs",
-	CH: "ch",
-	HS: "hs",
+	IN:   "in",
+	CS:   "cs",
+	CH:   "ch",
+	HS:   "hs",
+	NONE: "none",
+	ANY:  "any",
 }
 
 func ClassStr(t uint16) string {
@@ -130,6 +146,27 @@ func ClassStr(t uint16) string {
 	return fmt.Sprintf("c%d", t)
 }
 
+var rcodeStrs = map[int]string{
+	RCODE_FORMATERROR:  "format-err",
+	RCODE_SERVERFAIL:   "server-fail",
+	RCODE_NAMEERROR:    "name-err",
+	RCODE_NOTIMPLEMENT: "not-impl",
+	RCODE_REFUSED:      "refused",
+	RCODE_YXDOMAIN:     "yx-domain",
+	RCODE_YXRRSET:      "yx-rrset",
+	RCODE_NXRRSET:      "nx-rrset",
+	RCODE_NOTAUTH:      "not-auth",
+	RCODE_NOTZONE:      "not-zone",
+}
+
+func RcodeStr(rcode int) string {
+	ret, has := rcodeStrs[rcode]
+	if has {
+		return ret
+	}
+	return fmt.Sprintf("unknown(%d)", rcode)
+}
+
 func TTLStr(t uint32) string {
 	if t == 0 {
 		return "0"
@@ -167,6 +204,16 @@ func (q *Ques) printTo(p *printer) {
 }
 
 func (rr *RR) printTo(p *printer) {
+	if rr.Type == OPT {
+		slist := []string{rr.Name.String(), "opt",
+			fmt.Sprintf("udp=%d", rr.Class)}
+		if rr.TTL&EDNS_DO != 0 {
+			slist = append(slist, "do")
+		}
+		p.Print(slist...)
+		return
+	}
+
 	slist := make([]string, 0, 10)
 	slist = append(slist, rr.Name.String())
 	slist = append(slist, TypeStr(rr.Type))
@@ -208,6 +255,12 @@ func (m *Msg) printTo(p *printer) {
 	if (m.Flags & F_OPMASK) == OPSTATUS {
 		fstr = append(fstr, "op=status")
 	}
+	if (m.Flags & F_OPMASK) == OPNOTIFY {
+		fstr = append(fstr, "op=notify")
+	}
+	if (m.Flags & F_OPMASK) == OPUPDATE {
+		fstr = append(fstr, "op=update")
+	}
 	if (m.Flags & F_AA) == F_AA {
 		fstr = append(fstr, "auth")
 	}
@@ -225,22 +278,7 @@ func (m *Msg) printTo(p *printer) {
 	}
 	rcode := m.Flags & F_RCODEMASK
 	if rcode != RCODE_OKAY {
-		var rs string
-		switch rcode {
-		case RCODE_FORMATERROR:
-			rs = "format-err"
-		case RCODE_SERVERFAIL:
-			rs = "server-fail"
-		case RCODE_NAMEERROR:
-			rs = "name-err"
-		case RCODE_NOTIMPLEMENT:
-			rs = "not-impl"
-		case RCODE_REFUSED:
-			rs = "refused"
-		default:
-			rs = fmt.Sprintf("unknown(%d)", rcode)
-		}
-		p.Print("rcode", rs)
+		p.Print("rcode", RcodeStr(int(rcode)))
 	}
 
 	if len(m.Ques) > 0 {
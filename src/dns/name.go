@@ -6,10 +6,15 @@ import (
 )
 
 // immutable
+// labels can hold arbitrary octets; they are lower cased (ascii only)
+// for comparing, and the original case is kept in orig
 type Name struct {
 	labels []string
+	orig   []string // nil if same as labels
 }
 
+var rootName = &Name{labels: []string{}}
+
 type nameError struct {
 	name string
 	s    string
@@ -40,14 +45,90 @@ func (n *Name) Equal(other *Name) bool {
 	return true
 }
 
+// lower cases ascii letters only, other octets are kept as is
+func lowerLabel(s string) string {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' {
+			b := []byte(s)
+			for j := i; j < len(b); j++ {
+				if 'A' <= b[j] && b[j] <= 'Z' {
+					b[j] += 'a' - 'A'
+				}
+			}
+			return string(b)
+		}
+	}
+	return s
+}
+
+// makes a name from labels in original case
+func nameFromLabels(orig []string) *Name {
+	labels := make([]string, len(orig))
+	same := true
+	for i, lab := range orig {
+		labels[i] = lowerLabel(lab)
+		if labels[i] != lab {
+			same = false
+		}
+	}
+	if same {
+		return &Name{labels: labels}
+	}
+	return &Name{labels, orig}
+}
+
+// escapes a label in rfc1035 presentation format
+func escapeLabel(s string) string {
+	var buf []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '.' || c == '\\' || c == '"' || c == '(' || c == ')' ||
+			c == ';' || c == '@' || c == '$':
+			buf = append(buf, '\\', c)
+		case c <= ' ' || c >= 0x7f:
+			buf = append(buf, []byte(fmt.Sprintf("\\%03d", c))...)
+		default:
+			buf = append(buf, c)
+		}
+	}
+	return string(buf)
+}
+
+func joinLabels(labels []string) string {
+	if len(labels) == 0 {
+		return "."
+	}
+	escaped := make([]string, len(labels))
+	for i, lab := range labels {
+		escaped[i] = escapeLabel(lab)
+	}
+	return strings.Join(escaped, ".")
+}
+
+// the lower cased presentation form, without the final dot
 func (n *Name) String() string {
 	if n == nil {
 		fmt.Println("nil lables")
 	}
-	if len(n.labels) > 0 {
-		return strings.Join(n.labels, ".")
+	return joinLabels(n.labels)
+}
+
+// the presentation form in original case
+func (n *Name) OrigString() string {
+	if n.orig == nil {
+		return n.String()
+	}
+	return joinLabels(n.orig)
+}
+
+// the labels for the wire, in original case
+func (n *Name) wireLabels() []string {
+	if n.orig == nil {
+		return n.labels
 	}
-	return "."
+	return n.orig
 }
 
 // for programming use, will panic on fail
@@ -60,76 +141,159 @@ func Domain(s string) *Name {
 	return ret
 }
 
+// parses a name in presentation format, where \DDD and \X escapes
+// can be used for arbitrary octets
+// labels with (unescaped) unicode characters are converted to the
+// xn-- form with idna
+// it does not check if the name is a valid host name
 func NewName(s string) (ret *Name, e error) {
 	if len(s) == 0 {
 		return nil, &nameError{s, "empty name"}
 	}
+	s = idnaDots.Replace(s)
 
-	if len(s) > 255 {
-		return nil, &nameError{s, "name too long"}
-	}
-
-	if s[len(s)-1] != '.' {
-		s += "."
+	if s == "." {
+		return &Name{labels: []string{}}, nil
 	}
 
 	labels := make([]string, 0, 8)
+	label := make([]byte, 0, 63)
+	sum := 1
+	ended := false
+	unicode := false // has unicode characters
+	bidi := false    // has a right to left label
 
-	last := byte('.')
-	ok := false
-	partlen := 0
-	start := 0
+	endLabel := func() error {
+		if len(label) == 0 {
+			return &nameError{s, "empty label"}
+		}
+		if unicode {
+			ascii, e := idnaLabel(string(label))
+			if e != nil {
+				return e
+			}
+			if idnaHasRTL([]rune(string(label))) {
+				bidi = true
+			}
+			label = append(label[:0], ascii...)
+			unicode = false
+		}
+		if len(label) > 63 {
+			return &nameError{s, "label too long"}
+		}
+		sum += len(label) + 1
+		if sum > 255 {
+			return &nameError{s, "name too long"}
+		}
+		labels = append(labels, string(label))
+		label = label[:0]
+		return nil
+	}
 
-	if s != "." {
-		for i := 0; i < len(s); i++ {
-			c := s[i]
-			switch {
-			default:
-				return nil, &nameError{s, "special characters"}
-			case 'a' <= c && c <= 'z':
-				fallthrough
-			case 'A' <= c && c <= 'Z':
-				c = c - 'A' + 'a'
-				fallthrough
-			case c == '_':
-				ok = true
-				partlen++
-			case '0' <= c && c <= '9':
-				partlen++
-			case c == '-':
-				if last == '.' {
-					return nil, &nameError{s, "dash before dot"}
-				}
-				partlen++
-			case c == '.':
-				if last == '.' {
-					return nil, &nameError{s, "consecutive dot"}
-				}
-				if last == '-' {
-					return nil, &nameError{s, "dash after dot"}
-				}
-				if partlen > 63 {
-					return nil, &nameError{s, "label too long"}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		ended = false
+		switch c {
+		case '.':
+			if e := endLabel(); e != nil {
+				return nil, e
+			}
+			ended = true
+		case '\\':
+			i++
+			if i >= len(s) {
+				return nil, &nameError{s, "bad escape at end"}
+			}
+			if isDigit(s[i]) {
+				if i+2 >= len(s) || !isDigit(s[i+1]) || !isDigit(s[i+2]) {
+					return nil, &nameError{s, "bad \\DDD escape"}
 				}
-				if partlen == 0 {
-					return nil, &nameError{s, "start with dot, empty label"}
+				d := int(s[i]-'0')*100 + int(s[i+1]-'0')*10 +
+					int(s[i+2]-'0')
+				if d > 255 {
+					return nil, &nameError{s, "bad \\DDD escape"}
 				}
-				partlen = 0
-				labels = append(labels, string(s[start:i]))
-				start = i + 1
+				label = append(label, byte(d))
+				i += 2
+			} else {
+				label = append(label, s[i])
+			}
+		default:
+			if c >= 0x80 {
+				unicode = true
 			}
-			last = c
+			label = append(label, c)
 		}
+	}
 
-		if !ok {
-			return nil, &nameError{s, "all numbers, maybe IP"}
+	if !ended {
+		if e := endLabel(); e != nil {
+			return nil, e
+		}
+	}
+	if bidi {
+		if e := idnaBidiName(labels); e != nil {
+			return nil, e
 		}
 	}
 
-	ret = &Name{labels}
+	return nameFromLabels(labels), nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// parses a name and checks that it is a valid host name
+func NewHostname(s string) (*Name, error) {
+	ret, e := NewName(s)
+	if e != nil {
+		return nil, e
+	}
+	if e = ret.CheckHostname(); e != nil {
+		return nil, e
+	}
 	return ret, nil
 }
 
+// checks that all labels are made of letters, digits, dashes and
+// underscores, with no dash at the start or the end, and that the name
+// is not all numbers, which is maybe an IP
+func (n *Name) CheckHostname() error {
+	if n.IsRoot() {
+		return nil
+	}
+
+	ok := false
+	for _, lab := range n.labels {
+		if lab[0] == '-' {
+			return &nameError{n.String(), "dash before dot"}
+		}
+		if lab[len(lab)-1] == '-' {
+			return &nameError{n.String(), "dash after dot"}
+		}
+		for i := 0; i < len(lab); i++ {
+			c := lab[i]
+			switch {
+			default:
+				return &nameError{n.String(), "special characters"}
+			case 'a' <= c && c <= 'z', c == '_':
+				ok = true
+			case isDigit(c), c == '-':
+			}
+		}
+	}
+
+	if !ok {
+		return &nameError{n.String(), "all numbers, maybe IP"}
+	}
+	return nil
+}
+
+func (n *Name) IsHostname() bool {
+	return n.CheckHostname() == nil
+}
+
 func (n *Name) SubOf(other *Name) bool {
 	if len(other.labels) == 0 {
 		return true
@@ -146,6 +310,26 @@ func (n *Name) SubOf(other *Name) bool {
 	return true
 }
 
+// replaces the suffix old of n with new, n must be a sub of old
+func (n *Name) replaceSuffix(old, new *Name) (*Name, error) {
+	if !n.SubOf(old) {
+		return nil, &nameError{n.String(), "not under " + old.String()}
+	}
+	prefix := n.wireLabels()[:len(n.labels)-len(old.labels)]
+	labels := make([]string, 0, len(prefix)+len(new.labels))
+	labels = append(labels, prefix...)
+	labels = append(labels, new.wireLabels()...)
+
+	sum := 1
+	for _, lab := range labels {
+		sum += len(lab) + 1
+	}
+	if sum > 255 {
+		return nil, &nameError{n.String(), "name too long after rewrite"}
+	}
+	return nameFromLabels(labels), nil
+}
+
 func (n *Name) ParentOf(other *Name) bool {
 	return other.SubOf(n)
 }
@@ -160,5 +344,10 @@ func (n *Name) Parent() *Name {
 	}
 	labels := make([]string, len(n.labels)-1)
 	copy(labels, n.labels[1:])
-	return &Name{labels}
+	if n.orig == nil {
+		return &Name{labels: labels}
+	}
+	orig := make([]string, len(n.orig)-1)
+	copy(orig, n.orig[1:])
+	return &Name{labels, orig}
 }
@@ -0,0 +1,129 @@
+package dns
+
+import (
+	"sort"
+)
+
+// number of labels, 0 for the root
+func (n *Name) NumLabels() int {
+	return len(n.labels)
+}
+
+// the i-th label from the left, lower cased
+func (n *Name) Label(i int) string {
+	return n.labels[i]
+}
+
+// a copy of the labels from the left, lower cased
+func (n *Name) Labels() []string {
+	ret := make([]string, len(n.labels))
+	copy(ret, n.labels)
+	return ret
+}
+
+// length of the name on the wire, without compression
+func wireLen(labels []string) int {
+	sum := 1
+	for _, lab := range labels {
+		sum += len(lab) + 1
+	}
+	return sum
+}
+
+func makeName(s string, labels []string) (*Name, error) {
+	for _, lab := range labels {
+		if len(lab) == 0 {
+			return nil, &nameError{s, "empty label"}
+		}
+		if len(lab) > 63 {
+			return nil, &nameError{s, "label too long"}
+		}
+	}
+	if wireLen(labels) > 255 {
+		return nil, &nameError{s, "name too long"}
+	}
+	return nameFromLabels(labels), nil
+}
+
+// makes the name with a label prepended, the label can have any octets
+func (n *Name) Child(label string) (*Name, error) {
+	labels := make([]string, 0, len(n.labels)+1)
+	labels = append(labels, label)
+	labels = append(labels, n.wireLabels()...)
+	return makeName(escapeLabel(label)+"."+n.String(), labels)
+}
+
+// makes the name of n followed by suffix, like www + example.com
+func (n *Name) Concat(suffix *Name) (*Name, error) {
+	labels := make([]string, 0, len(n.labels)+len(suffix.labels))
+	labels = append(labels, n.wireLabels()...)
+	labels = append(labels, suffix.wireLabels()...)
+	return makeName(n.String()+"."+suffix.String(), labels)
+}
+
+// the labels of n relative to origin, which must be n or a parent of n
+// returns false if n is not under origin
+func (n *Name) RelativeTo(origin *Name) ([]string, bool) {
+	if n.Equal(origin) {
+		return []string{}, true
+	}
+	if !n.SubOf(origin) {
+		return nil, false
+	}
+	return n.Labels()[:len(n.labels)-len(origin.labels)], true
+}
+
+// the longest name that both n and other are equal to or under
+func (n *Name) CommonAncestor(other *Name) *Name {
+	i := len(n.labels) - 1
+	j := len(other.labels) - 1
+	for i >= 0 && j >= 0 && n.labels[i] == other.labels[j] {
+		i--
+		j--
+	}
+	ret := n
+	for k := -1; k < i; k++ {
+		ret = ret.Parent()
+	}
+	return ret
+}
+
+// compares two labels as lower cased octet strings
+func compareLabel(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compares names in the canonical order of rfc4034 section 6.1:
+// from the rightmost labels, and a parent comes before its children
+// returns -1, 0 or 1
+func (n *Name) Compare(other *Name) int {
+	i := len(n.labels) - 1
+	j := len(other.labels) - 1
+	for i >= 0 && j >= 0 {
+		if c := compareLabel(n.labels[i], other.labels[j]); c != 0 {
+			return c
+		}
+		i--
+		j--
+	}
+	switch {
+	case i >= 0:
+		return 1
+	case j >= 0:
+		return -1
+	}
+	return 0
+}
+
+// sorts names in the canonical order
+func SortNames(names []*Name) {
+	sort.Slice(names, func(i, j int) bool {
+		return names[i].Compare(names[j]) < 0
+	})
+}
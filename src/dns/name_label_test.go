@@ -0,0 +1,73 @@
+package dns
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNameLabels(t *testing.T) {
+	n := Domain("www.Example.com")
+	if n.NumLabels() != 3 || n.Label(1) != "example" {
+		t.Errorf("wrong labels: %v", n.Labels())
+	}
+
+	c, e := n.Child("a.b")
+	if e != nil || c.String() != `a\.b.www.example.com` ||
+		!c.Parent().Equal(n) {
+		t.Errorf("wrong child: %s %v", c, e)
+	}
+	if _, e = n.Child(""); e == nil {
+		t.Error("empty label should fail")
+	}
+	if _, e = n.Child(strings.Repeat("x", 64)); e == nil {
+		t.Error("long label should fail")
+	}
+
+	cat, e := Domain("a.b").Concat(Domain("c.d"))
+	if e != nil || !cat.Equal(Domain("a.b.c.d")) {
+		t.Errorf("wrong concat: %s", cat)
+	}
+
+	rel, ok := n.RelativeTo(Domain("com"))
+	if !ok || strings.Join(rel, ".") != "www.example" {
+		t.Errorf("wrong relative: %v", rel)
+	}
+	if _, ok = n.RelativeTo(Domain("org")); ok {
+		t.Error("www.example.com is not under org")
+	}
+
+	o := func(a, b, anc string) {
+		got := Domain(a).CommonAncestor(Domain(b))
+		if !got.Equal(Domain(anc)) {
+			t.Errorf("CommonAncestor(%s, %s) = %s", a, b, got)
+		}
+	}
+	o("a.example.com", "b.example.com", "example.com")
+	o("example.com", "x.y.example.com", "example.com")
+	o("example.com", "example.org", ".")
+}
+
+func TestCanonicalOrder(t *testing.T) {
+	// the example from rfc4034 section 6.1
+	expect := []string{
+		"example",
+		"a.example",
+		"yljkjljk.a.example",
+		`Z.a.example`,
+		`zABC.a.EXAMPLE`,
+		"z.example",
+		`\001.z.example`,
+		`*.z.example`,
+		`\200.z.example`,
+	}
+	names := make([]*Name, len(expect))
+	for i := range expect {
+		names[len(expect)-1-i] = Domain(expect[i])
+	}
+	SortNames(names)
+	for i, n := range names {
+		if !n.Equal(Domain(expect[i])) {
+			t.Errorf("position %d: %s, expecting %s", i, n, expect[i])
+		}
+	}
+}
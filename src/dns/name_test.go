@@ -30,3 +30,65 @@ func TestName(t *testing.T) {
 		t.Error("parent of google.com is not com")
 	}
 }
+
+func TestNameEscape(t *testing.T) {
+	n := Domain(`a\.b.\042x\ y.*.Example.COM`)
+	if len(n.labels) != 5 || n.labels[0] != "a.b" ||
+		n.labels[1] != "*x y" || n.labels[2] != "*" {
+		t.Errorf("wrong labels: %q", n.labels)
+	}
+	if n.String() != `a\.b.*x\032y.*.example.com` {
+		t.Errorf("wrong string: %s", n)
+	}
+	if n.OrigString() != `a\.b.*x\032y.*.Example.COM` {
+		t.Errorf("wrong original string: %s", n.OrigString())
+	}
+	if !Domain(n.String()).Equal(n) {
+		t.Error("presentation does not round trip")
+	}
+	if !n.Parent().Parent().Parent().Equal(Domain("example.com")) {
+		t.Error("wrong parent")
+	}
+
+	for _, s := range []string{`a\`, `a\25`, `a\256.b`, "a..b", ".a"} {
+		if _, e := NewName(s); e == nil {
+			t.Errorf("%q should fail", s)
+		}
+	}
+}
+
+func TestHostname(t *testing.T) {
+	for _, s := range []string{"a-b.c", "_dmarc.example.com", "."} {
+		if _, e := NewHostname(s); e != nil {
+			t.Errorf("%s: %s", s, e)
+		}
+	}
+	for _, s := range []string{"*.c", "-a.c", "a-.c", "a b.c", "1.2.3.4"} {
+		if Domain(s).IsHostname() {
+			t.Errorf("%q should not be a host name", s)
+		}
+	}
+}
+
+func TestWireName(t *testing.T) {
+	// a response with a wildcard, a space and a non-ascii octet
+	wire := []byte{
+		0, 1, 0x80, 0, 0, 1, 0, 0, 0, 0, 0, 0,
+		1, '*', 3, 'A', ' ', 0xe9, 2, 'c', 'n', 0,
+		0, 1, 0, 1,
+	}
+	m, e := ParseMsg(wire)
+	if e != nil {
+		t.Fatal(e)
+	}
+	n := m.Ques[0].Name
+	if n.String() != `*.a\032\233.cn` || n.OrigString() != `*.A\032\233.cn` {
+		t.Errorf("wrong name: %s", n.OrigString())
+	}
+
+	w := new(writer)
+	w.writeName(n)
+	if string(w.wire()) != string(wire[12:22]) {
+		t.Errorf("wrong wire: %v", w.wire())
+	}
+}
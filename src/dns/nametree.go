@@ -0,0 +1,157 @@
+package dns
+
+// a label trie keyed by names, from the root label down
+// it is not safe for concurrent use
+type NameTree struct {
+	root *treeNode
+	size int
+}
+
+type treeNode struct {
+	children map[string]*treeNode
+	name     *Name // nil if no value here
+	value    interface{}
+}
+
+func newTreeNode() *treeNode {
+	return &treeNode{children: make(map[string]*treeNode)}
+}
+
+func NewNameTree() *NameTree {
+	return &NameTree{root: newTreeNode()}
+}
+
+// number of names in the tree
+func (t *NameTree) Len() int {
+	return t.size
+}
+
+// returns the node of the name, creates one if create is true
+func (t *NameTree) find(n *Name, create bool) *treeNode {
+	node := t.root
+	for i := len(n.labels) - 1; i >= 0; i-- {
+		lab := n.labels[i]
+		next := node.children[lab]
+		if next == nil {
+			if !create {
+				return nil
+			}
+			next = newTreeNode()
+			node.children[lab] = next
+		}
+		node = next
+	}
+	return node
+}
+
+// sets the value of a name, returns true if it replaces an old one
+func (t *NameTree) Insert(n *Name, v interface{}) bool {
+	node := t.find(n, true)
+	replaced := node.name != nil
+	if !replaced {
+		t.size++
+	}
+	node.name = n
+	node.value = v
+	return replaced
+}
+
+// exact lookup
+func (t *NameTree) Get(n *Name) (interface{}, bool) {
+	node := t.find(n, false)
+	if node == nil || node.name == nil {
+		return nil, false
+	}
+	return node.value, true
+}
+
+// removes a name, returns true if it was in the tree
+func (t *NameTree) Delete(n *Name) bool {
+	// remember the path for pruning empty nodes
+	path := make([]*treeNode, 0, len(n.labels)+1)
+	node := t.root
+	path = append(path, node)
+	for i := len(n.labels) - 1; i >= 0; i-- {
+		node = node.children[n.labels[i]]
+		if node == nil {
+			return false
+		}
+		path = append(path, node)
+	}
+	if node.name == nil {
+		return false
+	}
+	node.name = nil
+	node.value = nil
+	t.size--
+
+	for i := len(path) - 1; i > 0; i-- {
+		node := path[i]
+		if node.name != nil || len(node.children) > 0 {
+			break
+		}
+		delete(path[i-1].children, n.labels[len(n.labels)-i])
+	}
+	return true
+}
+
+// calls f on the name and its parents that are in the tree, deepest
+// first, until f returns false
+func (t *NameTree) Ancestors(n *Name, f func(n *Name, v interface{}) bool) {
+	matches := make([]*treeNode, 0, len(n.labels)+1)
+	node := t.root
+	if node.name != nil {
+		matches = append(matches, node)
+	}
+	for i := len(n.labels) - 1; i >= 0; i-- {
+		node = node.children[n.labels[i]]
+		if node == nil {
+			break
+		}
+		if node.name != nil {
+			matches = append(matches, node)
+		}
+	}
+
+	for i := len(matches) - 1; i >= 0; i-- {
+		if !f(matches[i].name, matches[i].value) {
+			return
+		}
+	}
+}
+
+// the longest suffix of n (n included) that is in the tree
+// returns nil if there is none
+func (t *NameTree) Longest(n *Name) (*Name, interface{}) {
+	var retName *Name
+	var ret interface{}
+	t.Ancestors(n, func(n *Name, v interface{}) bool {
+		retName, ret = n, v
+		return false
+	})
+	return retName, ret
+}
+
+func (node *treeNode) walk(f func(n *Name, v interface{}) bool) bool {
+	if node.name != nil {
+		if !f(node.name, node.value) {
+			return false
+		}
+	}
+	for _, child := range node.children {
+		if !child.walk(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// calls f on n and every name under n in the tree, parents before
+// children, until f returns false
+func (t *NameTree) Walk(n *Name, f func(n *Name, v interface{}) bool) {
+	node := t.find(n, false)
+	if node == nil {
+		return
+	}
+	node.walk(f)
+}
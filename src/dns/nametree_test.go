@@ -0,0 +1,92 @@
+package dns
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNameTree(t *testing.T) {
+	tree := NewNameTree()
+	tree.Insert(Domain("com"), 1)
+	tree.Insert(Domain("example.com"), 2)
+	tree.Insert(Domain("a.b.example.com"), 3)
+	if tree.Insert(Domain("com"), 4) != true || tree.Len() != 3 {
+		t.Error("insert should replace")
+	}
+
+	if v, ok := tree.Get(Domain("b.example.com")); ok || v != nil {
+		t.Error("b.example.com should not be in the tree")
+	}
+	if v, _ := tree.Get(Domain("Example.COM")); v != 2 {
+		t.Error("wrong value for example.com")
+	}
+
+	n, v := tree.Longest(Domain("x.b.example.com"))
+	if !n.Equal(Domain("example.com")) || v != 2 {
+		t.Errorf("wrong longest match %s", n)
+	}
+	if n, _ = tree.Longest(Domain("org")); n != nil {
+		t.Error("org should have no match")
+	}
+
+	count := 0
+	tree.Walk(Domain("example.com"), func(n *Name, v interface{}) bool {
+		count++
+		return true
+	})
+	if count != 2 {
+		t.Errorf("walk got %d names", count)
+	}
+
+	if !tree.Delete(Domain("a.b.example.com")) || tree.Len() != 2 {
+		t.Error("delete failed")
+	}
+	if tree.Delete(Domain("b.example.com")) {
+		t.Error("deleted a name not in the tree")
+	}
+	if len(tree.find(Domain("example.com"), false).children) != 0 {
+		t.Error("empty nodes not pruned")
+	}
+}
+
+const _BENCH_NAMES = 1000000
+
+func benchNames() []*Name {
+	tlds := []string{"com", "net", "org", "co.uk", "com.cn"}
+	ret := make([]*Name, _BENCH_NAMES)
+	for i := range ret {
+		ret[i] = Domain(fmt.Sprintf("www.d%d.%s", i/4, tlds[i%len(tlds)]))
+	}
+	return ret
+}
+
+func BenchmarkNameTreeInsert(b *testing.B) {
+	names := benchNames()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree := NewNameTree()
+		for _, n := range names {
+			tree.Insert(n, true)
+		}
+	}
+}
+
+func BenchmarkNameTreeLongest(b *testing.B) {
+	names := benchNames()
+	tree := NewNameTree()
+	for _, n := range names {
+		tree.Insert(n.Parent(), true)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Longest(names[i%len(names)])
+	}
+}
+
+func BenchmarkRegParts(b *testing.B) {
+	names := benchNames()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		RegParts(names[i%len(names)])
+	}
+}
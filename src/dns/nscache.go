@@ -17,12 +17,16 @@ type cacheRequest struct {
 	newZone    *Zone // nul if not an add
 	queryZone  *Name // nul if is not an query
 	queryReply chan *Zone
+	newEntry   *cacheEntry        // nil if not a load
+	snapshot   chan []*cacheEntry // nil if not a snapshot
 }
 
 type NSCache struct {
-	cache     map[string]*cacheEntry
+	cache     *NameTree // zone name -> *cacheEntry
 	lastClean time.Time
 	requests  chan *cacheRequest
+	rtt       *RTTTable
+	lame      *LameTable
 }
 
 // the default nameserver cache
@@ -30,9 +34,11 @@ var TheCache *NSCache = NewNSCache()
 
 func NewNSCache() *NSCache {
 	ret := &NSCache{
-		cache:     make(map[string]*cacheEntry),
+		cache:     NewNameTree(),
 		lastClean: time.Now(),
 		requests:  make(chan *cacheRequest),
+		rtt:       NewRTTTable(),
+		lame:      NewLameTable(),
 	}
 
 	go ret.serve()
@@ -44,18 +50,50 @@ func (c *NSCache) Close() {
 	close(c.requests)
 }
 
+// server round trip times shared by all solvers using this cache
+func (c *NSCache) RTT() *RTTTable {
+	return c.rtt
+}
+
+// lame servers held down for all solvers using this cache
+func (c *NSCache) Lame() *LameTable {
+	return c.lame
+}
+
 func (c *NSCache) Query(name *Name) *Zone {
 	queryReply := make(chan *Zone)
-	req := &cacheRequest{nil, name, queryReply}
+	req := &cacheRequest{queryZone: name, queryReply: queryReply}
 	c.requests <- req
 	return <-queryReply
 }
 
 func (c *NSCache) Add(zs *Zone) {
-	req := &cacheRequest{zs, nil, nil}
+	req := &cacheRequest{newZone: zs}
 	c.requests <- req
 }
 
+// adds the zone to expire at the time, instead of after the default
+func (c *NSCache) AddUntil(zs *Zone, expire time.Time) {
+	entry := NewEntry(zs)
+	if entry == nil {
+		return
+	}
+	entry.expire = expire
+	c.requests <- &cacheRequest{newEntry: entry}
+}
+
+// returns all the entries that are not expired yet
+// entries are never modified in place, so they are safe to read
+func (c *NSCache) entries() []*cacheEntry {
+	snapshot := make(chan []*cacheEntry)
+	c.requests <- &cacheRequest{snapshot: snapshot}
+	return <-snapshot
+}
+
+func (c *NSCache) addEntry(e *cacheEntry) {
+	c.requests <- &cacheRequest{newEntry: e}
+}
+
 // cache cleanup interval
 const _CLEAN_INTERVAL = time.Hour / 4
 const _DEFAULT_EXPIRE = time.Hour
@@ -75,6 +113,14 @@ func (c *NSCache) serve() {
 			req.queryReply <- c.serveQuery(req.queryZone)
 		}
 
+		if req.newEntry != nil {
+			c.serveLoad(req.newEntry)
+		}
+
+		if req.snapshot != nil {
+			req.snapshot <- c.serveSnapshot()
+		}
+
 		if len(cleanTicker.C) > 0 {
 			<-cleanTicker.C
 			c.cleanUp()
@@ -141,48 +187,88 @@ func (e *cacheEntry) add(servers []*NameServer) (changed bool) {
 	return
 }
 
+func (c *NSCache) get(zone *Name) *cacheEntry {
+	v, has := c.cache.Get(zone)
+	if !has {
+		return nil
+	}
+	return v.(*cacheEntry)
+}
+
 func (c *NSCache) serveAdd(zone *Zone) {
-	zoneStr := zone.Name().String()
-	curEntry := c.cache[zoneStr]
+	curEntry := c.get(zone.Name())
 
 	if curEntry == nil {
 		entry := NewEntry(zone)
 		if entry == nil {
 			return
 		}
-		c.cache[zoneStr] = entry
+		c.cache.Insert(zone.Name(), entry)
 		return
 	}
 
 	newEntry := curEntry.Copy()
 	if newEntry.add(zone.List()) {
 		// entry changed, swap in the new one
-		c.cache[zoneStr] = newEntry
+		c.cache.Insert(zone.Name(), newEntry)
+	}
+}
+
+func (c *NSCache) serveLoad(entry *cacheEntry) {
+	zone := entry.zone.Name()
+	curEntry := c.get(zone)
+
+	if curEntry == nil || curEntry.expire.Before(time.Now()) {
+		c.cache.Insert(zone, entry)
+		return
+	}
+
+	newEntry := curEntry.Copy()
+	newEntry.add(entry.zone.List())
+	if entry.expire.After(newEntry.expire) {
+		newEntry.expire = entry.expire
 	}
+	c.cache.Insert(zone, newEntry)
+}
+
+func (c *NSCache) serveSnapshot() []*cacheEntry {
+	ret := make([]*cacheEntry, 0, c.cache.Len())
+	now := time.Now()
+	c.cache.Walk(rootName, func(n *Name, v interface{}) bool {
+		entry := v.(*cacheEntry)
+		if entry.expire.After(now) {
+			ret = append(ret, entry)
+		}
+		return true
+	})
+	return ret
 }
 
 func (c *NSCache) serveQuery(name *Name) *Zone {
-	for name != nil {
-		entry := c.cache[name.String()]
-		now := time.Now()
-		if entry != nil && entry.expire.After(now) {
-			return entry.zone
+	var ret *Zone
+	now := time.Now()
+	c.cache.Ancestors(name, func(n *Name, v interface{}) bool {
+		entry := v.(*cacheEntry)
+		if entry.expire.After(now) {
+			ret = entry.zone
+			return false
 		}
-		name = name.Parent()
-	}
-	return nil
+		return true
+	})
+	return ret
 }
 
 func (c *NSCache) cleanUp() {
-	toDelete := make([]string, 0, len(c.cache))
+	toDelete := make([]*Name, 0, c.cache.Len())
 	now := time.Now()
-	for nameStr, entry := range c.cache {
-		if entry.expire.Before(now) {
-			toDelete = append(toDelete, nameStr)
+	c.cache.Walk(rootName, func(n *Name, v interface{}) bool {
+		if v.(*cacheEntry).expire.Before(now) {
+			toDelete = append(toDelete, n)
 		}
-	}
+		return true
+	})
 
-	for _, nameStr := range toDelete {
-		delete(c.cache, nameStr)
+	for _, name := range toDelete {
+		c.cache.Delete(name)
 	}
 }
@@ -0,0 +1,144 @@
+package dns
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// a cache snapshot is a text file, one line for each server ip:
+//     <zone> <expire unix time> <server name> <ip>
+// empty lines and lines start with "//" are ignored
+
+type snapshotError struct {
+	line int
+	s    string
+}
+
+func (e *snapshotError) Error() string {
+	return fmt.Sprintf("cache snapshot line %d: %s", e.line, e.s)
+}
+
+// writes all unexpired entries of the cache to w
+func (c *NSCache) Save(w io.Writer) error {
+	entries := c.entries()
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].zone.Name().String() <
+			entries[j].zone.Name().String()
+	})
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "// nscache snapshot, %d zones\n", len(entries))
+	for _, entry := range entries {
+		zone := entry.zone.Name().String()
+		expire := entry.expire.Unix()
+		for _, server := range entry.zone.sortedList() {
+			for _, ip := range server.IPs {
+				fmt.Fprintf(out, "%s %d %s %s\n",
+					zone, expire, server.Name, ip)
+			}
+		}
+	}
+	return out.Flush()
+}
+
+// reads entries from a snapshot and adds them into the cache
+// entries that are already expired are discarded
+func (c *NSCache) Load(r io.Reader) error {
+	zones := make(map[string]*Zone)
+	expires := make(map[string]time.Time)
+	order := make([]string, 0, 100)
+
+	scanner := bufio.NewScanner(r)
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return &snapshotError{lineno, "expect 4 fields"}
+		}
+
+		zone, err := NewName(fields[0])
+		if err != nil {
+			return &snapshotError{lineno, err.Error()}
+		}
+		sec, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return &snapshotError{lineno, "bad expire time"}
+		}
+		server, err := NewName(fields[2])
+		if err != nil {
+			return &snapshotError{lineno, err.Error()}
+		}
+		ip := ParseIP(fields[3])
+		if ip == nil {
+			return &snapshotError{lineno, "bad ip"}
+		}
+
+		zoneStr := zone.String()
+		z := zones[zoneStr]
+		if z == nil {
+			z = NewZone(zone)
+			zones[zoneStr] = z
+			order = append(order, zoneStr)
+		}
+		z.Add(server, ip)
+
+		expire := time.Unix(sec, 0)
+		if expire.After(expires[zoneStr]) {
+			expires[zoneStr] = expire
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, zoneStr := range order {
+		expire := expires[zoneStr]
+		if !expire.After(now) {
+			continue // stale
+		}
+		entry := NewEntry(zones[zoneStr])
+		if entry == nil {
+			continue
+		}
+		entry.expire = expire
+		c.addEntry(entry)
+	}
+	return nil
+}
+
+func (c *NSCache) SaveFile(path string) error {
+	fout, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = c.Save(fout)
+	if err != nil {
+		fout.Close()
+		return err
+	}
+	return fout.Close()
+}
+
+func (c *NSCache) LoadFile(path string) error {
+	fin, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer fin.Close()
+
+	return c.Load(fin)
+}
@@ -0,0 +1,57 @@
+package dns
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCacheSaveLoad(t *testing.T) {
+	c := NewNSCache()
+	defer c.Close()
+
+	z := NewZone(Domain("com"))
+	z.Add(Domain("a.gtld-servers.net"), ParseIP("192.5.6.30"))
+	z.Add(Domain("b.gtld-servers.net"), ParseIP("192.33.14.30"))
+	c.Add(z)
+
+	buf := new(bytes.Buffer)
+	if e := c.Save(buf); e != nil {
+		t.Fatal(e)
+	}
+
+	c2 := NewNSCache()
+	defer c2.Close()
+	if e := c2.Load(bytes.NewReader(buf.Bytes())); e != nil {
+		t.Fatal(e)
+	}
+
+	got := c2.Query(Domain("www.google.com"))
+	if got == nil || !got.Name().Equal(Domain("com")) {
+		t.Fatal("loaded cache does not serve com")
+	}
+	if len(got.List()) != 2 {
+		t.Errorf("expect 2 servers, got %d", len(got.List()))
+	}
+}
+
+func TestCacheLoadExpired(t *testing.T) {
+	c := NewNSCache()
+	defer c.Close()
+
+	past := time.Now().Add(-time.Hour).Unix()
+	snapshot := "// old\n" +
+		"net " + strconv.FormatInt(past, 10) + " a.gtld-servers.net 192.5.6.30\n"
+	if e := c.Load(strings.NewReader(snapshot)); e != nil {
+		t.Fatal(e)
+	}
+	if c.Query(Domain("net")) != nil {
+		t.Error("expired entry should be discarded")
+	}
+
+	if e := c.Load(strings.NewReader("net x y")); e == nil {
+		t.Error("should fail on bad line")
+	}
+}
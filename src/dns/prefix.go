@@ -0,0 +1,82 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net"
+)
+
+// an ipv4 cidr prefix, like 192.0.2.0/24
+// IMPORTANT: should be treated as immutable
+type Prefix struct {
+	ip   *IPv4 // masked
+	bits int
+}
+
+var errNotIPv4Prefix = errors.New("not an ipv4 prefix")
+
+func mask(bits int) uint32 {
+	if bits == 0 {
+		return 0
+	}
+	return ^uint32(0) << uint(32-bits)
+}
+
+func ipFromUint(i uint32) *IPv4 {
+	ret := new(IPv4)
+	enc.PutUint32(ret.ip[:], i)
+	return ret
+}
+
+// makes a prefix, the host bits of ip are cleared
+// returns nil if bits is out of range
+func NewPrefix(ip *IPv4, bits int) *Prefix {
+	if bits < 0 || bits > 32 {
+		return nil
+	}
+	return &Prefix{ipFromUint(ip.Uint() & mask(bits)), bits}
+}
+
+func ParsePrefix(s string) (*Prefix, error) {
+	_, ipnet, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, err
+	}
+	ip := IPFromIP(ipnet.IP)
+	ones, size := ipnet.Mask.Size()
+	if ip == nil || size != 32 {
+		return nil, errNotIPv4Prefix
+	}
+	return NewPrefix(ip, ones), nil
+}
+
+func (p *Prefix) IP() *IPv4 {
+	return p.ip
+}
+
+func (p *Prefix) Bits() int {
+	return p.bits
+}
+
+// number of addresses in the prefix
+func (p *Prefix) Size() uint64 {
+	return uint64(1) << uint(32-p.bits)
+}
+
+func (p *Prefix) Contains(ip *IPv4) bool {
+	return ip.Uint()&mask(p.bits) == p.ip.Uint()
+}
+
+// calls f on every address in order, stops when f returns false
+func (p *Prefix) Each(f func(ip *IPv4) bool) {
+	first := uint64(p.ip.Uint())
+	for i := uint64(0); i < p.Size(); i++ {
+		if !f(ipFromUint(uint32(first + i))) {
+			return
+		}
+	}
+}
+
+func (p *Prefix) String() string {
+	return fmt.Sprintf("%s/%d", p.ip, p.bits)
+}
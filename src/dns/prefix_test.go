@@ -0,0 +1,32 @@
+package dns
+
+import "testing"
+
+func TestPrefix(t *testing.T) {
+	p, e := ParsePrefix("192.0.2.77/30")
+	if e != nil {
+		t.Fatal(e)
+	}
+	if p.String() != "192.0.2.76/30" || p.Size() != 4 {
+		t.Errorf("wrong prefix %s, size %d", p, p.Size())
+	}
+	if !p.Contains(ParseIP("192.0.2.79")) || p.Contains(ParseIP("192.0.2.80")) {
+		t.Error("wrong Contains")
+	}
+
+	var ips []string
+	p.Each(func(ip *IPv4) bool {
+		ips = append(ips, ip.String())
+		return true
+	})
+	if len(ips) != 4 || ips[0] != "192.0.2.76" || ips[3] != "192.0.2.79" {
+		t.Errorf("wrong iteration: %v", ips)
+	}
+
+	if _, e = ParsePrefix("2001:db8::/32"); e == nil {
+		t.Error("ipv6 prefix should fail")
+	}
+	if NewPrefix(ParseIP("10.1.2.3"), 0).Size() != 1<<32 {
+		t.Error("wrong size of /0")
+	}
+}
@@ -5,7 +5,7 @@ import (
 )
 
 func TestPrinter(t *testing.T) {
-	p := NewPrinter()
+	p := newPrinter()
 	p.Print("a")
 	p.Print("b", "hi", "yes")
 	p.Indent()
@@ -1,12 +1,13 @@
 package dns
 
 type ProbAddr struct {
-	name *Name
-	IPs  []*IPv4
+	name  *Name
+	IPs   []*IPv4
+	Chain []*RR // cname records followed
 }
 
 func NewProbAddr(name *Name) *ProbAddr {
-	return &ProbAddr{name, nil}
+	return &ProbAddr{name: name}
 }
 
 func (p *ProbAddr) Title() (title []string) {
@@ -23,41 +24,12 @@ func (p *ProbAddr) ExpandVia(a Solver) {
 	if ans == nil {
 		return
 	}
+	p.Chain = recur.Chain
 
-	/* first find A records */
+	// the recursion follows cnames, so look for the end of the chain
+	target := recur.Target()
 	rrs := ans.FilterIN(func(rr *RR, seg int) bool {
-		return rr.Name.Equal(p.name) && rr.Type == A
-	})
-
-	if len(rrs) > 0 {
-		p.IPs = toIPs(rrs)
-		return
-	}
-
-	// not found, then look for cnames
-	rrs = ans.FilterIN(func(rr *RR, seg int) bool {
-		return rr.Name.Equal(p.name) && rr.Type == CNAME
-	})
-	if len(rrs) == 0 {
-		return
-	}
-
-	cnames := make([]*Name, len(rrs))
-	for i, rr := range rrs {
-		cnames[i] = rr.Rdata.(*RdName).Name
-	}
-
-	// look for glued ips
-	rrs = ans.FilterIN(func(rr *RR, seg int) bool {
-		if rr.Type != A {
-			return false
-		}
-		name := rr.Name
-		for _, n := range cnames {
-			n.Equal(name)
-			return true
-		}
-		return false
+		return rr.Name.Equal(target) && rr.Type == A
 	})
 
 	if len(rrs) == 0 {
@@ -0,0 +1,246 @@
+package dns
+
+import (
+	"fmt"
+	"sort"
+)
+
+// checks if the delegation of a zone in its parent is consistent with
+// what the authoritative servers of the zone say
+type ProbDeleg struct {
+	zone *Name
+
+	Parent   *Name              // the zone that gives the referral
+	ParentNS []*Name            // ns set in the referral
+	Glue     map[string][]*IPv4 // glue ips by server name
+	Servers  []*DelegServer     // one for each server ip
+	Glues    []*GlueMismatch
+	Problems []string // a summary of all problems found
+}
+
+// the view of one authoritative server ip
+type DelegServer struct {
+	Name      *Name
+	IP        *IPv4
+	Reachable bool
+	Auth      bool    // answered with F_AA
+	NS        []*Name // ns set served by the server
+	Missing   []*Name // in the parent, but not served by the server
+	Extra     []*Name // served by the server, but not in the parent
+}
+
+// glue ips in the parent that differ from what the zone serves
+type GlueMismatch struct {
+	Server *Name
+	IP     *IPv4 // the child server asked
+	Glue   []*IPv4
+	Child  []*IPv4
+}
+
+func NewProbDeleg(zone *Name) *ProbDeleg {
+	return &ProbDeleg{zone: zone}
+}
+
+func (p *ProbDeleg) Title() (title []string) {
+	return []string{"deleg", p.zone.String()}
+}
+
+func (p *ProbDeleg) problem(a Solver, format string, args ...interface{}) {
+	s := fmt.Sprintf(format, args...)
+	a.Log("// problem:", s)
+	p.Problems = append(p.Problems, s)
+}
+
+// true if no problem found
+func (p *ProbDeleg) OK() bool {
+	return len(p.Problems) == 0
+}
+
+// looks for the referral of the zone from its parent
+func (p *ProbDeleg) findReferral(recur *ProbRecur) *Msg {
+	for _, r := range recur.History {
+		if r.Resp == nil {
+			continue
+		}
+		msg := r.Resp.Msg
+		if (msg.Flags & F_AA) == F_AA {
+			continue
+		}
+		for _, rr := range msg.Auth {
+			if rr.Type == NS && rr.Name.Equal(p.zone) {
+				p.Parent = r.Zone
+				return msg
+			}
+		}
+	}
+	return nil
+}
+
+func nameSet(names []*Name) map[string]*Name {
+	ret := make(map[string]*Name)
+	for _, n := range names {
+		ret[n.String()] = n
+	}
+	return ret
+}
+
+func sortedNames(set map[string]*Name) []*Name {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	ret := make([]*Name, len(keys))
+	for i, k := range keys {
+		ret[i] = set[k]
+	}
+	return ret
+}
+
+// names in a but not in b
+func nameDiff(a, b map[string]*Name) []*Name {
+	ret := make(map[string]*Name)
+	for k, n := range a {
+		if b[k] == nil {
+			ret[k] = n
+		}
+	}
+	return sortedNames(ret)
+}
+
+func sameIPs(a, b []*IPv4) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, ip := range a {
+		if !haveIP(b, ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *ProbDeleg) ExpandVia(a Solver) {
+	// start from the parent, so that the referral is seen even if the
+	// zone itself is cached
+	recur := NewProbRecur(p.zone, NS)
+	if !p.zone.IsRoot() {
+		parent := a.QueryCache(p.zone.Parent())
+		if parent == nil {
+			// not from the cache, which can have the zone itself
+			parent = a.Roots()
+		}
+		recur.StartsWith(parent)
+	}
+	if !a.SolveSub(recur) {
+		return
+	}
+
+	referral := p.findReferral(recur)
+	if referral == nil {
+		p.problem(a, "no referral from the parent")
+		return
+	}
+
+	// the parent's view
+	servers := make(map[string]*Name)
+	for _, rr := range referral.Auth {
+		if rr.Class != IN || rr.Type != NS || !rr.Name.Equal(p.zone) {
+			continue
+		}
+		n := rr.Rdata.(*RdName).Name
+		servers[n.String()] = n
+	}
+	p.ParentNS = sortedNames(servers)
+
+	p.Glue = make(map[string][]*IPv4)
+	referral.ForEachIN(func(rr *RR, seg int) {
+		if seg != ADDI || rr.Type != A || servers[rr.Name.String()] == nil {
+			return
+		}
+		key := rr.Name.String()
+		p.Glue[key] = append(p.Glue[key], rr.Rdata.(*RdIP).IP)
+	})
+
+	// the child's view
+	for _, server := range p.ParentNS {
+		ips := p.Glue[server.String()]
+		if len(ips) == 0 {
+			addr := NewProbAddr(server)
+			if !a.SolveSub(addr) {
+				return
+			}
+			ips = addr.IPs
+		}
+		if len(ips) == 0 {
+			p.problem(a, "no address for %s", server)
+			continue
+		}
+
+		for _, ip := range ips {
+			p.checkServer(a, server, ip, servers)
+		}
+	}
+}
+
+func (p *ProbDeleg) checkServer(a Solver, server *Name, ip *IPv4,
+	parentNS map[string]*Name) {
+	s := &DelegServer{Name: server, IP: ip}
+	p.Servers = append(p.Servers, s)
+
+	resp := a.Query(ip, p.zone, NS)
+	if resp == nil {
+		p.problem(a, "%s(%s) unreachable", server, ip)
+		return
+	}
+	s.Reachable = true
+
+	msg := resp.Msg
+	s.Auth = (msg.Flags & F_AA) == F_AA
+	if !s.Auth {
+		p.problem(a, "%s(%s) not authoritative", server, ip)
+	}
+
+	rrs := msg.FilterIN(func(rr *RR, seg int) bool {
+		return seg == ANSW && rr.Type == NS && rr.Name.Equal(p.zone)
+	})
+	names := make([]*Name, len(rrs))
+	for i, rr := range rrs {
+		names[i] = rr.Rdata.(*RdName).Name
+	}
+	childNS := nameSet(names)
+	s.NS = sortedNames(childNS)
+	s.Missing = nameDiff(parentNS, childNS)
+	s.Extra = nameDiff(childNS, parentNS)
+	for _, n := range s.Missing {
+		p.problem(a, "%s(%s) missing ns %s", server, ip, n)
+	}
+	for _, n := range s.Extra {
+		p.problem(a, "%s(%s) extra ns %s", server, ip, n)
+	}
+
+	if !s.Auth {
+		return
+	}
+
+	// check the glue of in-zone servers
+	for _, name := range p.ParentNS {
+		glue := p.Glue[name.String()]
+		if len(glue) == 0 || !name.SubOf(p.zone) {
+			continue
+		}
+		resp := a.Query(ip, name, A)
+		if resp == nil {
+			continue
+		}
+		rrs := resp.Msg.FilterIN(func(rr *RR, seg int) bool {
+			return seg == ANSW && rr.Type == A && rr.Name.Equal(name)
+		})
+		child := toIPs(rrs)
+		if !sameIPs(glue, child) {
+			p.Glues = append(p.Glues, &GlueMismatch{name, ip, glue, child})
+			p.problem(a, "%s(%s) glue mismatch for %s",
+				server, ip, name)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package dns
+
+import "testing"
+
+func nsRR(zone, server string) RR {
+	return RR{Domain(zone), NS, IN, 3600, &RdName{Domain(server)}}
+}
+
+func TestProbDeleg(t *testing.T) {
+	s := newFakeSolver()
+	s.zones = []*Zone{fakeZone("test", "ns.test", "192.0.2.1")}
+
+	s.answer("192.0.2.1", "ex.test", NS, &Msg{
+		Auth: []RR{
+			nsRR("ex.test", "ns1.ex.test"),
+			nsRR("ex.test", "ns2.ex.test"),
+		},
+		Addi: []RR{
+			aRR("ns1.ex.test", "192.0.2.10"),
+			aRR("ns2.ex.test", "192.0.2.11"),
+		},
+	})
+	s.answer("192.0.2.10", "ex.test", NS, &Msg{Flags: F_AA,
+		Answ: []RR{
+			nsRR("ex.test", "ns1.ex.test"),
+			nsRR("ex.test", "ns3.ex.test"),
+		},
+	})
+	s.answer("192.0.2.10", "ns1.ex.test", A, &Msg{Flags: F_AA,
+		Answ: []RR{aRR("ns1.ex.test", "192.0.2.12")},
+	})
+
+	p := NewProbDeleg(Domain("ex.test"))
+	s.SolveSub(p)
+
+	if !p.Parent.Equal(Domain("test")) || len(p.ParentNS) != 2 {
+		t.Fatalf("wrong parent view: %s %v", p.Parent, p.ParentNS)
+	}
+	if len(p.Servers) != 2 {
+		t.Fatalf("expect 2 servers, got %d", len(p.Servers))
+	}
+
+	s1 := p.Servers[0]
+	if !s1.Reachable || !s1.Auth || len(s1.Missing) != 1 ||
+		len(s1.Extra) != 1 || !s1.Extra[0].Equal(Domain("ns3.ex.test")) {
+		t.Errorf("wrong server view: %+v", s1)
+	}
+	if p.Servers[1].Reachable {
+		t.Error("ns2 should be unreachable")
+	}
+	if len(p.Glues) != 1 {
+		t.Errorf("expect 1 glue mismatch, got %d", len(p.Glues))
+	}
+	if p.OK() {
+		t.Error("problems not reported")
+	}
+}
+
+// the zone is cached but its parent is not, so the referral must come
+// from the roots
+func TestProbDelegUncachedParent(t *testing.T) {
+	s := newFakeSolver()
+	s.zones = []*Zone{fakeZone("test", "ns.test", "192.0.2.1")}
+	s.answerRoot("test", NS, &Msg{
+		Auth: []RR{nsRR("test", "ns.test")},
+		Addi: []RR{aRR("ns.test", "192.0.2.1")},
+	})
+	s.answer("192.0.2.1", "test", NS, &Msg{Flags: F_AA,
+		Answ: []RR{nsRR("test", "ns.test")},
+	})
+
+	p := NewProbDeleg(Domain("test"))
+	s.SolveSub(p)
+	if p.Parent == nil || !p.Parent.IsRoot() || len(p.ParentNS) != 1 {
+		t.Fatalf("wrong parent view: %s %v", p.Parent, p.ParentNS)
+	}
+	if !p.OK() {
+		t.Errorf("problems: %v", p.Problems)
+	}
+}
@@ -0,0 +1,54 @@
+package dns
+
+import (
+	"fmt"
+)
+
+// asks the root hints for the current root servers, see rfc8109
+type ProbPrime struct {
+	Roots    *Zone // the root servers in the answer, nil if failed
+	Problems []string
+}
+
+func NewProbPrime() *ProbPrime {
+	return &ProbPrime{}
+}
+
+func (p *ProbPrime) Title() (title []string) {
+	return []string{"prime"}
+}
+
+func (p *ProbPrime) problem(a Solver, format string, args ...interface{}) {
+	s := fmt.Sprintf(format, args...)
+	a.Log("// problem:", s)
+	p.Problems = append(p.Problems, s)
+}
+
+func (p *ProbPrime) ExpandVia(a Solver) {
+	p.Roots = nil
+	for _, server := range a.Prepare(a.Roots()) {
+		for _, ip := range server.IPs {
+			resp := a.Query(ip, rootName, NS)
+			if resp == nil {
+				continue
+			}
+			msg := resp.Msg
+			if (msg.Flags&F_AA) == 0 ||
+				(msg.Flags&F_RCODEMASK) != RCODE_OKAY {
+				p.problem(a, "bad priming response from %s", ip)
+				continue
+			}
+			rrs := make([]RR, 0, len(msg.Answ)+len(msg.Addi))
+			rrs = append(rrs, msg.Answ...)
+			rrs = append(rrs, msg.Addi...)
+			roots, err := rootZone(rrs)
+			if err != nil {
+				p.problem(a, "%s from %s", err, ip)
+				continue
+			}
+			p.Roots = roots
+			return
+		}
+	}
+	p.problem(a, "no root server answered")
+}
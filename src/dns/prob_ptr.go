@@ -0,0 +1,55 @@
+package dns
+
+import (
+	"net"
+)
+
+// looks up the names of an ip address
+type ProbPTR struct {
+	ip   net.IP
+	name *Name
+
+	Names   []*Name // the ptr names found
+	AnsCode int     // the answer code of the recursion
+	Rcode   int     // of the last response, -1 if none
+}
+
+func NewProbPTR(ip net.IP) *ProbPTR {
+	return &ProbPTR{ip: ip, name: ReverseIP(ip), Rcode: -1}
+}
+
+func (p *ProbPTR) Title() (title []string) {
+	return []string{"ptr", p.ip.String()}
+}
+
+func (p *ProbPTR) ExpandVia(a Solver) {
+	if p.name == nil {
+		a.Log("// invalid ip")
+		return
+	}
+
+	recur := NewProbRecur(p.name, PTR)
+	if !a.SolveSub(recur) {
+		return
+	}
+	p.AnsCode = recur.AnsCode
+	for _, r := range recur.History {
+		if r.Resp != nil {
+			p.Rcode = int(r.Resp.Msg.Flags & F_RCODEMASK)
+		}
+	}
+
+	ans := recur.Answer
+	if ans == nil {
+		return
+	}
+
+	// classless delegations use cnames, so look at the chain target
+	target := recur.Target()
+	rrs := ans.FilterIN(func(rr *RR, seg int) bool {
+		return seg == ANSW && rr.Name.Equal(target) && rr.Type == PTR
+	})
+	for _, rr := range rrs {
+		p.Names = append(p.Names, rr.Rdata.(*RdName).Name)
+	}
+}
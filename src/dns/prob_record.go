@@ -53,15 +53,20 @@ func (p *ProbRecord) ExpandVia(a Solver) {
 		return // error on finding the domain server
 	}
 
-	// restart from here
+	// restart from here, unless the answer zone is of a cname target
 	authZone := recur.AnsZone
+	if len(recur.Chain) > 0 {
+		authZone = nil
+	}
 	for _, t := range p.types[1:] {
 		if t == A {
 			continue // already probed
 		}
 
 		recur = NewProbRecur(p.name, t)
-		recur.StartsWith(authZone)
+		if authZone != nil {
+			recur.StartsWith(authZone)
+		}
 		if !a.SolveSub(recur) {
 			return // max depth reached
 		}
@@ -9,12 +9,20 @@ import (
 type ProbRecur struct {
 	n       *Name
 	t       uint16
+	target  *Name // the name being resolved, the end of Chain
+	visited map[string]bool
 	start   *Zone
 	current *Zone
 	Answer  *Msg
 	AnsZone *Zone
 	AnsCode int
 	History []*QueryRecord
+	Lame    []*LameRecord // lame or broken servers met on the way
+	Chain   []*RR         // cname records followed, in order
+
+	sec       *validator // nil if not validating
+	Security  int        // SEC_UNKNOWN if not validating
+	SecReason string     // why it is not secure
 }
 
 // to record the query history for recursive query problems
@@ -33,8 +41,12 @@ const (
 	OKAY = iota
 	NONEXIST
 	NORESP
+	BADCHAIN // cname loop or chain too long
 )
 
+// max number of cnames to follow
+const _MAX_CHAIN = 8
+
 func NewProbRecur(name *Name, t uint16) *ProbRecur {
 	return &ProbRecur{
 		n: name,
@@ -46,6 +58,14 @@ func (p *ProbRecur) StartsWith(zone *Zone) {
 	p.start = zone
 }
 
+// the end of the cname chain, which is the owner name of the answer
+func (p *ProbRecur) Target() *Name {
+	if p.target == nil {
+		return p.n
+	}
+	return p.target
+}
+
 func (p *ProbRecur) Title() (title []string) {
 	return []string{"recur", p.n.String(), TypeStr(p.t)}
 }
@@ -59,12 +79,19 @@ func haveIP(ipList []*IPv4, ip *IPv4) bool {
 	return false
 }
 
+// a server ip that is held down and skipped in the first round
+type heldServer struct {
+	name *Name
+	ip   *IPv4
+}
+
 func (p *ProbRecur) queryZone(a Solver) *Msg {
 	zone := p.current
 
 	// prepare the servers
-	servers := zone.Prepare()
+	servers := a.Prepare(zone)
 	tried := make(map[uint32]bool)
+	held := make([]*heldServer, 0, len(servers))
 
 	for _, server := range servers {
 		ips := server.IPs
@@ -92,50 +119,25 @@ func (p *ProbRecur) queryZone(a Solver) *Msg {
 			}
 			tried[i] = true
 
-			a.Log(fmt.Sprintf("// %s : %s(%s)",
-				zone.Name().String(),
-				server.Name.String(),
-				ip.String(),
-			))
-
-			hisRecord := &QueryRecord{
-				Host:   ip,
-				Name:   p.n,
-				Type:   p.t,
-				Zone:   zone.Name(),
-				Issued: time.Now(),
-			}
-			resp := a.Query(ip, p.n, p.t)
-			hisRecord.Resp = resp
-			p.History = append(p.History, hisRecord)
-
-			if resp == nil {
-				a.Log("// unreachable", server.Name.String())
+			lame := a.CheckLame(zone.Name(), ip)
+			if lame != nil {
+				a.Log("// skip", server.Name.String(),
+					fmt.Sprintf("(%s)", ip), LameStr(lame.Reason))
+				p.addLame(lame)
+				held = append(held, &heldServer{server.Name, ip})
 				continue
 			}
 
-			msg := resp.Msg
-			rcode := msg.Flags & F_RCODEMASK
-			if !(rcode == RCODE_OKAY || rcode == RCODE_NAMEERROR) {
-				a.Log("// server error", server.Name.String())
-				continue
+			if done, msg := p.queryServer(a, server.Name, ip); done {
+				return msg
 			}
+		}
+	}
 
-			found, redirect := p.findAns(msg, a)
-			if found {
-				p.AnsCode = OKAY
-				a.Log("// answer found")
-				p.AnsZone = zone
-				p.current = nil
-				return msg // found
-			} else {
-				if redirect == nil {
-					p.AnsCode = NONEXIST
-					a.Log("// domain does not exist")
-				}
-				p.current = redirect
-				return nil // found, but not exist
-			}
+	// all other servers failed, give the held down ones a last chance
+	for _, h := range held {
+		if done, msg := p.queryServer(a, h.name, h.ip); done {
+			return msg
 		}
 	}
 
@@ -145,23 +147,191 @@ func (p *ProbRecur) queryZone(a Solver) *Msg {
 	return nil
 }
 
-func (p *ProbRecur) findAns(msg *Msg, a Solver) (bool, *Zone) {
-	// look for answer
-	rrs := msg.FilterIN(func(rr *RR, seg int) bool {
-		if !rr.Name.Equal(p.n) {
-			return false
+// records a lame server, replacing the earlier record of the same server
+// so that a held down server that fails again is listed only once
+func (p *ProbRecur) addLame(r *LameRecord) {
+	for i, l := range p.Lame {
+		if l.Host.Equal(r.Host) && l.Zone.Equal(r.Zone) {
+			p.Lame[i] = r
+			return
+		}
+	}
+	p.Lame = append(p.Lame, r)
+}
+
+// queries one server of the current zone
+// returns true if the zone is resolved, which is either an answer,
+// a redirect or a name error
+func (p *ProbRecur) queryServer(a Solver, server *Name, ip *IPv4) (
+	bool, *Msg) {
+	zone := p.current
+
+	a.Log(fmt.Sprintf("// %s : %s(%s)",
+		zone.Name().String(),
+		server.String(),
+		ip.String(),
+	))
+
+	hisRecord := &QueryRecord{
+		Host:   ip,
+		Name:   p.target,
+		Type:   p.t,
+		Zone:   zone.Name(),
+		Issued: time.Now(),
+	}
+	resp, err := a.QueryErr(ip, p.target, p.t)
+	hisRecord.Resp = resp
+	p.History = append(p.History, hisRecord)
+
+	if resp == nil {
+		a.Log("// unreachable", server.String())
+		// running out of queries says nothing about the server
+		if err == ErrTimeout {
+			p.addLame(a.MarkLame(zone.Name(), ip, LAME_TIMEOUT))
 		}
-		return p.t == rr.Type || (p.t == A && rr.Type == CNAME)
+		return false, nil
+	}
+
+	msg := resp.Msg
+	reason := lameReason(zone.Name(), msg)
+	if reason != 0 {
+		a.Log("// lame server", server.String(), LameStr(reason))
+		p.addLame(a.MarkLame(zone.Name(), ip, reason))
+		return false, nil
+	}
+
+	found, redirect := p.findAns(msg, a)
+	if p.sec != nil {
+		p.sec.check(a, p, msg, found, redirect)
+	}
+	if found {
+		p.AnsCode = OKAY
+		a.Log("// answer found")
+		p.AnsZone = zone
+		p.current = nil
+		return true, msg // found
+	}
+
+	if redirect == nil && p.AnsCode != BADCHAIN {
+		p.AnsCode = NONEXIST
+		a.Log("// domain does not exist")
+	}
+	p.current = redirect
+	return true, nil // found, but not exist
+}
+
+// follows a cname record, returns false on a loop or a long chain
+func (p *ProbRecur) follow(rr *RR, a Solver) bool {
+	next := rr.Rdata.(*RdName).Name
+	a.Log("// cname", p.target.String(), "->", next.String())
+	p.Chain = append(p.Chain, rr)
+	p.target = next
+
+	if len(p.Chain) > _MAX_CHAIN {
+		a.Log("// cname chain too long")
+		return false
+	}
+	nextStr := next.String()
+	if p.visited[nextStr] {
+		a.Log("// cname loop")
+		return false
+	}
+	p.visited[nextStr] = true
+	return true
+}
+
+// looks for a dname record that redirects the target, and synthesizes
+// the cname record it implies
+func (p *ProbRecur) synthesize(msg *Msg, a Solver) []*RR {
+	rrs := msg.FilterIN(func(rr *RR, seg int) bool {
+		return rr.Type == DNAME && p.target.SubOf(rr.Name)
 	})
-	if len(rrs) > 0 {
-		return true, nil
+	if len(rrs) == 0 {
+		return nil
+	}
+
+	dname := rrs[0]
+	next, err := p.target.replaceSuffix(dname.Name,
+		dname.Rdata.(*RdName).Name)
+	if err != nil {
+		a.Log("// dname:", err.Error())
+		return nil
+	}
+
+	a.Log("// dname", dname.Name.String(), "->",
+		dname.Rdata.(*RdName).Name.String())
+	return []*RR{&RR{
+		Name:  p.target,
+		Type:  CNAME,
+		Class: IN,
+		TTL:   dname.TTL,
+		Rdata: &RdName{next},
+	}}
+}
+
+// the closest known zone to start resolving the target
+func (p *ProbRecur) restartZone(a Solver) *Zone {
+	if p.sec != nil {
+		return a.Roots() // cached zones have no validated keys
+	}
+	ret := a.QueryCache(p.target)
+	if ret == nil {
+		ret = a.Roots()
+	}
+	return ret
+}
+
+func (p *ProbRecur) findAns(msg *Msg, a Solver) (bool, *Zone) {
+	// look for answer, following the cnames in the message
+	followed := false
+	for {
+		rrs := msg.FilterIN(func(rr *RR, seg int) bool {
+			return rr.Name.Equal(p.target) && p.t == rr.Type
+		})
+		if len(rrs) > 0 {
+			return true, nil
+		}
+		if p.t == CNAME {
+			break
+		}
+
+		rrs = msg.FilterIN(func(rr *RR, seg int) bool {
+			return rr.Name.Equal(p.target) && rr.Type == CNAME
+		})
+		if len(rrs) == 0 {
+			rrs = p.synthesize(msg, a)
+		}
+		if len(rrs) == 0 {
+			break
+		}
+		if !p.follow(rrs[0], a) {
+			p.AnsCode = BADCHAIN
+			return false, nil
+		}
+		followed = true
+
+		if p.sec != nil && !inZone(p.target, p.current.Name()) {
+			// resolve the target again from the root, so that it is
+			// validated with the keys of its own zone
+			break
+		}
+	}
+
+	if followed {
+		if (msg.Flags & F_RCODEMASK) == RCODE_NAMEERROR {
+			return false, nil // the target does not exist
+		}
+		return false, p.restartZone(a)
 	}
 
 	// look for redirect name servers
-	rrs = msg.FilterIN(func(rr *RR, seg int) bool {
+	rrs := msg.FilterIN(func(rr *RR, seg int) bool {
 		if rr.Type != NS {
 			return false
 		}
+		if !(p.target.Equal(rr.Name) || p.target.SubOf(rr.Name)) {
+			return false
+		}
 		return rr.Name.SubOf(p.current.Name())
 	})
 	if len(rrs) == 0 {
@@ -221,60 +391,46 @@ func (p *ProbRecur) findAns(msg *Msg, a Solver) (bool, *Zone) {
 
 	}
 
-	if IsRegistrar(subzone) {
-		a.Log("// caching for zone:", subzone.String())
-		a.Cache(redirect)
-	}
+	a.Cache(redirect)
 
 	return false, redirect
 }
 
-var rootServers = makeRootServers()
-
-func makeRootServers() *Zone {
-	ret := NewZone(Domain("."))
-
-	// helper function for adding servers
-	ns := func(n string, ip string) {
-		ret.Add(
-			Domain(fmt.Sprintf("%s.root-servers.net", n)),
-			ParseIP(ip),
-		)
+func (p *ProbRecur) ExpandVia(a Solver) {
+	p.target = p.n
+	p.visited = map[string]bool{p.n.String(): true}
+	p.Chain = nil
+	p.sec = nil
+	p.Security = SEC_UNKNOWN
+	p.SecReason = ""
+
+	if anchor := a.TrustAnchor(); anchor != nil {
+		p.sec = newValidator(anchor)
+		p.sec.start(a)
 	}
 
-	// see en.wikipedia.org/wiki/Root_name_server for reference
-	// (since year 2012)
-	// ns("a", "192.41.0.4") // Verisign
-	ns("b", "192.228.79.201") // USC-ISI
-	ns("c", "192.33.4.12")    // Cogent
-	ns("d", "128.8.10.90")    // U Maryland
-	ns("e", "192.203.230.10") // NASA
-	ns("f", "192.5.5.241")    // Internet Systems Consortium
-	ns("g", "192.112.36.4")   // DISA
-	ns("h", "128.63.2.53")    // U.S. Army Research Lab
-	ns("i", "192.36.148.17")  // Netnod
-	ns("j", "198.41.0.10")    // Verisign
-	ns("k", "193.0.14.129")   // RIPE NCC
-	ns("l", "199.7.83.42")    // ICANN
-	ns("m", "202.12.27.33")   // WIDE Project
-
-	return ret
-}
-
-func (p *ProbRecur) ExpandVia(a Solver) {
-	if p.start != nil {
+	if p.start != nil && p.sec == nil {
 		p.current = p.start
 	} else {
-		_, reg := RegParts(p.n)
-		best := a.QueryCache(reg)
-		if best == nil {
-			best = rootServers
-		}
-		p.current = best
+		p.current = p.restartZone(a)
 	}
 
 	p.History = make([]*QueryRecord, 0, 50)
+	p.Lame = nil
 	for p.current != nil {
+		if p.sec != nil {
+			p.sec.enter(a, p.current)
+		}
 		p.Answer = p.queryZone(a)
 	}
+
+	if p.sec != nil {
+		p.Security = p.sec.state
+		p.SecReason = p.sec.reason
+		if p.Security == SEC_SECURE && p.AnsCode != OKAY &&
+			p.AnsCode != NONEXIST {
+			p.Security = SEC_UNKNOWN
+			p.SecReason = "no answer"
+		}
+	}
 }
@@ -0,0 +1,220 @@
+package dns
+
+import (
+	"testing"
+)
+
+// a fake solver that answers queries from a table, for testing problems
+type fakeSolver struct {
+	answers map[string]*Msg // "ip name type" -> response
+	zones   []*Zone         // as cached zones
+	lame    *LameTable
+	anchor  *TrustAnchor
+	keys    *RootKeyCache
+	err     error // for the queries not answered, nil for ErrTimeout
+
+	// answers the queries not in the table, can be nil
+	fallback func(h *IPv4, n *Name, t uint16) *Msg
+}
+
+func newFakeSolver() *fakeSolver {
+	return &fakeSolver{
+		answers: make(map[string]*Msg),
+		lame:    NewLameTable(),
+		keys:    NewRootKeyCache(),
+	}
+}
+
+func fakeKey(ip *IPv4, n *Name, t uint16) string {
+	return ip.String() + " " + n.String() + " " + TypeStr(t)
+}
+
+func (s *fakeSolver) answer(ip string, n string, t uint16, msg *Msg) {
+	msg.Flags |= F_RESPONSE
+	s.answers[fakeKey(ParseIP(ip), Domain(n), t)] = msg
+}
+
+func (s *fakeSolver) Query(h *IPv4, n *Name, t uint16) *Response {
+	msg := s.answers[fakeKey(h, n, t)]
+	if msg == nil && s.fallback != nil {
+		msg = s.fallback(h, n, t)
+	}
+	if msg == nil {
+		return nil
+	}
+	return &Response{Msg: msg, Host: h, Port: DNS_PORT}
+}
+
+func (s *fakeSolver) QueryErr(h *IPv4, n *Name, t uint16) (
+	*Response, error) {
+	ret := s.Query(h, n, t)
+	if ret != nil {
+		return ret, nil
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return nil, ErrTimeout
+}
+
+func (s *fakeSolver) QueryWith(h *IPv4, n *Name, t uint16,
+	opts *QueryOptions) *Response {
+	return s.Query(h, n, t)
+}
+
+func (s *fakeSolver) SolveSub(p Prob) bool {
+	p.ExpandVia(s)
+	return true
+}
+
+func (s *fakeSolver) Log(args ...string) {}
+func (s *fakeSolver) Cache(z *Zone)      {}
+
+func (s *fakeSolver) QueryCache(n *Name) *Zone {
+	var best *Zone
+	for _, z := range s.zones {
+		if n.Equal(z.Name()) || n.SubOf(z.Name()) {
+			if best == nil || z.Name().SubOf(best.Name()) {
+				best = z
+			}
+		}
+	}
+	return best
+}
+
+func (s *fakeSolver) Prepare(z *Zone) []*NameServer {
+	return z.sortedList()
+}
+
+func (s *fakeSolver) MarkLame(zone *Name, ip *IPv4, r int) *LameRecord {
+	return s.lame.Add(zone, ip, r)
+}
+
+func (s *fakeSolver) CheckLame(zone *Name, ip *IPv4) *LameRecord {
+	return s.lame.Check(zone, ip)
+}
+
+func (s *fakeSolver) RootKeys() *RootKeyCache {
+	return s.keys
+}
+
+func (s *fakeSolver) Roots() *Zone {
+	return rootServers
+}
+
+func (s *fakeSolver) TrustAnchor() *TrustAnchor {
+	return s.anchor
+}
+
+func fakeZone(name, server, ip string) *Zone {
+	ret := NewZone(Domain(name))
+	ret.Add(Domain(server), ParseIP(ip))
+	return ret
+}
+
+func cnameRR(from, to string) RR {
+	return RR{Domain(from), CNAME, IN, 300, &RdName{Domain(to)}}
+}
+
+func aRR(name, ip string) RR {
+	return RR{Domain(name), A, IN, 300, &RdIP{ParseIP(ip)}}
+}
+
+func TestRecurCNAME(t *testing.T) {
+	s := newFakeSolver()
+	za := fakeZone("a.test", "ns.a.test", "192.0.2.1")
+	zb := fakeZone("b.test", "ns.b.test", "192.0.2.2")
+	s.zones = []*Zone{za, zb}
+
+	s.answer("192.0.2.1", "www.a.test", A, &Msg{Flags: F_AA,
+		Answ: []RR{cnameRR("www.a.test", "web.b.test")}})
+	s.answer("192.0.2.2", "web.b.test", A, &Msg{Flags: F_AA,
+		Answ: []RR{aRR("web.b.test", "198.51.100.7")}})
+
+	p := NewProbRecur(Domain("www.a.test"), A)
+	s.SolveSub(p)
+	if p.AnsCode != OKAY {
+		t.Fatalf("AnsCode = %d", p.AnsCode)
+	}
+	if len(p.Chain) != 1 || !p.Target().Equal(Domain("web.b.test")) {
+		t.Errorf("wrong chain: %v", p.Chain)
+	}
+	if !p.AnsZone.Name().Equal(Domain("b.test")) {
+		t.Errorf("wrong answer zone %s", p.AnsZone.Name())
+	}
+
+	addr := NewProbAddr(Domain("www.a.test"))
+	s.SolveSub(addr)
+	if len(addr.IPs) != 1 || !addr.IPs[0].Equal(ParseIP("198.51.100.7")) {
+		t.Errorf("wrong addr: %v", addr.IPs)
+	}
+}
+
+func TestRecurCNAMELoop(t *testing.T) {
+	s := newFakeSolver()
+	s.zones = []*Zone{fakeZone("a.test", "ns.a.test", "192.0.2.1")}
+	s.answer("192.0.2.1", "x.a.test", A, &Msg{Flags: F_AA,
+		Answ: []RR{
+			cnameRR("x.a.test", "y.a.test"),
+			cnameRR("y.a.test", "x.a.test"),
+		}})
+
+	p := NewProbRecur(Domain("x.a.test"), A)
+	s.SolveSub(p)
+	if p.AnsCode != BADCHAIN {
+		t.Errorf("AnsCode = %d, expecting BADCHAIN", p.AnsCode)
+	}
+}
+
+func TestRecurDNAME(t *testing.T) {
+	s := newFakeSolver()
+	s.zones = []*Zone{
+		fakeZone("old.test", "ns.old.test", "192.0.2.1"),
+		fakeZone("new.test", "ns.new.test", "192.0.2.2"),
+	}
+	s.answer("192.0.2.1", "www.x.old.test", A, &Msg{Flags: F_AA,
+		Answ: []RR{{Domain("x.old.test"), DNAME, IN, 300,
+			&RdName{Domain("new.test")}}}})
+	s.answer("192.0.2.2", "www.new.test", A, &Msg{Flags: F_AA,
+		Answ: []RR{aRR("www.new.test", "198.51.100.8")}})
+
+	p := NewProbRecur(Domain("www.x.old.test"), A)
+	s.SolveSub(p)
+	if p.AnsCode != OKAY {
+		t.Fatalf("AnsCode = %d", p.AnsCode)
+	}
+	if !p.Target().Equal(Domain("www.new.test")) || len(p.Chain) != 1 ||
+		p.Chain[0].Type != CNAME {
+		t.Errorf("wrong chain: %v", p.Chain)
+	}
+}
+
+func TestRecurLame(t *testing.T) {
+	s := newFakeSolver()
+	z := fakeZone("a.test", "ns1.a.test", "192.0.2.1")
+	z.Add(Domain("ns2.a.test"), ParseIP("192.0.2.2"))
+	s.zones = []*Zone{z}
+
+	// out of queries, the servers are not to blame
+	s.err = errQueryLimit
+	p := NewProbRecur(Domain("www.a.test"), A)
+	s.SolveSub(p)
+	if p.AnsCode != NORESP {
+		t.Errorf("AnsCode = %d, expecting NORESP", p.AnsCode)
+	}
+	if len(p.Lame) != 0 || len(s.lame.List()) != 0 {
+		t.Errorf("servers marked lame: %v", p.Lame)
+	}
+
+	// a held down server that times out again is listed once
+	s.err = nil
+	s.MarkLame(Domain("a.test"), ParseIP("192.0.2.1"), LAME_TIMEOUT)
+	p = NewProbRecur(Domain("www.a.test"), A)
+	s.SolveSub(p)
+	if len(p.Lame) != 2 {
+		t.Errorf("lame list: %v", p.Lame)
+	}
+	if len(s.lame.List()) != 2 {
+		t.Errorf("lame table: %v", s.lame.List())
+	}
+}
@@ -0,0 +1,198 @@
+package dns
+
+import (
+	"fmt"
+)
+
+// compares the soa records served by all authoritative servers of the
+// zone that a name belongs to
+type ProbSOA struct {
+	name *Name
+
+	Zone     *Name        // the zone apex
+	Servers  []*SOAServer // one for each server ip
+	Serial   uint32       // the newest serial seen
+	Problems []string
+}
+
+// the soa served by one server ip
+type SOAServer struct {
+	Name      *Name
+	IP        *IPv4
+	Reachable bool
+	Auth      bool
+	SOA       *RdSOA // nil if not answered
+}
+
+func NewProbSOA(name *Name) *ProbSOA {
+	return &ProbSOA{name: name}
+}
+
+func (p *ProbSOA) Title() (title []string) {
+	return []string{"soa", p.name.String()}
+}
+
+func (p *ProbSOA) problem(a Solver, format string, args ...interface{}) {
+	s := fmt.Sprintf(format, args...)
+	a.Log("// problem:", s)
+	p.Problems = append(p.Problems, s)
+}
+
+// true if all servers answered with the same soa
+func (p *ProbSOA) OK() bool {
+	return len(p.Problems) == 0
+}
+
+// compares serials with rfc1982 serial number arithmetic
+func serialLess(a, b uint32) bool {
+	return int32(a-b) < 0
+}
+
+// finds the apex from the soa in the last response of a recursion
+func soaOwner(recur *ProbRecur) *Name {
+	for i := len(recur.History) - 1; i >= 0; i-- {
+		r := recur.History[i]
+		if r.Resp == nil {
+			continue
+		}
+		rrs := r.Resp.Msg.FilterIN(func(rr *RR, seg int) bool {
+			return rr.Type == SOA && seg != ADDI
+		})
+		if len(rrs) > 0 {
+			return rrs[0].Name
+		}
+		return nil
+	}
+	return nil
+}
+
+func (p *ProbSOA) findZone(a Solver) *Zone {
+	recur := NewProbRecur(p.name, SOA)
+	if !a.SolveSub(recur) {
+		return nil
+	}
+
+	apex := soaOwner(recur)
+	if apex == nil {
+		p.problem(a, "zone apex not found")
+		return nil
+	}
+	p.Zone = apex
+
+	if recur.AnsCode == OKAY && recur.AnsZone.Name().Equal(apex) {
+		return recur.AnsZone
+	}
+
+	// the name is not the apex, ask for the apex now
+	recur = NewProbRecur(apex, SOA)
+	if !a.SolveSub(recur) {
+		return nil
+	}
+	if recur.AnsCode != OKAY {
+		p.problem(a, "soa of %s not found", apex)
+		return nil
+	}
+	return recur.AnsZone
+}
+
+func (p *ProbSOA) ExpandVia(a Solver) {
+	zone := p.findZone(a)
+	if zone == nil {
+		return
+	}
+
+	tried := make(map[uint32]bool)
+	for _, server := range zone.sortedList() {
+		ips := server.IPs
+		if len(ips) == 0 {
+			addr := NewProbAddr(server.Name)
+			if !a.SolveSub(addr) {
+				return
+			}
+			ips = addr.IPs
+		}
+		if len(ips) == 0 {
+			p.problem(a, "no address for %s", server.Name)
+			continue
+		}
+
+		for _, ip := range ips {
+			if tried[ip.Uint()] {
+				continue
+			}
+			tried[ip.Uint()] = true
+			p.queryServer(a, server.Name, ip)
+		}
+	}
+
+	p.compare(a)
+}
+
+func (p *ProbSOA) queryServer(a Solver, name *Name, ip *IPv4) {
+	s := &SOAServer{Name: name, IP: ip}
+	p.Servers = append(p.Servers, s)
+
+	resp := a.Query(ip, p.Zone, SOA)
+	if resp == nil {
+		p.problem(a, "%s(%s) unreachable", name, ip)
+		return
+	}
+	s.Reachable = true
+
+	msg := resp.Msg
+	s.Auth = (msg.Flags & F_AA) == F_AA
+	if !s.Auth {
+		p.problem(a, "%s(%s) not authoritative", name, ip)
+	}
+
+	rrs := msg.FilterIN(func(rr *RR, seg int) bool {
+		return seg == ANSW && rr.Type == SOA && rr.Name.Equal(p.Zone)
+	})
+	if len(rrs) == 0 {
+		p.problem(a, "%s(%s) no soa", name, ip)
+		return
+	}
+	s.SOA = rrs[0].Rdata.(*RdSOA)
+}
+
+func (p *ProbSOA) compare(a Solver) {
+	var newest *RdSOA
+	for _, s := range p.Servers {
+		if s.SOA == nil {
+			continue
+		}
+		if newest == nil || serialLess(newest.Serial, s.SOA.Serial) {
+			newest = s.SOA
+		}
+	}
+	if newest == nil {
+		return
+	}
+	p.Serial = newest.Serial
+
+	for _, s := range p.Servers {
+		soa := s.SOA
+		if soa == nil {
+			continue
+		}
+		if soa.Serial != newest.Serial {
+			p.problem(a, "%s(%s) serial %d, newest is %d",
+				s.Name, s.IP, soa.Serial, newest.Serial)
+			continue // other fields may differ for old serials
+		}
+
+		if !soa.Mname.Equal(newest.Mname) {
+			p.problem(a, "%s(%s) mname %s, others %s",
+				s.Name, s.IP, soa.Mname, newest.Mname)
+		}
+		if !soa.Rname.Equal(newest.Rname) {
+			p.problem(a, "%s(%s) rname %s, others %s",
+				s.Name, s.IP, soa.Rname, newest.Rname)
+		}
+		if soa.Refresh != newest.Refresh || soa.Retry != newest.Retry ||
+			soa.Expire != newest.Expire || soa.Minimum != newest.Minimum {
+			p.problem(a, "%s(%s) timers differ with same serial",
+				s.Name, s.IP)
+		}
+	}
+}
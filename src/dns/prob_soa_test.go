@@ -0,0 +1,48 @@
+package dns
+
+import "testing"
+
+func soaRR(zone string, serial uint32) RR {
+	return RR{Domain(zone), SOA, IN, 3600, &RdSOA{
+		Domain("ns1." + zone), Domain("admin." + zone),
+		serial, 7200, 3600, 1209600, 300,
+	}}
+}
+
+func TestSerialLess(t *testing.T) {
+	if !serialLess(1, 2) || serialLess(2, 1) {
+		t.Error("serialLess wrong on small numbers")
+	}
+	if !serialLess(0xfffffff0, 5) {
+		t.Error("serialLess wrong on wrapping")
+	}
+}
+
+func TestProbSOA(t *testing.T) {
+	s := newFakeSolver()
+	z := NewZone(Domain("ex.test"))
+	z.Add(Domain("ns1.ex.test"), ParseIP("192.0.2.1"))
+	z.Add(Domain("ns2.ex.test"), ParseIP("192.0.2.2"))
+	z.Add(Domain("ns3.ex.test"), ParseIP("192.0.2.3"))
+	s.zones = []*Zone{z}
+
+	s.answer("192.0.2.1", "www.ex.test", SOA, &Msg{Flags: F_AA,
+		Auth: []RR{soaRR("ex.test", 2)}})
+	s.answer("192.0.2.1", "ex.test", SOA, &Msg{Flags: F_AA,
+		Answ: []RR{soaRR("ex.test", 2)}})
+	s.answer("192.0.2.2", "ex.test", SOA, &Msg{Flags: F_AA,
+		Answ: []RR{soaRR("ex.test", 1)}})
+
+	p := NewProbSOA(Domain("www.ex.test"))
+	s.SolveSub(p)
+
+	if !p.Zone.Equal(Domain("ex.test")) {
+		t.Fatalf("wrong apex: %s", p.Zone)
+	}
+	if len(p.Servers) != 3 || p.Serial != 2 {
+		t.Fatalf("servers: %d, serial: %d", len(p.Servers), p.Serial)
+	}
+	if len(p.Problems) != 2 {
+		t.Errorf("expect drift and unreachable, got %v", p.Problems)
+	}
+}
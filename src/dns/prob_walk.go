@@ -0,0 +1,342 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// enumerates the names of a signed zone by following its nsec chain; for
+// an nsec3 zone, it collects the hashed owner names, which can be matched
+// against a dictionary with Match later
+type ProbWalk struct {
+	zone  *Name
+	limit int
+	f     func(e *WalkEntry)
+
+	Entries  []*WalkEntry // in the order found
+	NSEC3    *RdNSEC3     // the hash parameters, nil for an nsec zone
+	Complete bool         // the whole chain is walked
+	Problems []string
+}
+
+// a name found in the chain
+type WalkEntry struct {
+	Name  *Name // the owner name, which is hashed for nsec3
+	Types []uint16
+	Hash  []byte // the owner hash for nsec3
+	Plain *Name  // the name that matches the hash, nil if unknown
+}
+
+// default max number of entries to walk
+const _WALK_LIMIT = 1000
+
+// hashes tried for finding a name in an unknown span of the nsec3 chain
+const _WALK_MAX_GUESS = 100000
+
+// options for walking, all queries ask for dnssec records
+var walkOpts = &QueryOptions{EDNSSize: 4096, DNSSEC: true}
+
+// f is called for each entry when it is found, and can be nil
+// limit is the max number of entries, 0 for the default
+func NewProbWalk(zone *Name, limit int, f func(e *WalkEntry)) *ProbWalk {
+	if limit <= 0 {
+		limit = _WALK_LIMIT
+	}
+	return &ProbWalk{zone: zone, limit: limit, f: f}
+}
+
+func (p *ProbWalk) Title() (title []string) {
+	return []string{"walk", p.zone.String()}
+}
+
+func (p *ProbWalk) problem(a Solver, format string, args ...interface{}) {
+	s := fmt.Sprintf(format, args...)
+	a.Log("// problem:", s)
+	p.Problems = append(p.Problems, s)
+}
+
+func (p *ProbWalk) add(e *WalkEntry) {
+	p.Entries = append(p.Entries, e)
+	if p.f != nil {
+		p.f(e)
+	}
+}
+
+// a server of the zone to walk
+type walkServer struct {
+	name *Name
+	ips  []*IPv4
+}
+
+// finds the servers of the zone, and checks that it is an apex
+func (p *ProbWalk) findServers(a Solver) []*walkServer {
+	recur := NewProbRecur(p.zone, SOA)
+	if !a.SolveSub(recur) {
+		return nil
+	}
+	if recur.AnsCode != OKAY || !recur.AnsZone.Name().Equal(p.zone) {
+		p.problem(a, "%s is not a zone apex", p.zone)
+		return nil
+	}
+
+	ret := make([]*walkServer, 0, 4)
+	for _, server := range recur.AnsZone.sortedList() {
+		ips := server.IPs
+		if len(ips) == 0 {
+			addr := NewProbAddr(server.Name)
+			if !a.SolveSub(addr) {
+				continue
+			}
+			ips = addr.IPs
+		}
+		if len(ips) > 0 {
+			ret = append(ret, &walkServer{server.Name, ips})
+		}
+	}
+	if len(ret) == 0 {
+		p.problem(a, "no server address for %s", p.zone)
+	}
+	return ret
+}
+
+// queries the servers in turn until one answers
+func (p *ProbWalk) query(a Solver, servers []*walkServer, n *Name,
+	t uint16) *Msg {
+	for _, server := range servers {
+		for _, ip := range server.ips {
+			resp := a.QueryWith(ip, n, t, walkOpts)
+			if resp == nil {
+				continue
+			}
+			if lameReason(p.zone, resp.Msg) != 0 {
+				continue
+			}
+			return resp.Msg
+		}
+	}
+	return nil
+}
+
+func (p *ProbWalk) ExpandVia(a Solver) {
+	p.Entries = nil
+	p.NSEC3 = nil
+	p.Complete = false
+
+	servers := p.findServers(a)
+	if len(servers) == 0 {
+		return
+	}
+
+	msg := p.query(a, servers, p.zone, NSEC)
+	if msg == nil {
+		p.problem(a, "no response for the apex nsec")
+		return
+	}
+	if rrs := msg.FilterIN(func(rr *RR, seg int) bool {
+		return seg == ANSW && rr.Type == NSEC && rr.Name.Equal(p.zone)
+	}); len(rrs) > 0 {
+		p.walkNSEC(a, servers, rrs[0])
+		return
+	}
+
+	if len(p.nsec3s(msg)) > 0 {
+		p.walkNSEC3(a, servers, msg)
+		return
+	}
+	p.problem(a, "neither nsec nor nsec3 at %s", p.zone)
+}
+
+// follows the next names from the apex until it comes back
+func (p *ProbWalk) walkNSEC(a Solver, servers []*walkServer, rr *RR) {
+	seen := make(map[string]bool)
+	for {
+		nsec := rr.Rdata.(*RdNSEC)
+		seen[rr.Name.String()] = true
+		p.add(&WalkEntry{Name: rr.Name, Types: nsec.Types})
+
+		next := nsec.Next
+		if next.Equal(p.zone) {
+			p.Complete = true
+			return
+		}
+		if !next.SubOf(p.zone) {
+			p.problem(a, "nsec of %s points out of the zone: %s",
+				rr.Name, next)
+			return
+		}
+		if seen[next.String()] {
+			p.problem(a, "nsec loop at %s", next)
+			return
+		}
+		if len(p.Entries) >= p.limit {
+			a.Log("// walk limit reached")
+			return
+		}
+
+		msg := p.query(a, servers, next, NSEC)
+		if msg == nil {
+			p.problem(a, "no response for %s", next)
+			return
+		}
+		rrs := msg.FilterIN(func(rr *RR, seg int) bool {
+			return seg != ADDI && rr.Type == NSEC && rr.Name.Equal(next)
+		})
+		if len(rrs) == 0 {
+			p.problem(a, "no nsec for %s", next)
+			return
+		}
+		rr = rrs[0]
+	}
+}
+
+// the nsec3 records of the zone in a message
+func (p *ProbWalk) nsec3s(msg *Msg) []*RR {
+	return msg.FilterIN(func(rr *RR, seg int) bool {
+		return seg == AUTH && rr.Type == NSEC3 &&
+			rr.Name.Parent().Equal(p.zone)
+	})
+}
+
+// the known spans of an nsec3 chain
+type nsec3Chain struct {
+	owners map[string]*RdNSEC3 // owner hash -> record
+}
+
+func (c *nsec3Chain) covers(h []byte) bool {
+	if c.owners[string(h)] != nil {
+		return true
+	}
+	for owner, rd := range c.owners {
+		if nsec3Covers([]byte(owner), rd.NextHash, h) {
+			return true
+		}
+	}
+	return false
+}
+
+// true if every next hash is a known owner, so the ring is closed
+func (c *nsec3Chain) closed() bool {
+	for _, rd := range c.owners {
+		if c.owners[string(rd.NextHash)] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// adds the new nsec3 records in the message, returns the number added
+func (p *ProbWalk) collect(a Solver, chain *nsec3Chain, msg *Msg) int {
+	added := 0
+	for _, rr := range p.nsec3s(msg) {
+		rd := rr.Rdata.(*RdNSEC3)
+		if !sameNSEC3Params(p.NSEC3, rd) {
+			continue
+		}
+		h, err := nsec3OwnerHash(rr.Name)
+		if err != nil {
+			p.problem(a, "%s", err)
+			continue
+		}
+		if chain.owners[string(h)] != nil {
+			continue
+		}
+		chain.owners[string(h)] = rd
+		p.add(&WalkEntry{Name: rr.Name, Types: rd.Types, Hash: h})
+		added++
+	}
+	return added
+}
+
+// guesses names whose hashes fall in unknown spans, and queries them to
+// get the nsec3 records that cover the hashes
+func (p *ProbWalk) walkNSEC3(a Solver, servers []*walkServer, msg *Msg) {
+	p.NSEC3 = p.nsec3s(msg)[0].Rdata.(*RdNSEC3)
+	if p.NSEC3.HashAlg != 1 {
+		p.problem(a, "unknown nsec3 hash algorithm %d", p.NSEC3.HashAlg)
+		return
+	}
+	chain := &nsec3Chain{owners: make(map[string]*RdNSEC3)}
+	p.collect(a, chain, msg)
+
+	guess := 0
+	for !chain.closed() {
+		if len(p.Entries) >= p.limit {
+			a.Log("// walk limit reached")
+			return
+		}
+
+		var n *Name
+		for tries := 0; n == nil; tries++ {
+			if tries >= _WALK_MAX_GUESS {
+				p.problem(a, "no name found for the unknown spans")
+				return
+			}
+			c, err := p.zone.Child(fmt.Sprintf("%x", guess))
+			guess++
+			if err != nil {
+				p.problem(a, "%s", err)
+				return
+			}
+			h, _ := nsec3Hash(c, p.NSEC3.HashAlg, p.NSEC3.Salt,
+				p.NSEC3.Iterations)
+			if !chain.covers(h) {
+				n = c
+			}
+		}
+
+		msg := p.query(a, servers, n, A)
+		if msg == nil {
+			p.problem(a, "no response for %s", n)
+			return
+		}
+		if p.collect(a, chain, msg) == 0 {
+			p.problem(a, "no new nsec3 for %s", n)
+			return
+		}
+	}
+	p.Complete = true
+}
+
+// hashes the words as names under the zone, and fills in Plain of the
+// entries that match; a word can be a label or a relative name like
+// "www.eu"; returns the number of entries matched
+func (p *ProbWalk) Match(words []string) int {
+	if p.NSEC3 == nil {
+		return 0
+	}
+	byHash := make(map[string]*WalkEntry)
+	for _, e := range p.Entries {
+		if e.Plain == nil {
+			byHash[string(e.Hash)] = e
+		}
+	}
+
+	ret := 0
+	for _, w := range words {
+		w = strings.TrimSpace(w)
+		if w == "" {
+			continue
+		}
+		rel, err := NewName(w)
+		if err != nil {
+			continue
+		}
+		n := p.zone
+		if !rel.IsRoot() {
+			if n, err = rel.Concat(p.zone); err != nil {
+				continue
+			}
+		}
+		h, err := nsec3Hash(n, p.NSEC3.HashAlg, p.NSEC3.Salt,
+			p.NSEC3.Iterations)
+		if err != nil {
+			return ret
+		}
+		if e := byHash[string(h)]; e != nil {
+			e.Plain = n
+			delete(byHash, string(h))
+			ret++
+		}
+	}
+	return ret
+}
@@ -0,0 +1,95 @@
+package dns
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+)
+
+func TestWalkNSEC(t *testing.T) {
+	s := newFakeSolver()
+	s.zones = []*Zone{fakeZone("walk.test", "ns.walk.test", "192.0.2.1")}
+	s.answer("192.0.2.1", "walk.test", SOA, &Msg{Flags: F_AA,
+		Answ: []RR{soaRR("walk.test", 1)}})
+
+	chain := []string{"walk.test", "a.walk.test", "b.walk.test"}
+	for i, n := range chain {
+		next := chain[(i+1)%len(chain)]
+		s.answer("192.0.2.1", n, NSEC, &Msg{Flags: F_AA,
+			Answ: []RR{nsecRR(n, next, A, NSEC)}})
+	}
+
+	found := 0
+	p := NewProbWalk(Domain("walk.test"), 0, func(e *WalkEntry) {
+		found++
+	})
+	s.SolveSub(p)
+	if !p.Complete || len(p.Entries) != 3 || found != 3 {
+		t.Fatalf("walk: complete=%v %d entries, %v", p.Complete,
+			len(p.Entries), p.Problems)
+	}
+	for i, e := range p.Entries {
+		if !e.Name.Equal(Domain(chain[i])) {
+			t.Errorf("entry %d: %s", i, e.Name)
+		}
+	}
+
+	p = NewProbWalk(Domain("walk.test"), 2, nil)
+	s.SolveSub(p)
+	if p.Complete || len(p.Entries) != 2 {
+		t.Errorf("limit: complete=%v %d entries", p.Complete, len(p.Entries))
+	}
+}
+
+func TestWalkNSEC3(t *testing.T) {
+	zone := Domain("h.test")
+	salt := []byte{0x12, 0x34}
+	var hashes [][]byte
+	for _, n := range []string{"h.test", "a.h.test", "b.h.test", "mail.h.test"} {
+		h, _ := nsec3Hash(Domain(n), 1, salt, 2)
+		hashes = append(hashes, h)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i], hashes[j]) < 0
+	})
+	records := make([]RR, len(hashes))
+	for i, h := range hashes {
+		owner, _ := zone.Child(nsec3Label(h))
+		records[i] = RR{owner, NSEC3, IN, 300, &RdNSEC3{1, 0, 2, salt,
+			hashes[(i+1)%len(hashes)], []uint16{A, RRSIG}}}
+	}
+
+	s := newFakeSolver()
+	s.zones = []*Zone{fakeZone("h.test", "ns.h.test", "192.0.2.1")}
+	s.answer("192.0.2.1", "h.test", SOA, &Msg{Flags: F_AA,
+		Answ: []RR{soaRR("h.test", 1)}})
+	// answers with the record that matches or covers the name
+	s.fallback = func(h *IPv4, n *Name, t uint16) *Msg {
+		hash, _ := nsec3Hash(n, 1, salt, 2)
+		for i, rr := range records {
+			rd := rr.Rdata.(*RdNSEC3)
+			if bytes.Equal(hashes[i], hash) ||
+				nsec3Covers(hashes[i], rd.NextHash, hash) {
+				return &Msg{Flags: F_AA | F_RESPONSE | RCODE_NAMEERROR,
+					Auth: []RR{rr}}
+			}
+		}
+		return nil
+	}
+
+	p := NewProbWalk(zone, 0, nil)
+	s.SolveSub(p)
+	if !p.Complete || len(p.Entries) != 4 || p.NSEC3 == nil {
+		t.Fatalf("walk: complete=%v %d entries, %v", p.Complete,
+			len(p.Entries), p.Problems)
+	}
+	if n := p.Match([]string{"a", "b", "www", "mail"}); n != 3 {
+		t.Errorf("%d matched, expecting 3", n)
+	}
+	for _, e := range p.Entries {
+		if e.Plain != nil && e.Plain.Equal(Domain("mail.h.test")) {
+			return
+		}
+	}
+	t.Error("mail.h.test not matched")
+}
@@ -0,0 +1,185 @@
+package dns
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// punycode, as in rfc3492
+
+const (
+	_PUNY_BASE         = 36
+	_PUNY_TMIN         = 1
+	_PUNY_TMAX         = 26
+	_PUNY_SKEW         = 38
+	_PUNY_DAMP         = 700
+	_PUNY_INITIAL_BIAS = 72
+	_PUNY_INITIAL_N    = 128
+)
+
+var (
+	errPunyBadInput = errors.New("punycode: bad input")
+	errPunyOverflow = errors.New("punycode: overflow")
+)
+
+func punyAdapt(delta, numPoints int, first bool) int {
+	if first {
+		delta /= _PUNY_DAMP
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > ((_PUNY_BASE-_PUNY_TMIN)*_PUNY_TMAX)/2 {
+		delta /= _PUNY_BASE - _PUNY_TMIN
+		k += _PUNY_BASE
+	}
+	return k + (_PUNY_BASE-_PUNY_TMIN+1)*delta/(delta+_PUNY_SKEW)
+}
+
+func punyThreshold(k, bias int) int {
+	t := k - bias
+	if t < _PUNY_TMIN {
+		return _PUNY_TMIN
+	}
+	if t > _PUNY_TMAX {
+		return _PUNY_TMAX
+	}
+	return t
+}
+
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func punyValue(c byte) int {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c-'0') + 26
+	case 'a' <= c && c <= 'z':
+		return int(c - 'a')
+	case 'A' <= c && c <= 'Z':
+		return int(c - 'A')
+	}
+	return -1
+}
+
+// encodes a unicode string into punycode, without the xn-- prefix
+func punyEncode(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", errPunyBadInput
+	}
+	input := []rune(s)
+	out := make([]byte, 0, len(s)+8)
+	for _, r := range input {
+		if r < 0x80 {
+			out = append(out, byte(r))
+		}
+	}
+	b := len(out)
+	h := b
+	if b > 0 {
+		out = append(out, '-')
+	}
+
+	n := _PUNY_INITIAL_N
+	delta := 0
+	bias := _PUNY_INITIAL_BIAS
+	for h < len(input) {
+		m := int(utf8.MaxRune) + 1
+		for _, r := range input {
+			if int(r) >= n && int(r) < m {
+				m = int(r)
+			}
+		}
+		if (m - n) > (1<<30-delta)/(h+1) {
+			return "", errPunyOverflow
+		}
+		delta += (m - n) * (h + 1)
+		n = m
+
+		for _, r := range input {
+			if int(r) < n {
+				delta++
+			}
+			if int(r) != n {
+				continue
+			}
+			q := delta
+			for k := _PUNY_BASE; ; k += _PUNY_BASE {
+				t := punyThreshold(k, bias)
+				if q < t {
+					break
+				}
+				out = append(out, punyDigit(t+(q-t)%(_PUNY_BASE-t)))
+				q = (q - t) / (_PUNY_BASE - t)
+			}
+			out = append(out, punyDigit(q))
+			bias = punyAdapt(delta, h+1, h == b)
+			delta = 0
+			h++
+		}
+		delta++
+		n++
+	}
+	return string(out), nil
+}
+
+// decodes punycode, without the xn-- prefix, into unicode
+func punyDecode(s string) (string, error) {
+	output := make([]rune, 0, len(s))
+	pos := 0
+	if b := strings.LastIndex(s, "-"); b >= 0 {
+		for i := 0; i < b; i++ {
+			if s[i] >= 0x80 {
+				return "", errPunyBadInput
+			}
+			output = append(output, rune(s[i]))
+		}
+		pos = b + 1
+	}
+
+	n := _PUNY_INITIAL_N
+	i := 0
+	bias := _PUNY_INITIAL_BIAS
+	for pos < len(s) {
+		oldi := i
+		w := 1
+		for k := _PUNY_BASE; ; k += _PUNY_BASE {
+			if pos >= len(s) {
+				return "", errPunyBadInput
+			}
+			digit := punyValue(s[pos])
+			pos++
+			if digit < 0 {
+				return "", errPunyBadInput
+			}
+			if digit > (1<<30-i)/w {
+				return "", errPunyOverflow
+			}
+			i += digit * w
+			t := punyThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			w *= _PUNY_BASE - t
+		}
+		count := len(output) + 1
+		bias = punyAdapt(i-oldi, count, oldi == 0)
+		n += i / count
+		i %= count
+		if n > utf8.MaxRune || n < 0x80 {
+			return "", errPunyBadInput
+		}
+
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = rune(n)
+		i++
+	}
+	return string(output), nil
+}
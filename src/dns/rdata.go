@@ -2,6 +2,7 @@ package dns
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
@@ -25,12 +26,15 @@ func (rd *RdBytes) printOut() ([]string, func(p *printer)) {
 }
 
 func (rd *RdBytes) writeTo(w *writer) error {
-	w.writeUint16(0)
+	w.writeBytes(rd.Data)
 	return nil
 }
 
 func (rd *RdBytes) readFrom(r *reader, n uint16) error {
 	rd.Data = make([]byte, n)
+	if n == 0 {
+		return nil
+	}
 	return r.readBytes(rd.Data)
 }
 
@@ -72,7 +76,6 @@ func (r *RdName) printOut() ([]string, func(p *printer)) {
 }
 
 func (rd *RdName) writeTo(w *writer) error {
-	panic("not implemented")
 	w.writeName(rd.Name)
 	return nil
 }
@@ -84,3 +87,81 @@ func (rd *RdName) readFrom(r *reader, n uint16) (err error) {
 	}
 	return nil
 }
+
+// for soa records
+type RdSOA struct {
+	Mname   *Name
+	Rname   *Name
+	Serial  uint32
+	Refresh uint32
+	Retry   uint32
+	Expire  uint32
+	Minimum uint32
+}
+
+func (rd *RdSOA) printOut() ([]string, func(p *printer)) {
+	return []string{
+		rd.Mname.String(),
+		rd.Rname.String(),
+		fmt.Sprintf("%d", rd.Serial),
+		TTLStr(rd.Refresh),
+		TTLStr(rd.Retry),
+		TTLStr(rd.Expire),
+		TTLStr(rd.Minimum),
+	}, nil
+}
+
+func (rd *RdSOA) writeTo(w *writer) error {
+	w.writeName(rd.Mname)
+	w.writeName(rd.Rname)
+	w.writeUint32(rd.Serial)
+	w.writeUint32(rd.Refresh)
+	w.writeUint32(rd.Retry)
+	w.writeUint32(rd.Expire)
+	w.writeUint32(rd.Minimum)
+	return nil
+}
+
+func (rd *RdSOA) readFrom(r *reader, n uint16) (err error) {
+	if rd.Mname, err = r.readName(); err != nil {
+		return err
+	}
+	if rd.Rname, err = r.readName(); err != nil {
+		return err
+	}
+	for _, p := range []*uint32{
+		&rd.Serial, &rd.Refresh, &rd.Retry, &rd.Expire, &rd.Minimum,
+	} {
+		if *p, err = r.readUint32(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// for mx records
+type RdMX struct {
+	Preference uint16
+	Exchange   *Name
+}
+
+func (rd *RdMX) printOut() ([]string, func(p *printer)) {
+	return []string{
+		fmt.Sprintf("%d", rd.Preference),
+		rd.Exchange.String(),
+	}, nil
+}
+
+func (rd *RdMX) writeTo(w *writer) error {
+	w.writeUint16(rd.Preference)
+	w.writeName(rd.Exchange)
+	return nil
+}
+
+func (rd *RdMX) readFrom(r *reader, n uint16) (err error) {
+	if rd.Preference, err = r.readUint16(); err != nil {
+		return err
+	}
+	rd.Exchange, err = r.readName()
+	return err
+}
@@ -0,0 +1,466 @@
+package dns
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// rdata of the dnssec record types, see rfc4034 and rfc5155
+
+var (
+	errRdataSize = errors.New("rdata: wrong size")
+	errBitmap    = errors.New("rdata: bad type bitmap")
+)
+
+// reads the rest of an rdata that starts at start and has n bytes
+func (r *reader) readRest(start int, n uint16) ([]byte, error) {
+	left := int(n) - (r.offset() - start)
+	if left < 0 {
+		return nil, errRdataSize
+	}
+	ret := make([]byte, left)
+	if left == 0 {
+		return ret, nil
+	}
+	if err := r.readBytes(ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (r *reader) readSized() ([]byte, error) {
+	size, err := r.readUint8()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]byte, size)
+	if size == 0 {
+		return ret, nil
+	}
+	if err = r.readBytes(ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func hexStr(b []byte) string {
+	if len(b) == 0 {
+		return "-"
+	}
+	return strings.ToUpper(hex.EncodeToString(b))
+}
+
+var base32Hex = base32.HexEncoding.WithPadding(base32.NoPadding)
+
+// dnssec time stamps are printed as YYYYMMDDHHmmSS
+func sigTimeStr(t uint32) string {
+	return time.Unix(int64(t), 0).UTC().Format("20060102150405")
+}
+
+// decodes a type bitmap of nsec and nsec3 records
+func decodeBitmap(buf []byte) ([]uint16, error) {
+	ret := make([]uint16, 0, 16)
+	lastWindow := -1
+	for len(buf) > 0 {
+		if len(buf) < 2 {
+			return nil, errBitmap
+		}
+		window := int(buf[0])
+		size := int(buf[1])
+		if window <= lastWindow || size == 0 || size > 32 ||
+			len(buf) < 2+size {
+			return nil, errBitmap
+		}
+		lastWindow = window
+		for i, b := range buf[2 : 2+size] {
+			for bit := 0; bit < 8; bit++ {
+				if b&(0x80>>uint(bit)) != 0 {
+					ret = append(ret, uint16(window<<8|i*8+bit))
+				}
+			}
+		}
+		buf = buf[2+size:]
+	}
+	return ret, nil
+}
+
+// encodes types into a type bitmap
+func encodeBitmap(types []uint16) []byte {
+	sorted := make([]int, len(types))
+	for i, t := range types {
+		sorted[i] = int(t)
+	}
+	sort.Ints(sorted)
+
+	ret := make([]byte, 0, 34)
+	var block []byte
+	window := -1
+	flush := func() {
+		if window >= 0 {
+			ret = append(ret, byte(window), byte(len(block)))
+			ret = append(ret, block...)
+		}
+	}
+	for _, t := range sorted {
+		if t>>8 != window {
+			flush()
+			window = t >> 8
+			block = make([]byte, 0, 32)
+		}
+		i := (t & 0xff) / 8
+		for len(block) <= i {
+			block = append(block, 0)
+		}
+		block[i] |= 0x80 >> uint(t%8)
+	}
+	flush()
+	return ret
+}
+
+func typeListStrs(types []uint16) []string {
+	ret := make([]string, len(types))
+	for i, t := range types {
+		ret[i] = TypeStr(t)
+	}
+	return ret
+}
+
+// for dnskey records
+type RdDNSKEY struct {
+	Flags     uint16
+	Protocol  uint8
+	Algorithm uint8
+	PublicKey []byte
+}
+
+// dnskey flags
+const (
+	DNSKEY_ZONE = 0x1 << 8
+	DNSKEY_SEP  = 0x1
+)
+
+func (rd *RdDNSKEY) printOut() ([]string, func(p *printer)) {
+	return []string{
+		fmt.Sprintf("%d", rd.Flags),
+		fmt.Sprintf("%d", rd.Protocol),
+		fmt.Sprintf("%d", rd.Algorithm),
+		base64.StdEncoding.EncodeToString(rd.PublicKey),
+		fmt.Sprintf("tag=%d", rd.KeyTag()),
+	}, nil
+}
+
+func (rd *RdDNSKEY) writeTo(w *writer) error {
+	w.writeUint16(rd.Flags)
+	w.writeUint8(rd.Protocol)
+	w.writeUint8(rd.Algorithm)
+	w.writeBytes(rd.PublicKey)
+	return nil
+}
+
+func (rd *RdDNSKEY) readFrom(r *reader, n uint16) (err error) {
+	start := r.offset()
+	if rd.Flags, err = r.readUint16(); err != nil {
+		return err
+	}
+	if rd.Protocol, err = r.readUint8(); err != nil {
+		return err
+	}
+	if rd.Algorithm, err = r.readUint8(); err != nil {
+		return err
+	}
+	rd.PublicKey, err = r.readRest(start, n)
+	return err
+}
+
+// the key tag, as in rfc4034 appendix b
+func (rd *RdDNSKEY) KeyTag() uint16 {
+	w := new(writer)
+	rd.writeTo(w)
+	buf := w.wire()
+
+	if rd.Algorithm == 1 { // rsa/md5
+		if len(buf) < 4 {
+			return 0
+		}
+		return enc.Uint16(buf[len(buf)-3:])
+	}
+
+	var ac uint32
+	for i, b := range buf {
+		if i&1 == 1 {
+			ac += uint32(b)
+		} else {
+			ac += uint32(b) << 8
+		}
+	}
+	ac += ac >> 16 & 0xffff
+	return uint16(ac & 0xffff)
+}
+
+// for ds records
+type RdDS struct {
+	KeyTag     uint16
+	Algorithm  uint8
+	DigestType uint8
+	Digest     []byte
+}
+
+func (rd *RdDS) printOut() ([]string, func(p *printer)) {
+	return []string{
+		fmt.Sprintf("%d", rd.KeyTag),
+		fmt.Sprintf("%d", rd.Algorithm),
+		fmt.Sprintf("%d", rd.DigestType),
+		hexStr(rd.Digest),
+	}, nil
+}
+
+func (rd *RdDS) writeTo(w *writer) error {
+	w.writeUint16(rd.KeyTag)
+	w.writeUint8(rd.Algorithm)
+	w.writeUint8(rd.DigestType)
+	w.writeBytes(rd.Digest)
+	return nil
+}
+
+func (rd *RdDS) readFrom(r *reader, n uint16) (err error) {
+	start := r.offset()
+	if rd.KeyTag, err = r.readUint16(); err != nil {
+		return err
+	}
+	if rd.Algorithm, err = r.readUint8(); err != nil {
+		return err
+	}
+	if rd.DigestType, err = r.readUint8(); err != nil {
+		return err
+	}
+	rd.Digest, err = r.readRest(start, n)
+	return err
+}
+
+// for rrsig records
+type RdRRSIG struct {
+	TypeCovered uint16
+	Algorithm   uint8
+	Labels      uint8
+	OrigTTL     uint32
+	Expiration  uint32
+	Inception   uint32
+	KeyTag      uint16
+	Signer      *Name
+	Signature   []byte
+}
+
+func (rd *RdRRSIG) printOut() ([]string, func(p *printer)) {
+	return []string{
+		TypeStr(rd.TypeCovered),
+		fmt.Sprintf("%d", rd.Algorithm),
+		fmt.Sprintf("%d", rd.Labels),
+		TTLStr(rd.OrigTTL),
+		sigTimeStr(rd.Expiration),
+		sigTimeStr(rd.Inception),
+		fmt.Sprintf("%d", rd.KeyTag),
+		rd.Signer.String(),
+		base64.StdEncoding.EncodeToString(rd.Signature),
+	}, nil
+}
+
+// writes the fields before the signature
+func (rd *RdRRSIG) writeHeader(w *writer) {
+	w.writeUint16(rd.TypeCovered)
+	w.writeUint8(rd.Algorithm)
+	w.writeUint8(rd.Labels)
+	w.writeUint32(rd.OrigTTL)
+	w.writeUint32(rd.Expiration)
+	w.writeUint32(rd.Inception)
+	w.writeUint16(rd.KeyTag)
+	w.writeName(rd.Signer)
+}
+
+func (rd *RdRRSIG) writeTo(w *writer) error {
+	rd.writeHeader(w)
+	w.writeBytes(rd.Signature)
+	return nil
+}
+
+func (rd *RdRRSIG) readFrom(r *reader, n uint16) (err error) {
+	start := r.offset()
+	if rd.TypeCovered, err = r.readUint16(); err != nil {
+		return err
+	}
+	if rd.Algorithm, err = r.readUint8(); err != nil {
+		return err
+	}
+	if rd.Labels, err = r.readUint8(); err != nil {
+		return err
+	}
+	for _, p := range []*uint32{
+		&rd.OrigTTL, &rd.Expiration, &rd.Inception,
+	} {
+		if *p, err = r.readUint32(); err != nil {
+			return err
+		}
+	}
+	if rd.KeyTag, err = r.readUint16(); err != nil {
+		return err
+	}
+	if rd.Signer, err = r.readName(); err != nil {
+		return err
+	}
+	rd.Signature, err = r.readRest(start, n)
+	return err
+}
+
+// for nsec records
+type RdNSEC struct {
+	Next  *Name
+	Types []uint16
+}
+
+func (rd *RdNSEC) printOut() ([]string, func(p *printer)) {
+	return append([]string{rd.Next.String()}, typeListStrs(rd.Types)...),
+		nil
+}
+
+func (rd *RdNSEC) writeTo(w *writer) error {
+	w.writeNameKeepCase(rd.Next) // not lower cased, rfc6840 section 5.1
+	w.writeBytes(encodeBitmap(rd.Types))
+	return nil
+}
+
+func (rd *RdNSEC) readFrom(r *reader, n uint16) (err error) {
+	start := r.offset()
+	if rd.Next, err = r.readName(); err != nil {
+		return err
+	}
+	bitmap, err := r.readRest(start, n)
+	if err != nil {
+		return err
+	}
+	rd.Types, err = decodeBitmap(bitmap)
+	return err
+}
+
+// true if the type is in the bitmap
+func hasType(types []uint16, t uint16) bool {
+	for _, x := range types {
+		if x == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (rd *RdNSEC) HasType(t uint16) bool {
+	return hasType(rd.Types, t)
+}
+
+// for nsec3 records
+type RdNSEC3 struct {
+	HashAlg    uint8
+	Flags      uint8
+	Iterations uint16
+	Salt       []byte
+	NextHash   []byte
+	Types      []uint16
+}
+
+// nsec3 flags
+const NSEC3_OPTOUT = 0x1
+
+func (rd *RdNSEC3) printOut() ([]string, func(p *printer)) {
+	ret := []string{
+		fmt.Sprintf("%d", rd.HashAlg),
+		fmt.Sprintf("%d", rd.Flags),
+		fmt.Sprintf("%d", rd.Iterations),
+		hexStr(rd.Salt),
+		strings.ToLower(base32Hex.EncodeToString(rd.NextHash)),
+	}
+	return append(ret, typeListStrs(rd.Types)...), nil
+}
+
+func (rd *RdNSEC3) writeTo(w *writer) error {
+	w.writeUint8(rd.HashAlg)
+	w.writeUint8(rd.Flags)
+	w.writeUint16(rd.Iterations)
+	w.writeUint8(uint8(len(rd.Salt)))
+	w.writeBytes(rd.Salt)
+	w.writeUint8(uint8(len(rd.NextHash)))
+	w.writeBytes(rd.NextHash)
+	w.writeBytes(encodeBitmap(rd.Types))
+	return nil
+}
+
+func (rd *RdNSEC3) readFrom(r *reader, n uint16) (err error) {
+	start := r.offset()
+	if rd.HashAlg, err = r.readUint8(); err != nil {
+		return err
+	}
+	if rd.Flags, err = r.readUint8(); err != nil {
+		return err
+	}
+	if rd.Iterations, err = r.readUint16(); err != nil {
+		return err
+	}
+	if rd.Salt, err = r.readSized(); err != nil {
+		return err
+	}
+	if rd.NextHash, err = r.readSized(); err != nil {
+		return err
+	}
+	bitmap, err := r.readRest(start, n)
+	if err != nil {
+		return err
+	}
+	rd.Types, err = decodeBitmap(bitmap)
+	return err
+}
+
+func (rd *RdNSEC3) HasType(t uint16) bool {
+	return hasType(rd.Types, t)
+}
+
+// for nsec3param records
+type RdNSEC3PARAM struct {
+	HashAlg    uint8
+	Flags      uint8
+	Iterations uint16
+	Salt       []byte
+}
+
+func (rd *RdNSEC3PARAM) printOut() ([]string, func(p *printer)) {
+	return []string{
+		fmt.Sprintf("%d", rd.HashAlg),
+		fmt.Sprintf("%d", rd.Flags),
+		fmt.Sprintf("%d", rd.Iterations),
+		hexStr(rd.Salt),
+	}, nil
+}
+
+func (rd *RdNSEC3PARAM) writeTo(w *writer) error {
+	w.writeUint8(rd.HashAlg)
+	w.writeUint8(rd.Flags)
+	w.writeUint16(rd.Iterations)
+	w.writeUint8(uint8(len(rd.Salt)))
+	w.writeBytes(rd.Salt)
+	return nil
+}
+
+func (rd *RdNSEC3PARAM) readFrom(r *reader, n uint16) (err error) {
+	if rd.HashAlg, err = r.readUint8(); err != nil {
+		return err
+	}
+	if rd.Flags, err = r.readUint8(); err != nil {
+		return err
+	}
+	if rd.Iterations, err = r.readUint16(); err != nil {
+		return err
+	}
+	rd.Salt, err = r.readSized()
+	return err
+}
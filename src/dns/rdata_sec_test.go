@@ -0,0 +1,79 @@
+package dns
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestKeyTag(t *testing.T) {
+	// the example in rfc4034 section 5.4
+	key, _ := base64.StdEncoding.DecodeString(
+		"AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/" +
+			"2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvx" +
+			"egXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9Xzc" +
+			"nOf+EPbtG9DMBmADjFDc2w/rljwvFw==")
+	rd := &RdDNSKEY{256, 3, 5, key}
+	if rd.KeyTag() != 60485 {
+		t.Errorf("key tag = %d, expecting 60485", rd.KeyTag())
+	}
+}
+
+func TestBitmap(t *testing.T) {
+	types := []uint16{A, MX, RRSIG, NSEC, 1234}
+	buf := encodeBitmap(types)
+	// rfc4034 section 4.3, plus type 1234 in window 4
+	expect := []byte{
+		0, 6, 0x40, 0x01, 0x00, 0x00, 0x00, 0x03,
+		4, 27, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x20,
+	}
+	if string(buf) != string(expect) {
+		t.Errorf("wrong bitmap: %v", buf)
+	}
+	got, e := decodeBitmap(buf)
+	if e != nil || len(got) != len(types) {
+		t.Fatalf("decode: %v %v", got, e)
+	}
+	for i := range types {
+		if got[i] != types[i] {
+			t.Errorf("type %d: %d", i, got[i])
+		}
+	}
+	if _, e = decodeBitmap([]byte{0, 40}); e == nil {
+		t.Error("bad bitmap should fail")
+	}
+}
+
+func TestSecWire(t *testing.T) {
+	zone := Domain("example.com")
+	m := NewQuery(zone, DNSKEY)
+	m.Flags = F_RESPONSE | F_AA
+	add := func(t uint16, rd Rdata) {
+		m.Answ = append(m.Answ, RR{zone, t, IN, 3600, rd})
+	}
+	add(DNSKEY, &RdDNSKEY{257, 3, 13, []byte{1, 2, 3, 4}})
+	add(DS, &RdDS{12345, 13, 2, []byte{0xab, 0xcd}})
+	add(RRSIG, &RdRRSIG{DNSKEY, 13, 2, 3600, 1700000000, 1690000000,
+		12345, zone, []byte{9, 8, 7}})
+	add(NSEC, &RdNSEC{Domain("a.example.com"), []uint16{NS, SOA, RRSIG}})
+	add(NSEC3, &RdNSEC3{1, 1, 10, []byte{0xaa}, []byte{1, 2, 3, 4, 5},
+		[]uint16{A, RRSIG}})
+	add(NSEC3PARAM, &RdNSEC3PARAM{1, 0, 0, nil})
+	add(SOA, &RdSOA{zone, zone, 1, 2, 3, 4, 5})
+
+	wire, e := m.Wire()
+	if e != nil {
+		t.Fatal(e)
+	}
+	m2, e := ParseMsg(wire)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if m.String() != m2.String() {
+		t.Errorf("round trip differs:\n%s\n%s", m, m2)
+	}
+	wire2, _ := m2.Wire()
+	if string(wire) != string(wire2) {
+		t.Error("wire differs")
+	}
+}
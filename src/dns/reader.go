@@ -5,27 +5,24 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
-	"strings"
 )
 
 // message parser
 type reader struct {
-	buf    *bytes.Reader
-	seeker *bytes.Reader
+	buf     *bytes.Reader
+	seeker  *bytes.Reader
+	rrStart int // offset of the last rr read
 }
 
 var (
-	errEmptyLabel   = errors.New("empty label")
-	errDashStart    = errors.New("label starts with dash")
-	errDashEnd      = errors.New("label ends with dash")
-	errSpecialChars = errors.New("label has special characters")
-	errLongLabel    = errors.New("label too long")
-	errLongName     = errors.New("name too long")
+	errLongLabel   = errors.New("label too long")
+	errLongName    = errors.New("name too long")
+	errPointerLoop = errors.New("too many compression pointers")
 )
 
 func newReader(wire []byte) *reader {
-	return &reader{bytes.NewReader(wire),
-		bytes.NewReader(wire)}
+	return &reader{buf: bytes.NewReader(wire),
+		seeker: bytes.NewReader(wire)}
 }
 
 func (r *reader) readUint8() (ret uint8, err error) {
@@ -52,6 +49,11 @@ func (r *reader) readUint32() (ret uint32, err error) {
 	return
 }
 
+// the number of bytes read so far
+func (r *reader) offset() int {
+	return int(r.buf.Size()) - r.buf.Len()
+}
+
 func (r *reader) readBytes(buf []byte) (err error) {
 	n, e := r.buf.Read(buf)
 	if e != nil {
@@ -63,36 +65,9 @@ func (r *reader) readBytes(buf []byte) (err error) {
 	return nil
 }
 
-func fmtLabel(b []byte) (ret string, err error) {
-	s := strings.ToLower(string(b))
-	n := len(s)
-	if n == 0 {
-		return "", errEmptyLabel
-	}
-
-	if s[0] == '-' {
-		return "", errDashStart
-	}
-	if s[len(s)-1] == '-' {
-		return "", errDashEnd
-	}
-
-	for _, c := range s {
-		switch {
-		default:
-			return "", errSpecialChars
-		case 'a' <= c && c <= 'z':
-		case '0' <= c && c <= '9':
-		case c == '_':
-		case c == '-':
-		}
-	}
-
-	return s, nil
-}
-
 func (r *reader) readName() (n *Name, err error) {
 	sum := 0
+	jumps := 0
 	labels := make([]string, 0, 5)
 	rin := r.buf
 	for {
@@ -109,6 +84,10 @@ func (r *reader) readName() (n *Name, err error) {
 				return nil, e
 			}
 			off := ((uint16(n) & 0x3f) << 8) + uint16(c2)
+			jumps++
+			if jumps > 127 {
+				return nil, errPointerLoop
+			}
 			rin = r.seeker
 			rin.Seek(int64(off), 0)
 			continue
@@ -125,17 +104,15 @@ func (r *reader) readName() (n *Name, err error) {
 		if e != nil {
 			return nil, e
 		}
-		s, e := fmtLabel(b)
-		if e != nil {
-			return nil, e
-		}
-		labels = append(labels, s)
+		// any octet is allowed in a label
+		labels = append(labels, string(b))
 	}
 
-	return &Name{labels}, nil
+	return nameFromLabels(labels), nil
 }
 
 func (r *reader) readRR(ret *RR) (err error) {
+	r.rrStart = r.offset()
 	ret.Name, err = r.readName()
 	if err != nil {
 		return
@@ -166,14 +143,32 @@ func (r *reader) readRR(ret *RR) (err error) {
 }
 
 func (r *reader) readRdata(c, t, n uint16) (ret Rdata, e error) {
-	if c == IN {
+	if t == TSIG {
+		ret = new(RdTSIG)
+	} else if c == IN {
 		switch t {
 		default:
 			ret = new(RdBytes)
 		case A:
 			ret = new(RdIP)
-		case CNAME, NS:
+		case CNAME, NS, DNAME, PTR:
 			ret = new(RdName)
+		case SOA:
+			ret = new(RdSOA)
+		case MX:
+			ret = new(RdMX)
+		case DNSKEY:
+			ret = new(RdDNSKEY)
+		case DS:
+			ret = new(RdDS)
+		case RRSIG:
+			ret = new(RdRRSIG)
+		case NSEC:
+			ret = new(RdNSEC)
+		case NSEC3:
+			ret = new(RdNSEC3)
+		case NSEC3PARAM:
+			ret = new(RdNSEC3PARAM)
 		case TXT:
 			ret = new(RdBytes)
 		}
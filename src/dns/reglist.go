@@ -1,3442 +0,0 @@
-package dns
-
-var superNames = map[string]bool{
-	"ar":            true,
-	"au":            true,
-	"bd":            true,
-	"bn":            true,
-	"bt":            true,
-	"ck":            true,
-	"cy":            true,
-	"do":            true,
-	"eg":            true,
-	"er":            true,
-	"et":            true,
-	"fj":            true,
-	"fk":            true,
-	"gt":            true,
-	"gu":            true,
-	"id":            true,
-	"il":            true,
-	"jm":            true,
-	"aichi.jp":      true,
-	"akita.jp":      true,
-	"aomori.jp":     true,
-	"chiba.jp":      true,
-	"ehime.jp":      true,
-	"fukui.jp":      true,
-	"fukuoka.jp":    true,
-	"fukushima.jp":  true,
-	"gifu.jp":       true,
-	"gunma.jp":      true,
-	"hiroshima.jp":  true,
-	"hokkaido.jp":   true,
-	"hyogo.jp":      true,
-	"ibaraki.jp":    true,
-	"ishikawa.jp":   true,
-	"iwate.jp":      true,
-	"kagawa.jp":     true,
-	"kagoshima.jp":  true,
-	"kanagawa.jp":   true,
-	"kawasaki.jp":   true,
-	"kitakyushu.jp": true,
-	"kobe.jp":       true,
-	"kochi.jp":      true,
-	"kumamoto.jp":   true,
-	"kyoto.jp":      true,
-	"mie.jp":        true,
-	"miyagi.jp":     true,
-	"miyazaki.jp":   true,
-	"nagano.jp":     true,
-	"nagasaki.jp":   true,
-	"nagoya.jp":     true,
-	"nara.jp":       true,
-	"niigata.jp":    true,
-	"oita.jp":       true,
-	"okayama.jp":    true,
-	"okinawa.jp":    true,
-	"osaka.jp":      true,
-	"saga.jp":       true,
-	"saitama.jp":    true,
-	"sapporo.jp":    true,
-	"sendai.jp":     true,
-	"shiga.jp":      true,
-	"shimane.jp":    true,
-	"shizuoka.jp":   true,
-	"tochigi.jp":    true,
-	"tokushima.jp":  true,
-	"tokyo.jp":      true,
-	"tottori.jp":    true,
-	"toyama.jp":     true,
-	"wakayama.jp":   true,
-	"yamagata.jp":   true,
-	"yamaguchi.jp":  true,
-	"yamanashi.jp":  true,
-	"yokohama.jp":   true,
-	"ke":            true,
-	"kh":            true,
-	"kw":            true,
-	"ml":            true,
-	"mm":            true,
-	"mt":            true,
-	"mv":            true,
-	"mz":            true,
-	"ni":            true,
-	"np":            true,
-	"nz":            true,
-	"om":            true,
-	"pg":            true,
-	"py":            true,
-	"qa":            true,
-	"sv":            true,
-	"tr":            true,
-	"uk":            true,
-	"sch.uk":        true,
-	"uy":            true,
-	"ve":            true,
-	"ye":            true,
-	"yu":            true,
-	"za":            true,
-	"zm":            true,
-	"zw":            true,
-}
-
-var regNames = map[string]bool{
-	"ac":     true,
-	"com.ac": true,
-	"edu.ac": true,
-	"gov.ac": true,
-	"net.ac": true,
-	"mil.ac": true,
-	"org.ac": true,
-	"ad":     true,
-	"nom.ad": true,
-	"ae":     true,
-	"co.ae":  true,
-	"net.ae": true,
-	"org.ae": true,
-	"sch.ae": true,
-	"ac.ae":  true,
-	"gov.ae": true,
-	"mil.ae": true,
-	"aero":   true,
-	"accident-investigation.aero":         true,
-	"accident-prevention.aero":            true,
-	"aerobatic.aero":                      true,
-	"aeroclub.aero":                       true,
-	"aerodrome.aero":                      true,
-	"agents.aero":                         true,
-	"aircraft.aero":                       true,
-	"airline.aero":                        true,
-	"airport.aero":                        true,
-	"air-surveillance.aero":               true,
-	"airtraffic.aero":                     true,
-	"air-traffic-control.aero":            true,
-	"ambulance.aero":                      true,
-	"amusement.aero":                      true,
-	"association.aero":                    true,
-	"author.aero":                         true,
-	"ballooning.aero":                     true,
-	"broker.aero":                         true,
-	"caa.aero":                            true,
-	"cargo.aero":                          true,
-	"catering.aero":                       true,
-	"certification.aero":                  true,
-	"championship.aero":                   true,
-	"charter.aero":                        true,
-	"civilaviation.aero":                  true,
-	"club.aero":                           true,
-	"conference.aero":                     true,
-	"consultant.aero":                     true,
-	"consulting.aero":                     true,
-	"control.aero":                        true,
-	"council.aero":                        true,
-	"crew.aero":                           true,
-	"design.aero":                         true,
-	"dgca.aero":                           true,
-	"educator.aero":                       true,
-	"emergency.aero":                      true,
-	"engine.aero":                         true,
-	"engineer.aero":                       true,
-	"entertainment.aero":                  true,
-	"equipment.aero":                      true,
-	"exchange.aero":                       true,
-	"express.aero":                        true,
-	"federation.aero":                     true,
-	"flight.aero":                         true,
-	"freight.aero":                        true,
-	"fuel.aero":                           true,
-	"gliding.aero":                        true,
-	"government.aero":                     true,
-	"groundhandling.aero":                 true,
-	"group.aero":                          true,
-	"hanggliding.aero":                    true,
-	"homebuilt.aero":                      true,
-	"insurance.aero":                      true,
-	"journal.aero":                        true,
-	"journalist.aero":                     true,
-	"leasing.aero":                        true,
-	"logistics.aero":                      true,
-	"magazine.aero":                       true,
-	"maintenance.aero":                    true,
-	"marketplace.aero":                    true,
-	"media.aero":                          true,
-	"microlight.aero":                     true,
-	"modelling.aero":                      true,
-	"navigation.aero":                     true,
-	"parachuting.aero":                    true,
-	"paragliding.aero":                    true,
-	"passenger-association.aero":          true,
-	"pilot.aero":                          true,
-	"press.aero":                          true,
-	"production.aero":                     true,
-	"recreation.aero":                     true,
-	"repbody.aero":                        true,
-	"res.aero":                            true,
-	"research.aero":                       true,
-	"rotorcraft.aero":                     true,
-	"safety.aero":                         true,
-	"scientist.aero":                      true,
-	"services.aero":                       true,
-	"show.aero":                           true,
-	"skydiving.aero":                      true,
-	"software.aero":                       true,
-	"student.aero":                        true,
-	"taxi.aero":                           true,
-	"trader.aero":                         true,
-	"trading.aero":                        true,
-	"trainer.aero":                        true,
-	"union.aero":                          true,
-	"workinggroup.aero":                   true,
-	"works.aero":                          true,
-	"af":                                  true,
-	"gov.af":                              true,
-	"com.af":                              true,
-	"org.af":                              true,
-	"net.af":                              true,
-	"edu.af":                              true,
-	"ag":                                  true,
-	"com.ag":                              true,
-	"org.ag":                              true,
-	"net.ag":                              true,
-	"co.ag":                               true,
-	"nom.ag":                              true,
-	"ai":                                  true,
-	"off.ai":                              true,
-	"com.ai":                              true,
-	"net.ai":                              true,
-	"org.ai":                              true,
-	"al":                                  true,
-	"gov.al":                              true,
-	"edu.al":                              true,
-	"org.al":                              true,
-	"com.al":                              true,
-	"net.al":                              true,
-	"am":                                  true,
-	"an":                                  true,
-	"com.an":                              true,
-	"net.an":                              true,
-	"org.an":                              true,
-	"edu.an":                              true,
-	"ao":                                  true,
-	"ed.ao":                               true,
-	"gv.ao":                               true,
-	"og.ao":                               true,
-	"co.ao":                               true,
-	"pb.ao":                               true,
-	"it.ao":                               true,
-	"aq":                                  true,
-	"e164.arpa":                           true,
-	"in-addr.arpa":                        true,
-	"ip6.arpa":                            true,
-	"uri.arpa":                            true,
-	"urn.arpa":                            true,
-	"as":                                  true,
-	"gov.as":                              true,
-	"asia":                                true,
-	"at":                                  true,
-	"gv.at":                               true,
-	"ac.at":                               true,
-	"co.at":                               true,
-	"or.at":                               true,
-	"priv.at":                             true,
-	"act.edu.au":                          true,
-	"nsw.edu.au":                          true,
-	"nt.edu.au":                           true,
-	"qld.edu.au":                          true,
-	"sa.edu.au":                           true,
-	"tas.edu.au":                          true,
-	"vic.edu.au":                          true,
-	"wa.edu.au":                           true,
-	"act.gov.au":                          true,
-	"nsw.gov.au":                          true,
-	"nt.gov.au":                           true,
-	"qld.gov.au":                          true,
-	"sa.gov.au":                           true,
-	"tas.gov.au":                          true,
-	"vic.gov.au":                          true,
-	"wa.gov.au":                           true,
-	"aw":                                  true,
-	"com.aw":                              true,
-	"ax":                                  true,
-	"az":                                  true,
-	"com.az":                              true,
-	"net.az":                              true,
-	"int.az":                              true,
-	"gov.az":                              true,
-	"org.az":                              true,
-	"edu.az":                              true,
-	"info.az":                             true,
-	"pp.az":                               true,
-	"mil.az":                              true,
-	"name.az":                             true,
-	"pro.az":                              true,
-	"biz.az":                              true,
-	"ba":                                  true,
-	"org.ba":                              true,
-	"net.ba":                              true,
-	"edu.ba":                              true,
-	"gov.ba":                              true,
-	"mil.ba":                              true,
-	"unsa.ba":                             true,
-	"unbi.ba":                             true,
-	"co.ba":                               true,
-	"com.ba":                              true,
-	"rs.ba":                               true,
-	"bb":                                  true,
-	"com.bb":                              true,
-	"edu.bb":                              true,
-	"gov.bb":                              true,
-	"net.bb":                              true,
-	"org.bb":                              true,
-	"be":                                  true,
-	"ac.be":                               true,
-	"bf":                                  true,
-	"gov.bf":                              true,
-	"bg":                                  true,
-	"a.bg":                                true,
-	"b.bg":                                true,
-	"c.bg":                                true,
-	"d.bg":                                true,
-	"e.bg":                                true,
-	"f.bg":                                true,
-	"g.bg":                                true,
-	"h.bg":                                true,
-	"i.bg":                                true,
-	"j.bg":                                true,
-	"k.bg":                                true,
-	"l.bg":                                true,
-	"m.bg":                                true,
-	"n.bg":                                true,
-	"o.bg":                                true,
-	"p.bg":                                true,
-	"q.bg":                                true,
-	"r.bg":                                true,
-	"s.bg":                                true,
-	"t.bg":                                true,
-	"u.bg":                                true,
-	"v.bg":                                true,
-	"w.bg":                                true,
-	"x.bg":                                true,
-	"y.bg":                                true,
-	"z.bg":                                true,
-	"0.bg":                                true,
-	"1.bg":                                true,
-	"2.bg":                                true,
-	"3.bg":                                true,
-	"4.bg":                                true,
-	"5.bg":                                true,
-	"6.bg":                                true,
-	"7.bg":                                true,
-	"8.bg":                                true,
-	"9.bg":                                true,
-	"bh":                                  true,
-	"com.bh":                              true,
-	"bi":                                  true,
-	"co.bi":                               true,
-	"com.bi":                              true,
-	"edu.bi":                              true,
-	"or.bi":                               true,
-	"org.bi":                              true,
-	"biz":                                 true,
-	"bj":                                  true,
-	"bm":                                  true,
-	"com.bm":                              true,
-	"edu.bm":                              true,
-	"gov.bm":                              true,
-	"net.bm":                              true,
-	"org.bm":                              true,
-	"bo":                                  true,
-	"com.bo":                              true,
-	"edu.bo":                              true,
-	"gov.bo":                              true,
-	"gob.bo":                              true,
-	"int.bo":                              true,
-	"org.bo":                              true,
-	"net.bo":                              true,
-	"mil.bo":                              true,
-	"tv.bo":                               true,
-	"br":                                  true,
-	"adm.br":                              true,
-	"adv.br":                              true,
-	"agr.br":                              true,
-	"am.br":                               true,
-	"arq.br":                              true,
-	"art.br":                              true,
-	"ato.br":                              true,
-	"bio.br":                              true,
-	"blog.br":                             true,
-	"bmd.br":                              true,
-	"can.br":                              true,
-	"cim.br":                              true,
-	"cng.br":                              true,
-	"cnt.br":                              true,
-	"com.br":                              true,
-	"coop.br":                             true,
-	"ecn.br":                              true,
-	"edu.br":                              true,
-	"eng.br":                              true,
-	"esp.br":                              true,
-	"etc.br":                              true,
-	"eti.br":                              true,
-	"far.br":                              true,
-	"flog.br":                             true,
-	"fm.br":                               true,
-	"fnd.br":                              true,
-	"fot.br":                              true,
-	"fst.br":                              true,
-	"g12.br":                              true,
-	"ggf.br":                              true,
-	"gov.br":                              true,
-	"imb.br":                              true,
-	"ind.br":                              true,
-	"inf.br":                              true,
-	"jor.br":                              true,
-	"jus.br":                              true,
-	"lel.br":                              true,
-	"mat.br":                              true,
-	"med.br":                              true,
-	"mil.br":                              true,
-	"mus.br":                              true,
-	"net.br":                              true,
-	"nom.br":                              true,
-	"not.br":                              true,
-	"ntr.br":                              true,
-	"odo.br":                              true,
-	"org.br":                              true,
-	"ppg.br":                              true,
-	"pro.br":                              true,
-	"psc.br":                              true,
-	"psi.br":                              true,
-	"qsl.br":                              true,
-	"rec.br":                              true,
-	"slg.br":                              true,
-	"srv.br":                              true,
-	"tmp.br":                              true,
-	"trd.br":                              true,
-	"tur.br":                              true,
-	"tv.br":                               true,
-	"vet.br":                              true,
-	"vlog.br":                             true,
-	"wiki.br":                             true,
-	"zlg.br":                              true,
-	"bs":                                  true,
-	"com.bs":                              true,
-	"net.bs":                              true,
-	"org.bs":                              true,
-	"edu.bs":                              true,
-	"gov.bs":                              true,
-	"bw":                                  true,
-	"co.bw":                               true,
-	"org.bw":                              true,
-	"by":                                  true,
-	"gov.by":                              true,
-	"mil.by":                              true,
-	"com.by":                              true,
-	"bz":                                  true,
-	"com.bz":                              true,
-	"net.bz":                              true,
-	"org.bz":                              true,
-	"edu.bz":                              true,
-	"gov.bz":                              true,
-	"ca":                                  true,
-	"ab.ca":                               true,
-	"bc.ca":                               true,
-	"mb.ca":                               true,
-	"nb.ca":                               true,
-	"nf.ca":                               true,
-	"nl.ca":                               true,
-	"ns.ca":                               true,
-	"nt.ca":                               true,
-	"nu.ca":                               true,
-	"on.ca":                               true,
-	"pe.ca":                               true,
-	"qc.ca":                               true,
-	"sk.ca":                               true,
-	"yk.ca":                               true,
-	"gc.ca":                               true,
-	"cat":                                 true,
-	"co.cc":                               true,
-	"cz.cc":                               true,
-	"cc":                                  true,
-	"cd":                                  true,
-	"gov.cd":                              true,
-	"cf":                                  true,
-	"cg":                                  true,
-	"ch":                                  true,
-	"ci":                                  true,
-	"org.ci":                              true,
-	"or.ci":                               true,
-	"com.ci":                              true,
-	"co.ci":                               true,
-	"edu.ci":                              true,
-	"ed.ci":                               true,
-	"ac.ci":                               true,
-	"net.ci":                              true,
-	"go.ci":                               true,
-	"asso.ci":                             true,
-	"int.ci":                              true,
-	"presse.ci":                           true,
-	"md.ci":                               true,
-	"gouv.ci":                             true,
-	"cl":                                  true,
-	"gov.cl":                              true,
-	"gob.cl":                              true,
-	"cm":                                  true,
-	"gov.cm":                              true,
-	"cn":                                  true,
-	"ac.cn":                               true,
-	"com.cn":                              true,
-	"edu.cn":                              true,
-	"gov.cn":                              true,
-	"net.cn":                              true,
-	"org.cn":                              true,
-	"mil.cn":                              true,
-	"ah.cn":                               true,
-	"bj.cn":                               true,
-	"cq.cn":                               true,
-	"fj.cn":                               true,
-	"gd.cn":                               true,
-	"gs.cn":                               true,
-	"gz.cn":                               true,
-	"gx.cn":                               true,
-	"ha.cn":                               true,
-	"hb.cn":                               true,
-	"he.cn":                               true,
-	"hi.cn":                               true,
-	"hl.cn":                               true,
-	"hn.cn":                               true,
-	"jl.cn":                               true,
-	"js.cn":                               true,
-	"jx.cn":                               true,
-	"ln.cn":                               true,
-	"nm.cn":                               true,
-	"nx.cn":                               true,
-	"qh.cn":                               true,
-	"sc.cn":                               true,
-	"sd.cn":                               true,
-	"sh.cn":                               true,
-	"sn.cn":                               true,
-	"sx.cn":                               true,
-	"tj.cn":                               true,
-	"xj.cn":                               true,
-	"xz.cn":                               true,
-	"yn.cn":                               true,
-	"zj.cn":                               true,
-	"hk.cn":                               true,
-	"mo.cn":                               true,
-	"tw.cn":                               true,
-	"co":                                  true,
-	"arts.co":                             true,
-	"com.co":                              true,
-	"edu.co":                              true,
-	"firm.co":                             true,
-	"gov.co":                              true,
-	"info.co":                             true,
-	"int.co":                              true,
-	"mil.co":                              true,
-	"net.co":                              true,
-	"nom.co":                              true,
-	"org.co":                              true,
-	"rec.co":                              true,
-	"web.co":                              true,
-	"com":                                 true,
-	"ar.com":                              true,
-	"br.com":                              true,
-	"cn.com":                              true,
-	"de.com":                              true,
-	"eu.com":                              true,
-	"gb.com":                              true,
-	"hu.com":                              true,
-	"jpn.com":                             true,
-	"kr.com":                              true,
-	"no.com":                              true,
-	"qc.com":                              true,
-	"ru.com":                              true,
-	"sa.com":                              true,
-	"se.com":                              true,
-	"uk.com":                              true,
-	"us.com":                              true,
-	"uy.com":                              true,
-	"za.com":                              true,
-	"coop":                                true,
-	"cr":                                  true,
-	"ac.cr":                               true,
-	"co.cr":                               true,
-	"ed.cr":                               true,
-	"fi.cr":                               true,
-	"go.cr":                               true,
-	"or.cr":                               true,
-	"sa.cr":                               true,
-	"cu":                                  true,
-	"com.cu":                              true,
-	"edu.cu":                              true,
-	"org.cu":                              true,
-	"net.cu":                              true,
-	"gov.cu":                              true,
-	"inf.cu":                              true,
-	"cv":                                  true,
-	"cx":                                  true,
-	"gov.cx":                              true,
-	"cz":                                  true,
-	"de":                                  true,
-	"dj":                                  true,
-	"dk":                                  true,
-	"dm":                                  true,
-	"com.dm":                              true,
-	"net.dm":                              true,
-	"org.dm":                              true,
-	"edu.dm":                              true,
-	"gov.dm":                              true,
-	"dz":                                  true,
-	"com.dz":                              true,
-	"org.dz":                              true,
-	"net.dz":                              true,
-	"gov.dz":                              true,
-	"edu.dz":                              true,
-	"asso.dz":                             true,
-	"pol.dz":                              true,
-	"art.dz":                              true,
-	"ec":                                  true,
-	"com.ec":                              true,
-	"info.ec":                             true,
-	"net.ec":                              true,
-	"fin.ec":                              true,
-	"k12.ec":                              true,
-	"med.ec":                              true,
-	"pro.ec":                              true,
-	"org.ec":                              true,
-	"edu.ec":                              true,
-	"gov.ec":                              true,
-	"mil.ec":                              true,
-	"edu":                                 true,
-	"ee":                                  true,
-	"edu.ee":                              true,
-	"gov.ee":                              true,
-	"riik.ee":                             true,
-	"lib.ee":                              true,
-	"med.ee":                              true,
-	"com.ee":                              true,
-	"pri.ee":                              true,
-	"aip.ee":                              true,
-	"org.ee":                              true,
-	"fie.ee":                              true,
-	"es":                                  true,
-	"com.es":                              true,
-	"nom.es":                              true,
-	"org.es":                              true,
-	"gob.es":                              true,
-	"edu.es":                              true,
-	"eu":                                  true,
-	"fi":                                  true,
-	"aland.fi":                            true,
-	"fm":                                  true,
-	"fo":                                  true,
-	"fr":                                  true,
-	"com.fr":                              true,
-	"asso.fr":                             true,
-	"nom.fr":                              true,
-	"prd.fr":                              true,
-	"presse.fr":                           true,
-	"tm.fr":                               true,
-	"aeroport.fr":                         true,
-	"assedic.fr":                          true,
-	"avocat.fr":                           true,
-	"avoues.fr":                           true,
-	"cci.fr":                              true,
-	"chambagri.fr":                        true,
-	"chirurgiens-dentistes.fr":            true,
-	"experts-comptables.fr":               true,
-	"geometre-expert.fr":                  true,
-	"gouv.fr":                             true,
-	"greta.fr":                            true,
-	"huissier-justice.fr":                 true,
-	"medecin.fr":                          true,
-	"notaires.fr":                         true,
-	"pharmacien.fr":                       true,
-	"port.fr":                             true,
-	"veterinaire.fr":                      true,
-	"ga":                                  true,
-	"gd":                                  true,
-	"ge":                                  true,
-	"com.ge":                              true,
-	"edu.ge":                              true,
-	"gov.ge":                              true,
-	"org.ge":                              true,
-	"mil.ge":                              true,
-	"net.ge":                              true,
-	"pvt.ge":                              true,
-	"gf":                                  true,
-	"gg":                                  true,
-	"co.gg":                               true,
-	"org.gg":                              true,
-	"net.gg":                              true,
-	"sch.gg":                              true,
-	"gov.gg":                              true,
-	"gh":                                  true,
-	"com.gh":                              true,
-	"edu.gh":                              true,
-	"gov.gh":                              true,
-	"org.gh":                              true,
-	"mil.gh":                              true,
-	"gi":                                  true,
-	"com.gi":                              true,
-	"ltd.gi":                              true,
-	"gov.gi":                              true,
-	"mod.gi":                              true,
-	"edu.gi":                              true,
-	"org.gi":                              true,
-	"gl":                                  true,
-	"gm":                                  true,
-	"ac.gn":                               true,
-	"com.gn":                              true,
-	"edu.gn":                              true,
-	"gov.gn":                              true,
-	"org.gn":                              true,
-	"net.gn":                              true,
-	"gov":                                 true,
-	"gp":                                  true,
-	"com.gp":                              true,
-	"net.gp":                              true,
-	"mobi.gp":                             true,
-	"edu.gp":                              true,
-	"org.gp":                              true,
-	"asso.gp":                             true,
-	"gq":                                  true,
-	"gr":                                  true,
-	"com.gr":                              true,
-	"edu.gr":                              true,
-	"net.gr":                              true,
-	"org.gr":                              true,
-	"gov.gr":                              true,
-	"gs":                                  true,
-	"gw":                                  true,
-	"gy":                                  true,
-	"co.gy":                               true,
-	"com.gy":                              true,
-	"net.gy":                              true,
-	"hk":                                  true,
-	"com.hk":                              true,
-	"edu.hk":                              true,
-	"gov.hk":                              true,
-	"idv.hk":                              true,
-	"net.hk":                              true,
-	"org.hk":                              true,
-	"hm":                                  true,
-	"hn":                                  true,
-	"com.hn":                              true,
-	"edu.hn":                              true,
-	"org.hn":                              true,
-	"net.hn":                              true,
-	"mil.hn":                              true,
-	"gob.hn":                              true,
-	"hr":                                  true,
-	"iz.hr":                               true,
-	"from.hr":                             true,
-	"name.hr":                             true,
-	"com.hr":                              true,
-	"ht":                                  true,
-	"com.ht":                              true,
-	"shop.ht":                             true,
-	"firm.ht":                             true,
-	"info.ht":                             true,
-	"adult.ht":                            true,
-	"net.ht":                              true,
-	"pro.ht":                              true,
-	"org.ht":                              true,
-	"med.ht":                              true,
-	"art.ht":                              true,
-	"coop.ht":                             true,
-	"pol.ht":                              true,
-	"asso.ht":                             true,
-	"edu.ht":                              true,
-	"rel.ht":                              true,
-	"gouv.ht":                             true,
-	"perso.ht":                            true,
-	"hu":                                  true,
-	"co.hu":                               true,
-	"info.hu":                             true,
-	"org.hu":                              true,
-	"priv.hu":                             true,
-	"sport.hu":                            true,
-	"tm.hu":                               true,
-	"2000.hu":                             true,
-	"agrar.hu":                            true,
-	"bolt.hu":                             true,
-	"casino.hu":                           true,
-	"city.hu":                             true,
-	"erotica.hu":                          true,
-	"erotika.hu":                          true,
-	"film.hu":                             true,
-	"forum.hu":                            true,
-	"games.hu":                            true,
-	"hotel.hu":                            true,
-	"ingatlan.hu":                         true,
-	"jogasz.hu":                           true,
-	"konyvelo.hu":                         true,
-	"lakas.hu":                            true,
-	"media.hu":                            true,
-	"news.hu":                             true,
-	"reklam.hu":                           true,
-	"sex.hu":                              true,
-	"shop.hu":                             true,
-	"suli.hu":                             true,
-	"szex.hu":                             true,
-	"tozsde.hu":                           true,
-	"utazas.hu":                           true,
-	"video.hu":                            true,
-	"ie":                                  true,
-	"gov.ie":                              true,
-	"im":                                  true,
-	"co.im":                               true,
-	"ltd.co.im":                           true,
-	"plc.co.im":                           true,
-	"net.im":                              true,
-	"gov.im":                              true,
-	"org.im":                              true,
-	"nic.im":                              true,
-	"ac.im":                               true,
-	"in":                                  true,
-	"co.in":                               true,
-	"firm.in":                             true,
-	"net.in":                              true,
-	"org.in":                              true,
-	"gen.in":                              true,
-	"ind.in":                              true,
-	"nic.in":                              true,
-	"ac.in":                               true,
-	"edu.in":                              true,
-	"res.in":                              true,
-	"gov.in":                              true,
-	"mil.in":                              true,
-	"info":                                true,
-	"int":                                 true,
-	"eu.int":                              true,
-	"io":                                  true,
-	"com.io":                              true,
-	"iq":                                  true,
-	"gov.iq":                              true,
-	"edu.iq":                              true,
-	"mil.iq":                              true,
-	"com.iq":                              true,
-	"org.iq":                              true,
-	"net.iq":                              true,
-	"ir":                                  true,
-	"ac.ir":                               true,
-	"co.ir":                               true,
-	"gov.ir":                              true,
-	"id.ir":                               true,
-	"net.ir":                              true,
-	"org.ir":                              true,
-	"sch.ir":                              true,
-	"is":                                  true,
-	"net.is":                              true,
-	"com.is":                              true,
-	"edu.is":                              true,
-	"gov.is":                              true,
-	"org.is":                              true,
-	"int.is":                              true,
-	"it":                                  true,
-	"gov.it":                              true,
-	"edu.it":                              true,
-	"agrigento.it":                        true,
-	"ag.it":                               true,
-	"alessandria.it":                      true,
-	"al.it":                               true,
-	"ancona.it":                           true,
-	"an.it":                               true,
-	"aosta.it":                            true,
-	"aoste.it":                            true,
-	"ao.it":                               true,
-	"arezzo.it":                           true,
-	"ar.it":                               true,
-	"ascoli-piceno.it":                    true,
-	"ascolipiceno.it":                     true,
-	"ap.it":                               true,
-	"asti.it":                             true,
-	"at.it":                               true,
-	"avellino.it":                         true,
-	"av.it":                               true,
-	"bari.it":                             true,
-	"ba.it":                               true,
-	"barlettaandriatrani.it":              true,
-	"barletta-andria-trani.it":            true,
-	"belluno.it":                          true,
-	"bl.it":                               true,
-	"benevento.it":                        true,
-	"bn.it":                               true,
-	"bergamo.it":                          true,
-	"bg.it":                               true,
-	"biella.it":                           true,
-	"bi.it":                               true,
-	"bologna.it":                          true,
-	"bo.it":                               true,
-	"bolzano.it":                          true,
-	"bozen.it":                            true,
-	"balsan.it":                           true,
-	"alto-adige.it":                       true,
-	"altoadige.it":                        true,
-	"suedtirol.it":                        true,
-	"bz.it":                               true,
-	"brescia.it":                          true,
-	"bs.it":                               true,
-	"brindisi.it":                         true,
-	"br.it":                               true,
-	"cagliari.it":                         true,
-	"ca.it":                               true,
-	"caltanissetta.it":                    true,
-	"cl.it":                               true,
-	"campobasso.it":                       true,
-	"cb.it":                               true,
-	"caserta.it":                          true,
-	"ce.it":                               true,
-	"catania.it":                          true,
-	"ct.it":                               true,
-	"catanzaro.it":                        true,
-	"cz.it":                               true,
-	"chieti.it":                           true,
-	"ch.it":                               true,
-	"como.it":                             true,
-	"co.it":                               true,
-	"cosenza.it":                          true,
-	"cs.it":                               true,
-	"cremona.it":                          true,
-	"cr.it":                               true,
-	"crotone.it":                          true,
-	"kr.it":                               true,
-	"cuneo.it":                            true,
-	"cn.it":                               true,
-	"enna.it":                             true,
-	"en.it":                               true,
-	"fermo.it":                            true,
-	"ferrara.it":                          true,
-	"fe.it":                               true,
-	"firenze.it":                          true,
-	"florence.it":                         true,
-	"fi.it":                               true,
-	"foggia.it":                           true,
-	"fg.it":                               true,
-	"forli-cesena.it":                     true,
-	"forlicesena.it":                      true,
-	"fc.it":                               true,
-	"frosinone.it":                        true,
-	"fr.it":                               true,
-	"genova.it":                           true,
-	"genoa.it":                            true,
-	"ge.it":                               true,
-	"gorizia.it":                          true,
-	"go.it":                               true,
-	"grosseto.it":                         true,
-	"gr.it":                               true,
-	"imperia.it":                          true,
-	"im.it":                               true,
-	"isernia.it":                          true,
-	"is.it":                               true,
-	"laquila.it":                          true,
-	"aquila.it":                           true,
-	"aq.it":                               true,
-	"la-spezia.it":                        true,
-	"laspezia.it":                         true,
-	"sp.it":                               true,
-	"latina.it":                           true,
-	"lt.it":                               true,
-	"lecce.it":                            true,
-	"le.it":                               true,
-	"lecco.it":                            true,
-	"lc.it":                               true,
-	"livorno.it":                          true,
-	"li.it":                               true,
-	"lodi.it":                             true,
-	"lo.it":                               true,
-	"lucca.it":                            true,
-	"lu.it":                               true,
-	"macerata.it":                         true,
-	"mc.it":                               true,
-	"mantova.it":                          true,
-	"mn.it":                               true,
-	"massa-carrara.it":                    true,
-	"massacarrara.it":                     true,
-	"ms.it":                               true,
-	"matera.it":                           true,
-	"mt.it":                               true,
-	"messina.it":                          true,
-	"me.it":                               true,
-	"milano.it":                           true,
-	"milan.it":                            true,
-	"mi.it":                               true,
-	"modena.it":                           true,
-	"mo.it":                               true,
-	"monza.it":                            true,
-	"napoli.it":                           true,
-	"naples.it":                           true,
-	"na.it":                               true,
-	"novara.it":                           true,
-	"no.it":                               true,
-	"nuoro.it":                            true,
-	"nu.it":                               true,
-	"oristano.it":                         true,
-	"or.it":                               true,
-	"padova.it":                           true,
-	"padua.it":                            true,
-	"pd.it":                               true,
-	"palermo.it":                          true,
-	"pa.it":                               true,
-	"parma.it":                            true,
-	"pr.it":                               true,
-	"pavia.it":                            true,
-	"pv.it":                               true,
-	"perugia.it":                          true,
-	"pg.it":                               true,
-	"pescara.it":                          true,
-	"pe.it":                               true,
-	"pesaro-urbino.it":                    true,
-	"pesarourbino.it":                     true,
-	"pu.it":                               true,
-	"piacenza.it":                         true,
-	"pc.it":                               true,
-	"pisa.it":                             true,
-	"pi.it":                               true,
-	"pistoia.it":                          true,
-	"pt.it":                               true,
-	"pordenone.it":                        true,
-	"pn.it":                               true,
-	"potenza.it":                          true,
-	"pz.it":                               true,
-	"prato.it":                            true,
-	"po.it":                               true,
-	"ragusa.it":                           true,
-	"rg.it":                               true,
-	"ravenna.it":                          true,
-	"ra.it":                               true,
-	"reggio-calabria.it":                  true,
-	"reggiocalabria.it":                   true,
-	"rc.it":                               true,
-	"reggio-emilia.it":                    true,
-	"reggioemilia.it":                     true,
-	"re.it":                               true,
-	"rieti.it":                            true,
-	"ri.it":                               true,
-	"rimini.it":                           true,
-	"rn.it":                               true,
-	"roma.it":                             true,
-	"rome.it":                             true,
-	"rm.it":                               true,
-	"rovigo.it":                           true,
-	"ro.it":                               true,
-	"salerno.it":                          true,
-	"sa.it":                               true,
-	"sassari.it":                          true,
-	"ss.it":                               true,
-	"savona.it":                           true,
-	"sv.it":                               true,
-	"siena.it":                            true,
-	"si.it":                               true,
-	"siracusa.it":                         true,
-	"sr.it":                               true,
-	"sondrio.it":                          true,
-	"so.it":                               true,
-	"taranto.it":                          true,
-	"ta.it":                               true,
-	"teramo.it":                           true,
-	"te.it":                               true,
-	"terni.it":                            true,
-	"tr.it":                               true,
-	"torino.it":                           true,
-	"turin.it":                            true,
-	"to.it":                               true,
-	"trapani.it":                          true,
-	"tp.it":                               true,
-	"trento.it":                           true,
-	"trentino.it":                         true,
-	"tn.it":                               true,
-	"treviso.it":                          true,
-	"tv.it":                               true,
-	"trieste.it":                          true,
-	"ts.it":                               true,
-	"udine.it":                            true,
-	"ud.it":                               true,
-	"varese.it":                           true,
-	"va.it":                               true,
-	"venezia.it":                          true,
-	"venice.it":                           true,
-	"ve.it":                               true,
-	"verbania.it":                         true,
-	"vb.it":                               true,
-	"vercelli.it":                         true,
-	"vc.it":                               true,
-	"verona.it":                           true,
-	"vr.it":                               true,
-	"vibo-valentia.it":                    true,
-	"vibovalentia.it":                     true,
-	"vv.it":                               true,
-	"vicenza.it":                          true,
-	"vi.it":                               true,
-	"viterbo.it":                          true,
-	"vt.it":                               true,
-	"je":                                  true,
-	"co.je":                               true,
-	"org.je":                              true,
-	"net.je":                              true,
-	"sch.je":                              true,
-	"gov.je":                              true,
-	"jo":                                  true,
-	"com.jo":                              true,
-	"org.jo":                              true,
-	"net.jo":                              true,
-	"edu.jo":                              true,
-	"sch.jo":                              true,
-	"gov.jo":                              true,
-	"mil.jo":                              true,
-	"name.jo":                             true,
-	"jobs":                                true,
-	"jp":                                  true,
-	"ac.jp":                               true,
-	"ad.jp":                               true,
-	"co.jp":                               true,
-	"ed.jp":                               true,
-	"go.jp":                               true,
-	"gr.jp":                               true,
-	"lg.jp":                               true,
-	"ne.jp":                               true,
-	"or.jp":                               true,
-	"kg":                                  true,
-	"org.kg":                              true,
-	"net.kg":                              true,
-	"com.kg":                              true,
-	"edu.kg":                              true,
-	"gov.kg":                              true,
-	"mil.kg":                              true,
-	"ki":                                  true,
-	"edu.ki":                              true,
-	"biz.ki":                              true,
-	"net.ki":                              true,
-	"org.ki":                              true,
-	"gov.ki":                              true,
-	"info.ki":                             true,
-	"com.ki":                              true,
-	"km":                                  true,
-	"org.km":                              true,
-	"nom.km":                              true,
-	"gov.km":                              true,
-	"prd.km":                              true,
-	"tm.km":                               true,
-	"edu.km":                              true,
-	"mil.km":                              true,
-	"ass.km":                              true,
-	"com.km":                              true,
-	"coop.km":                             true,
-	"asso.km":                             true,
-	"presse.km":                           true,
-	"medecin.km":                          true,
-	"notaires.km":                         true,
-	"pharmaciens.km":                      true,
-	"veterinaire.km":                      true,
-	"gouv.km":                             true,
-	"kn":                                  true,
-	"net.kn":                              true,
-	"org.kn":                              true,
-	"edu.kn":                              true,
-	"gov.kn":                              true,
-	"kr":                                  true,
-	"ac.kr":                               true,
-	"co.kr":                               true,
-	"es.kr":                               true,
-	"go.kr":                               true,
-	"hs.kr":                               true,
-	"kg.kr":                               true,
-	"mil.kr":                              true,
-	"ms.kr":                               true,
-	"ne.kr":                               true,
-	"or.kr":                               true,
-	"pe.kr":                               true,
-	"re.kr":                               true,
-	"sc.kr":                               true,
-	"busan.kr":                            true,
-	"chungbuk.kr":                         true,
-	"chungnam.kr":                         true,
-	"daegu.kr":                            true,
-	"daejeon.kr":                          true,
-	"gangwon.kr":                          true,
-	"gwangju.kr":                          true,
-	"gyeongbuk.kr":                        true,
-	"gyeonggi.kr":                         true,
-	"gyeongnam.kr":                        true,
-	"incheon.kr":                          true,
-	"jeju.kr":                             true,
-	"jeonbuk.kr":                          true,
-	"jeonnam.kr":                          true,
-	"seoul.kr":                            true,
-	"ulsan.kr":                            true,
-	"ky":                                  true,
-	"edu.ky":                              true,
-	"gov.ky":                              true,
-	"com.ky":                              true,
-	"org.ky":                              true,
-	"net.ky":                              true,
-	"kz":                                  true,
-	"org.kz":                              true,
-	"edu.kz":                              true,
-	"net.kz":                              true,
-	"gov.kz":                              true,
-	"mil.kz":                              true,
-	"com.kz":                              true,
-	"la":                                  true,
-	"int.la":                              true,
-	"net.la":                              true,
-	"info.la":                             true,
-	"edu.la":                              true,
-	"gov.la":                              true,
-	"per.la":                              true,
-	"com.la":                              true,
-	"org.la":                              true,
-	"c.la":                                true,
-	"com.lb":                              true,
-	"edu.lb":                              true,
-	"gov.lb":                              true,
-	"net.lb":                              true,
-	"org.lb":                              true,
-	"lc":                                  true,
-	"com.lc":                              true,
-	"net.lc":                              true,
-	"co.lc":                               true,
-	"org.lc":                              true,
-	"edu.lc":                              true,
-	"gov.lc":                              true,
-	"li":                                  true,
-	"lk":                                  true,
-	"gov.lk":                              true,
-	"sch.lk":                              true,
-	"net.lk":                              true,
-	"int.lk":                              true,
-	"com.lk":                              true,
-	"org.lk":                              true,
-	"edu.lk":                              true,
-	"ngo.lk":                              true,
-	"soc.lk":                              true,
-	"web.lk":                              true,
-	"ltd.lk":                              true,
-	"assn.lk":                             true,
-	"grp.lk":                              true,
-	"hotel.lk":                            true,
-	"local":                               true,
-	"com.lr":                              true,
-	"edu.lr":                              true,
-	"gov.lr":                              true,
-	"org.lr":                              true,
-	"net.lr":                              true,
-	"ls":                                  true,
-	"co.ls":                               true,
-	"org.ls":                              true,
-	"lt":                                  true,
-	"gov.lt":                              true,
-	"lu":                                  true,
-	"lv":                                  true,
-	"com.lv":                              true,
-	"edu.lv":                              true,
-	"gov.lv":                              true,
-	"org.lv":                              true,
-	"mil.lv":                              true,
-	"id.lv":                               true,
-	"net.lv":                              true,
-	"asn.lv":                              true,
-	"conf.lv":                             true,
-	"ly":                                  true,
-	"com.ly":                              true,
-	"net.ly":                              true,
-	"gov.ly":                              true,
-	"plc.ly":                              true,
-	"edu.ly":                              true,
-	"sch.ly":                              true,
-	"med.ly":                              true,
-	"org.ly":                              true,
-	"id.ly":                               true,
-	"ma":                                  true,
-	"co.ma":                               true,
-	"net.ma":                              true,
-	"gov.ma":                              true,
-	"org.ma":                              true,
-	"ac.ma":                               true,
-	"press.ma":                            true,
-	"mc":                                  true,
-	"tm.mc":                               true,
-	"asso.mc":                             true,
-	"md":                                  true,
-	"me":                                  true,
-	"co.me":                               true,
-	"net.me":                              true,
-	"org.me":                              true,
-	"edu.me":                              true,
-	"ac.me":                               true,
-	"gov.me":                              true,
-	"its.me":                              true,
-	"priv.me":                             true,
-	"mg":                                  true,
-	"org.mg":                              true,
-	"nom.mg":                              true,
-	"gov.mg":                              true,
-	"prd.mg":                              true,
-	"tm.mg":                               true,
-	"edu.mg":                              true,
-	"mil.mg":                              true,
-	"com.mg":                              true,
-	"mh":                                  true,
-	"mil":                                 true,
-	"mk":                                  true,
-	"com.mk":                              true,
-	"org.mk":                              true,
-	"net.mk":                              true,
-	"edu.mk":                              true,
-	"gov.mk":                              true,
-	"inf.mk":                              true,
-	"name.mk":                             true,
-	"mn":                                  true,
-	"gov.mn":                              true,
-	"edu.mn":                              true,
-	"org.mn":                              true,
-	"mo":                                  true,
-	"com.mo":                              true,
-	"net.mo":                              true,
-	"org.mo":                              true,
-	"edu.mo":                              true,
-	"gov.mo":                              true,
-	"mobi":                                true,
-	"mp":                                  true,
-	"mq":                                  true,
-	"mr":                                  true,
-	"gov.mr":                              true,
-	"ms":                                  true,
-	"mu":                                  true,
-	"com.mu":                              true,
-	"net.mu":                              true,
-	"org.mu":                              true,
-	"gov.mu":                              true,
-	"ac.mu":                               true,
-	"co.mu":                               true,
-	"or.mu":                               true,
-	"museum":                              true,
-	"academy.museum":                      true,
-	"agriculture.museum":                  true,
-	"air.museum":                          true,
-	"airguard.museum":                     true,
-	"alabama.museum":                      true,
-	"alaska.museum":                       true,
-	"amber.museum":                        true,
-	"ambulance.museum":                    true,
-	"american.museum":                     true,
-	"americana.museum":                    true,
-	"americanantiques.museum":             true,
-	"americanart.museum":                  true,
-	"amsterdam.museum":                    true,
-	"and.museum":                          true,
-	"annefrank.museum":                    true,
-	"anthro.museum":                       true,
-	"anthropology.museum":                 true,
-	"antiques.museum":                     true,
-	"aquarium.museum":                     true,
-	"arboretum.museum":                    true,
-	"archaeological.museum":               true,
-	"archaeology.museum":                  true,
-	"architecture.museum":                 true,
-	"art.museum":                          true,
-	"artanddesign.museum":                 true,
-	"artcenter.museum":                    true,
-	"artdeco.museum":                      true,
-	"arteducation.museum":                 true,
-	"artgallery.museum":                   true,
-	"arts.museum":                         true,
-	"artsandcrafts.museum":                true,
-	"asmatart.museum":                     true,
-	"assassination.museum":                true,
-	"assisi.museum":                       true,
-	"association.museum":                  true,
-	"astronomy.museum":                    true,
-	"atlanta.museum":                      true,
-	"austin.museum":                       true,
-	"australia.museum":                    true,
-	"automotive.museum":                   true,
-	"aviation.museum":                     true,
-	"axis.museum":                         true,
-	"badajoz.museum":                      true,
-	"baghdad.museum":                      true,
-	"bahn.museum":                         true,
-	"bale.museum":                         true,
-	"baltimore.museum":                    true,
-	"barcelona.museum":                    true,
-	"baseball.museum":                     true,
-	"basel.museum":                        true,
-	"baths.museum":                        true,
-	"bauern.museum":                       true,
-	"beauxarts.museum":                    true,
-	"beeldengeluid.museum":                true,
-	"bellevue.museum":                     true,
-	"bergbau.museum":                      true,
-	"berkeley.museum":                     true,
-	"berlin.museum":                       true,
-	"bern.museum":                         true,
-	"bible.museum":                        true,
-	"bilbao.museum":                       true,
-	"bill.museum":                         true,
-	"birdart.museum":                      true,
-	"birthplace.museum":                   true,
-	"bonn.museum":                         true,
-	"boston.museum":                       true,
-	"botanical.museum":                    true,
-	"botanicalgarden.museum":              true,
-	"botanicgarden.museum":                true,
-	"botany.museum":                       true,
-	"brandywinevalley.museum":             true,
-	"brasil.museum":                       true,
-	"bristol.museum":                      true,
-	"british.museum":                      true,
-	"britishcolumbia.museum":              true,
-	"broadcast.museum":                    true,
-	"brunel.museum":                       true,
-	"brussel.museum":                      true,
-	"brussels.museum":                     true,
-	"bruxelles.museum":                    true,
-	"building.museum":                     true,
-	"burghof.museum":                      true,
-	"bus.museum":                          true,
-	"bushey.museum":                       true,
-	"cadaques.museum":                     true,
-	"california.museum":                   true,
-	"cambridge.museum":                    true,
-	"can.museum":                          true,
-	"canada.museum":                       true,
-	"capebreton.museum":                   true,
-	"carrier.museum":                      true,
-	"cartoonart.museum":                   true,
-	"casadelamoneda.museum":               true,
-	"castle.museum":                       true,
-	"castres.museum":                      true,
-	"celtic.museum":                       true,
-	"center.museum":                       true,
-	"chattanooga.museum":                  true,
-	"cheltenham.museum":                   true,
-	"chesapeakebay.museum":                true,
-	"chicago.museum":                      true,
-	"children.museum":                     true,
-	"childrens.museum":                    true,
-	"childrensgarden.museum":              true,
-	"chiropractic.museum":                 true,
-	"chocolate.museum":                    true,
-	"christiansburg.museum":               true,
-	"cincinnati.museum":                   true,
-	"cinema.museum":                       true,
-	"circus.museum":                       true,
-	"civilisation.museum":                 true,
-	"civilization.museum":                 true,
-	"civilwar.museum":                     true,
-	"clinton.museum":                      true,
-	"clock.museum":                        true,
-	"coal.museum":                         true,
-	"coastaldefence.museum":               true,
-	"cody.museum":                         true,
-	"coldwar.museum":                      true,
-	"collection.museum":                   true,
-	"colonialwilliamsburg.museum":         true,
-	"coloradoplateau.museum":              true,
-	"columbia.museum":                     true,
-	"columbus.museum":                     true,
-	"communication.museum":                true,
-	"communications.museum":               true,
-	"community.museum":                    true,
-	"computer.museum":                     true,
-	"computerhistory.museum":              true,
-	"contemporary.museum":                 true,
-	"contemporaryart.museum":              true,
-	"convent.museum":                      true,
-	"copenhagen.museum":                   true,
-	"corporation.museum":                  true,
-	"corvette.museum":                     true,
-	"costume.museum":                      true,
-	"countryestate.museum":                true,
-	"county.museum":                       true,
-	"crafts.museum":                       true,
-	"cranbrook.museum":                    true,
-	"creation.museum":                     true,
-	"cultural.museum":                     true,
-	"culturalcenter.museum":               true,
-	"culture.museum":                      true,
-	"cyber.museum":                        true,
-	"cymru.museum":                        true,
-	"dali.museum":                         true,
-	"dallas.museum":                       true,
-	"database.museum":                     true,
-	"ddr.museum":                          true,
-	"decorativearts.museum":               true,
-	"delaware.museum":                     true,
-	"delmenhorst.museum":                  true,
-	"denmark.museum":                      true,
-	"depot.museum":                        true,
-	"design.museum":                       true,
-	"detroit.museum":                      true,
-	"dinosaur.museum":                     true,
-	"discovery.museum":                    true,
-	"dolls.museum":                        true,
-	"donostia.museum":                     true,
-	"durham.museum":                       true,
-	"eastafrica.museum":                   true,
-	"eastcoast.museum":                    true,
-	"education.museum":                    true,
-	"educational.museum":                  true,
-	"egyptian.museum":                     true,
-	"eisenbahn.museum":                    true,
-	"elburg.museum":                       true,
-	"elvendrell.museum":                   true,
-	"embroidery.museum":                   true,
-	"encyclopedic.museum":                 true,
-	"england.museum":                      true,
-	"entomology.museum":                   true,
-	"environment.museum":                  true,
-	"environmentalconservation.museum":    true,
-	"epilepsy.museum":                     true,
-	"essex.museum":                        true,
-	"estate.museum":                       true,
-	"ethnology.museum":                    true,
-	"exeter.museum":                       true,
-	"exhibition.museum":                   true,
-	"family.museum":                       true,
-	"farm.museum":                         true,
-	"farmequipment.museum":                true,
-	"farmers.museum":                      true,
-	"farmstead.museum":                    true,
-	"field.museum":                        true,
-	"figueres.museum":                     true,
-	"filatelia.museum":                    true,
-	"film.museum":                         true,
-	"fineart.museum":                      true,
-	"finearts.museum":                     true,
-	"finland.museum":                      true,
-	"flanders.museum":                     true,
-	"florida.museum":                      true,
-	"force.museum":                        true,
-	"fortmissoula.museum":                 true,
-	"fortworth.museum":                    true,
-	"foundation.museum":                   true,
-	"francaise.museum":                    true,
-	"frankfurt.museum":                    true,
-	"franziskaner.museum":                 true,
-	"freemasonry.museum":                  true,
-	"freiburg.museum":                     true,
-	"fribourg.museum":                     true,
-	"frog.museum":                         true,
-	"fundacio.museum":                     true,
-	"furniture.museum":                    true,
-	"gallery.museum":                      true,
-	"garden.museum":                       true,
-	"gateway.museum":                      true,
-	"geelvinck.museum":                    true,
-	"gemological.museum":                  true,
-	"geology.museum":                      true,
-	"georgia.museum":                      true,
-	"giessen.museum":                      true,
-	"glas.museum":                         true,
-	"glass.museum":                        true,
-	"gorge.museum":                        true,
-	"grandrapids.museum":                  true,
-	"graz.museum":                         true,
-	"guernsey.museum":                     true,
-	"halloffame.museum":                   true,
-	"hamburg.museum":                      true,
-	"handson.museum":                      true,
-	"harvestcelebration.museum":           true,
-	"hawaii.museum":                       true,
-	"health.museum":                       true,
-	"heimatunduhren.museum":               true,
-	"hellas.museum":                       true,
-	"helsinki.museum":                     true,
-	"hembygdsforbund.museum":              true,
-	"heritage.museum":                     true,
-	"histoire.museum":                     true,
-	"historical.museum":                   true,
-	"historicalsociety.museum":            true,
-	"historichouses.museum":               true,
-	"historisch.museum":                   true,
-	"historisches.museum":                 true,
-	"history.museum":                      true,
-	"historyofscience.museum":             true,
-	"horology.museum":                     true,
-	"house.museum":                        true,
-	"humanities.museum":                   true,
-	"illustration.museum":                 true,
-	"imageandsound.museum":                true,
-	"indian.museum":                       true,
-	"indiana.museum":                      true,
-	"indianapolis.museum":                 true,
-	"indianmarket.museum":                 true,
-	"intelligence.museum":                 true,
-	"interactive.museum":                  true,
-	"iraq.museum":                         true,
-	"iron.museum":                         true,
-	"isleofman.museum":                    true,
-	"jamison.museum":                      true,
-	"jefferson.museum":                    true,
-	"jerusalem.museum":                    true,
-	"jewelry.museum":                      true,
-	"jewish.museum":                       true,
-	"jewishart.museum":                    true,
-	"jfk.museum":                          true,
-	"journalism.museum":                   true,
-	"judaica.museum":                      true,
-	"judygarland.museum":                  true,
-	"juedisches.museum":                   true,
-	"juif.museum":                         true,
-	"karate.museum":                       true,
-	"karikatur.museum":                    true,
-	"kids.museum":                         true,
-	"koebenhavn.museum":                   true,
-	"koeln.museum":                        true,
-	"kunst.museum":                        true,
-	"kunstsammlung.museum":                true,
-	"kunstunddesign.museum":               true,
-	"labor.museum":                        true,
-	"labour.museum":                       true,
-	"lajolla.museum":                      true,
-	"lancashire.museum":                   true,
-	"landes.museum":                       true,
-	"lans.museum":                         true,
-	"larsson.museum":                      true,
-	"lewismiller.museum":                  true,
-	"lincoln.museum":                      true,
-	"linz.museum":                         true,
-	"living.museum":                       true,
-	"livinghistory.museum":                true,
-	"localhistory.museum":                 true,
-	"london.museum":                       true,
-	"losangeles.museum":                   true,
-	"louvre.museum":                       true,
-	"loyalist.museum":                     true,
-	"lucerne.museum":                      true,
-	"luxembourg.museum":                   true,
-	"luzern.museum":                       true,
-	"mad.museum":                          true,
-	"madrid.museum":                       true,
-	"mallorca.museum":                     true,
-	"manchester.museum":                   true,
-	"mansion.museum":                      true,
-	"mansions.museum":                     true,
-	"manx.museum":                         true,
-	"marburg.museum":                      true,
-	"maritime.museum":                     true,
-	"maritimo.museum":                     true,
-	"maryland.museum":                     true,
-	"marylhurst.museum":                   true,
-	"media.museum":                        true,
-	"medical.museum":                      true,
-	"medizinhistorisches.museum":          true,
-	"meeres.museum":                       true,
-	"memorial.museum":                     true,
-	"mesaverde.museum":                    true,
-	"michigan.museum":                     true,
-	"midatlantic.museum":                  true,
-	"military.museum":                     true,
-	"mill.museum":                         true,
-	"miners.museum":                       true,
-	"mining.museum":                       true,
-	"minnesota.museum":                    true,
-	"missile.museum":                      true,
-	"missoula.museum":                     true,
-	"modern.museum":                       true,
-	"moma.museum":                         true,
-	"money.museum":                        true,
-	"monmouth.museum":                     true,
-	"monticello.museum":                   true,
-	"montreal.museum":                     true,
-	"moscow.museum":                       true,
-	"motorcycle.museum":                   true,
-	"muenchen.museum":                     true,
-	"muenster.museum":                     true,
-	"mulhouse.museum":                     true,
-	"muncie.museum":                       true,
-	"museet.museum":                       true,
-	"museumcenter.museum":                 true,
-	"museumvereniging.museum":             true,
-	"music.museum":                        true,
-	"national.museum":                     true,
-	"nationalfirearms.museum":             true,
-	"nationalheritage.museum":             true,
-	"nativeamerican.museum":               true,
-	"naturalhistory.museum":               true,
-	"naturalhistorymuseum.museum":         true,
-	"naturalsciences.museum":              true,
-	"nature.museum":                       true,
-	"naturhistorisches.museum":            true,
-	"natuurwetenschappen.museum":          true,
-	"naumburg.museum":                     true,
-	"naval.museum":                        true,
-	"nebraska.museum":                     true,
-	"neues.museum":                        true,
-	"newhampshire.museum":                 true,
-	"newjersey.museum":                    true,
-	"newmexico.museum":                    true,
-	"newport.museum":                      true,
-	"newspaper.museum":                    true,
-	"newyork.museum":                      true,
-	"niepce.museum":                       true,
-	"norfolk.museum":                      true,
-	"north.museum":                        true,
-	"nrw.museum":                          true,
-	"nuernberg.museum":                    true,
-	"nuremberg.museum":                    true,
-	"nyc.museum":                          true,
-	"nyny.museum":                         true,
-	"oceanographic.museum":                true,
-	"oceanographique.museum":              true,
-	"omaha.museum":                        true,
-	"online.museum":                       true,
-	"ontario.museum":                      true,
-	"openair.museum":                      true,
-	"oregon.museum":                       true,
-	"oregontrail.museum":                  true,
-	"otago.museum":                        true,
-	"oxford.museum":                       true,
-	"pacific.museum":                      true,
-	"paderborn.museum":                    true,
-	"palace.museum":                       true,
-	"paleo.museum":                        true,
-	"palmsprings.museum":                  true,
-	"panama.museum":                       true,
-	"paris.museum":                        true,
-	"pasadena.museum":                     true,
-	"pharmacy.museum":                     true,
-	"philadelphia.museum":                 true,
-	"philadelphiaarea.museum":             true,
-	"philately.museum":                    true,
-	"phoenix.museum":                      true,
-	"photography.museum":                  true,
-	"pilots.museum":                       true,
-	"pittsburgh.museum":                   true,
-	"planetarium.museum":                  true,
-	"plantation.museum":                   true,
-	"plants.museum":                       true,
-	"plaza.museum":                        true,
-	"portal.museum":                       true,
-	"portland.museum":                     true,
-	"portlligat.museum":                   true,
-	"posts-and-telecommunications.museum": true,
-	"preservation.museum":                 true,
-	"presidio.museum":                     true,
-	"press.museum":                        true,
-	"project.museum":                      true,
-	"public.museum":                       true,
-	"pubol.museum":                        true,
-	"quebec.museum":                       true,
-	"railroad.museum":                     true,
-	"railway.museum":                      true,
-	"research.museum":                     true,
-	"resistance.museum":                   true,
-	"riodejaneiro.museum":                 true,
-	"rochester.museum":                    true,
-	"rockart.museum":                      true,
-	"roma.museum":                         true,
-	"russia.museum":                       true,
-	"saintlouis.museum":                   true,
-	"salem.museum":                        true,
-	"salvadordali.museum":                 true,
-	"salzburg.museum":                     true,
-	"sandiego.museum":                     true,
-	"sanfrancisco.museum":                 true,
-	"santabarbara.museum":                 true,
-	"santacruz.museum":                    true,
-	"santafe.museum":                      true,
-	"saskatchewan.museum":                 true,
-	"satx.museum":                         true,
-	"savannahga.museum":                   true,
-	"schlesisches.museum":                 true,
-	"schoenbrunn.museum":                  true,
-	"schokoladen.museum":                  true,
-	"school.museum":                       true,
-	"schweiz.museum":                      true,
-	"science.museum":                      true,
-	"scienceandhistory.museum":            true,
-	"scienceandindustry.museum":           true,
-	"sciencecenter.museum":                true,
-	"sciencecenters.museum":               true,
-	"science-fiction.museum":              true,
-	"sciencehistory.museum":               true,
-	"sciences.museum":                     true,
-	"sciencesnaturelles.museum":           true,
-	"scotland.museum":                     true,
-	"seaport.museum":                      true,
-	"settlement.museum":                   true,
-	"settlers.museum":                     true,
-	"shell.museum":                        true,
-	"sherbrooke.museum":                   true,
-	"sibenik.museum":                      true,
-	"silk.museum":                         true,
-	"ski.museum":                          true,
-	"skole.museum":                        true,
-	"society.museum":                      true,
-	"sologne.museum":                      true,
-	"soundandvision.museum":               true,
-	"southcarolina.museum":                true,
-	"southwest.museum":                    true,
-	"space.museum":                        true,
-	"spy.museum":                          true,
-	"square.museum":                       true,
-	"stadt.museum":                        true,
-	"stalbans.museum":                     true,
-	"starnberg.museum":                    true,
-	"state.museum":                        true,
-	"stateofdelaware.museum":              true,
-	"station.museum":                      true,
-	"steam.museum":                        true,
-	"steiermark.museum":                   true,
-	"stjohn.museum":                       true,
-	"stockholm.museum":                    true,
-	"stpetersburg.museum":                 true,
-	"stuttgart.museum":                    true,
-	"suisse.museum":                       true,
-	"surgeonshall.museum":                 true,
-	"surrey.museum":                       true,
-	"svizzera.museum":                     true,
-	"sweden.museum":                       true,
-	"sydney.museum":                       true,
-	"tank.museum":                         true,
-	"tcm.museum":                          true,
-	"technology.museum":                   true,
-	"telekommunikation.museum":            true,
-	"television.museum":                   true,
-	"texas.museum":                        true,
-	"textile.museum":                      true,
-	"theater.museum":                      true,
-	"time.museum":                         true,
-	"timekeeping.museum":                  true,
-	"topology.museum":                     true,
-	"torino.museum":                       true,
-	"touch.museum":                        true,
-	"town.museum":                         true,
-	"transport.museum":                    true,
-	"tree.museum":                         true,
-	"trolley.museum":                      true,
-	"trust.museum":                        true,
-	"trustee.museum":                      true,
-	"uhren.museum":                        true,
-	"ulm.museum":                          true,
-	"undersea.museum":                     true,
-	"university.museum":                   true,
-	"usa.museum":                          true,
-	"usantiques.museum":                   true,
-	"usarts.museum":                       true,
-	"uscountryestate.museum":              true,
-	"usculture.museum":                    true,
-	"usdecorativearts.museum":             true,
-	"usgarden.museum":                     true,
-	"ushistory.museum":                    true,
-	"ushuaia.museum":                      true,
-	"uslivinghistory.museum":              true,
-	"utah.museum":                         true,
-	"uvic.museum":                         true,
-	"valley.museum":                       true,
-	"vantaa.museum":                       true,
-	"versailles.museum":                   true,
-	"viking.museum":                       true,
-	"village.museum":                      true,
-	"virginia.museum":                     true,
-	"virtual.museum":                      true,
-	"virtuel.museum":                      true,
-	"vlaanderen.museum":                   true,
-	"volkenkunde.museum":                  true,
-	"wales.museum":                        true,
-	"wallonie.museum":                     true,
-	"war.museum":                          true,
-	"washingtondc.museum":                 true,
-	"watchandclock.museum":                true,
-	"watch-and-clock.museum":              true,
-	"western.museum":                      true,
-	"westfalen.museum":                    true,
-	"whaling.museum":                      true,
-	"wildlife.museum":                     true,
-	"williamsburg.museum":                 true,
-	"windmill.museum":                     true,
-	"workshop.museum":                     true,
-	"york.museum":                         true,
-	"yorkshire.museum":                    true,
-	"yosemite.museum":                     true,
-	"youth.museum":                        true,
-	"zoological.museum":                   true,
-	"zoology.museum":                      true,
-	"mw":                                  true,
-	"ac.mw":                               true,
-	"biz.mw":                              true,
-	"co.mw":                               true,
-	"com.mw":                              true,
-	"coop.mw":                             true,
-	"edu.mw":                              true,
-	"gov.mw":                              true,
-	"int.mw":                              true,
-	"net.mw":                              true,
-	"org.mw":                              true,
-	"mx":                                  true,
-	"com.mx":                              true,
-	"org.mx":                              true,
-	"gob.mx":                              true,
-	"edu.mx":                              true,
-	"net.mx":                              true,
-	"my":                                  true,
-	"com.my":                              true,
-	"net.my":                              true,
-	"org.my":                              true,
-	"gov.my":                              true,
-	"edu.my":                              true,
-	"mil.my":                              true,
-	"name.my":                             true,
-	"na":                                  true,
-	"info.na":                             true,
-	"pro.na":                              true,
-	"name.na":                             true,
-	"school.na":                           true,
-	"or.na":                               true,
-	"dr.na":                               true,
-	"us.na":                               true,
-	"mx.na":                               true,
-	"ca.na":                               true,
-	"in.na":                               true,
-	"cc.na":                               true,
-	"tv.na":                               true,
-	"ws.na":                               true,
-	"mobi.na":                             true,
-	"co.na":                               true,
-	"com.na":                              true,
-	"org.na":                              true,
-	"name":                                true,
-	"nc":                                  true,
-	"ne":                                  true,
-	"net":                                 true,
-	"gb.net":                              true,
-	"se.net":                              true,
-	"uk.net":                              true,
-	"za.net":                              true,
-	"nf":                                  true,
-	"com.nf":                              true,
-	"net.nf":                              true,
-	"per.nf":                              true,
-	"rec.nf":                              true,
-	"web.nf":                              true,
-	"arts.nf":                             true,
-	"firm.nf":                             true,
-	"info.nf":                             true,
-	"other.nf":                            true,
-	"store.nf":                            true,
-	"ac.ng":                               true,
-	"com.ng":                              true,
-	"edu.ng":                              true,
-	"gov.ng":                              true,
-	"net.ng":                              true,
-	"org.ng":                              true,
-	"nl":                                  true,
-	"no":                                  true,
-	"fhs.no":                              true,
-	"vgs.no":                              true,
-	"fylkesbibl.no":                       true,
-	"folkebibl.no":                        true,
-	"museum.no":                           true,
-	"idrett.no":                           true,
-	"priv.no":                             true,
-	"mil.no":                              true,
-	"stat.no":                             true,
-	"dep.no":                              true,
-	"kommune.no":                          true,
-	"herad.no":                            true,
-	"aa.no":                               true,
-	"ah.no":                               true,
-	"bu.no":                               true,
-	"fm.no":                               true,
-	"hl.no":                               true,
-	"hm.no":                               true,
-	"jan-mayen.no":                        true,
-	"mr.no":                               true,
-	"nl.no":                               true,
-	"nt.no":                               true,
-	"of.no":                               true,
-	"ol.no":                               true,
-	"oslo.no":                             true,
-	"rl.no":                               true,
-	"sf.no":                               true,
-	"st.no":                               true,
-	"svalbard.no":                         true,
-	"tm.no":                               true,
-	"tr.no":                               true,
-	"va.no":                               true,
-	"vf.no":                               true,
-	"gs.aa.no":                            true,
-	"gs.ah.no":                            true,
-	"gs.bu.no":                            true,
-	"gs.fm.no":                            true,
-	"gs.hl.no":                            true,
-	"gs.hm.no":                            true,
-	"gs.jan-mayen.no":                     true,
-	"gs.mr.no":                            true,
-	"gs.nl.no":                            true,
-	"gs.nt.no":                            true,
-	"gs.of.no":                            true,
-	"gs.ol.no":                            true,
-	"gs.oslo.no":                          true,
-	"gs.rl.no":                            true,
-	"gs.sf.no":                            true,
-	"gs.st.no":                            true,
-	"gs.svalbard.no":                      true,
-	"gs.tm.no":                            true,
-	"gs.tr.no":                            true,
-	"gs.va.no":                            true,
-	"gs.vf.no":                            true,
-	"akrehamn.no":                         true,
-	"algard.no":                           true,
-	"arna.no":                             true,
-	"brumunddal.no":                       true,
-	"bryne.no":                            true,
-	"bronnoysund.no":                      true,
-	"drobak.no":                           true,
-	"egersund.no":                         true,
-	"fetsund.no":                          true,
-	"floro.no":                            true,
-	"fredrikstad.no":                      true,
-	"hokksund.no":                         true,
-	"honefoss.no":                         true,
-	"jessheim.no":                         true,
-	"jorpeland.no":                        true,
-	"kirkenes.no":                         true,
-	"kopervik.no":                         true,
-	"krokstadelva.no":                     true,
-	"langevag.no":                         true,
-	"leirvik.no":                          true,
-	"mjondalen.no":                        true,
-	"mo-i-rana.no":                        true,
-	"mosjoen.no":                          true,
-	"nesoddtangen.no":                     true,
-	"orkanger.no":                         true,
-	"osoyro.no":                           true,
-	"raholt.no":                           true,
-	"sandnessjoen.no":                     true,
-	"skedsmokorset.no":                    true,
-	"slattum.no":                          true,
-	"spjelkavik.no":                       true,
-	"stathelle.no":                        true,
-	"stavern.no":                          true,
-	"stjordalshalsen.no":                  true,
-	"tananger.no":                         true,
-	"tranby.no":                           true,
-	"vossevangen.no":                      true,
-	"afjord.no":                           true,
-	"agdenes.no":                          true,
-	"al.no":                               true,
-	"alesund.no":                          true,
-	"alstahaug.no":                        true,
-	"alta.no":                             true,
-	"alaheadju.no":                        true,
-	"alvdal.no":                           true,
-	"amli.no":                             true,
-	"amot.no":                             true,
-	"andebu.no":                           true,
-	"andoy.no":                            true,
-	"andasuolo.no":                        true,
-	"ardal.no":                            true,
-	"aremark.no":                          true,
-	"arendal.no":                          true,
-	"aseral.no":                           true,
-	"asker.no":                            true,
-	"askim.no":                            true,
-	"askvoll.no":                          true,
-	"askoy.no":                            true,
-	"asnes.no":                            true,
-	"audnedaln.no":                        true,
-	"aukra.no":                            true,
-	"aure.no":                             true,
-	"aurland.no":                          true,
-	"aurskog-holand.no":                   true,
-	"austevoll.no":                        true,
-	"austrheim.no":                        true,
-	"averoy.no":                           true,
-	"balestrand.no":                       true,
-	"ballangen.no":                        true,
-	"balat.no":                            true,
-	"balsfjord.no":                        true,
-	"bahccavuotna.no":                     true,
-	"bamble.no":                           true,
-	"bardu.no":                            true,
-	"beardu.no":                           true,
-	"beiarn.no":                           true,
-	"bajddar.no":                          true,
-	"baidar.no":                           true,
-	"berg.no":                             true,
-	"bergen.no":                           true,
-	"berlevag.no":                         true,
-	"bearalvahki.no":                      true,
-	"bindal.no":                           true,
-	"birkenes.no":                         true,
-	"bjarkoy.no":                          true,
-	"bjerkreim.no":                        true,
-	"bjugn.no":                            true,
-	"bodo.no":                             true,
-	"badaddja.no":                         true,
-	"budejju.no":                          true,
-	"bokn.no":                             true,
-	"bremanger.no":                        true,
-	"bronnoy.no":                          true,
-	"bygland.no":                          true,
-	"bykle.no":                            true,
-	"barum.no":                            true,
-	"bo.telemark.no":                      true,
-	"bo.nordland.no":                      true,
-	"bievat.no":                           true,
-	"bomlo.no":                            true,
-	"batsfjord.no":                        true,
-	"bahcavuotna.no":                      true,
-	"dovre.no":                            true,
-	"drammen.no":                          true,
-	"drangedal.no":                        true,
-	"dyroy.no":                            true,
-	"donna.no":                            true,
-	"eid.no":                              true,
-	"eidfjord.no":                         true,
-	"eidsberg.no":                         true,
-	"eidskog.no":                          true,
-	"eidsvoll.no":                         true,
-	"eigersund.no":                        true,
-	"elverum.no":                          true,
-	"enebakk.no":                          true,
-	"engerdal.no":                         true,
-	"etne.no":                             true,
-	"etnedal.no":                          true,
-	"evenes.no":                           true,
-	"evenassi.no":                         true,
-	"evje-og-hornnes.no":                  true,
-	"farsund.no":                          true,
-	"fauske.no":                           true,
-	"fuossko.no":                          true,
-	"fuoisku.no":                          true,
-	"fedje.no":                            true,
-	"fet.no":                              true,
-	"finnoy.no":                           true,
-	"fitjar.no":                           true,
-	"fjaler.no":                           true,
-	"fjell.no":                            true,
-	"flakstad.no":                         true,
-	"flatanger.no":                        true,
-	"flekkefjord.no":                      true,
-	"flesberg.no":                         true,
-	"flora.no":                            true,
-	"fla.no":                              true,
-	"folldal.no":                          true,
-	"forsand.no":                          true,
-	"fosnes.no":                           true,
-	"frei.no":                             true,
-	"frogn.no":                            true,
-	"froland.no":                          true,
-	"frosta.no":                           true,
-	"frana.no":                            true,
-	"froya.no":                            true,
-	"fusa.no":                             true,
-	"fyresdal.no":                         true,
-	"forde.no":                            true,
-	"gamvik.no":                           true,
-	"gangaviika.no":                       true,
-	"gaular.no":                           true,
-	"gausdal.no":                          true,
-	"gildeskal.no":                        true,
-	"giske.no":                            true,
-	"gjemnes.no":                          true,
-	"gjerdrum.no":                         true,
-	"gjerstad.no":                         true,
-	"gjesdal.no":                          true,
-	"gjovik.no":                           true,
-	"gloppen.no":                          true,
-	"gol.no":                              true,
-	"gran.no":                             true,
-	"grane.no":                            true,
-	"granvin.no":                          true,
-	"gratangen.no":                        true,
-	"grimstad.no":                         true,
-	"grong.no":                            true,
-	"kraanghke.no":                        true,
-	"grue.no":                             true,
-	"gulen.no":                            true,
-	"hadsel.no":                           true,
-	"halden.no":                           true,
-	"halsa.no":                            true,
-	"hamar.no":                            true,
-	"hamaroy.no":                          true,
-	"habmer.no":                           true,
-	"hapmir.no":                           true,
-	"hammerfest.no":                       true,
-	"hammarfeasta.no":                     true,
-	"haram.no":                            true,
-	"hareid.no":                           true,
-	"harstad.no":                          true,
-	"hasvik.no":                           true,
-	"aknoluokta.no":                       true,
-	"hattfjelldal.no":                     true,
-	"aarborte.no":                         true,
-	"haugesund.no":                        true,
-	"hemne.no":                            true,
-	"hemnes.no":                           true,
-	"hemsedal.no":                         true,
-	"heroy.more-og-romsdal.no":            true,
-	"heroy.nordland.no":                   true,
-	"hitra.no":                            true,
-	"hjartdal.no":                         true,
-	"hjelmeland.no":                       true,
-	"hobol.no":                            true,
-	"hof.no":                              true,
-	"hol.no":                              true,
-	"hole.no":                             true,
-	"holmestrand.no":                      true,
-	"holtalen.no":                         true,
-	"hornindal.no":                        true,
-	"horten.no":                           true,
-	"hurdal.no":                           true,
-	"hurum.no":                            true,
-	"hvaler.no":                           true,
-	"hyllestad.no":                        true,
-	"hagebostad.no":                       true,
-	"hoyanger.no":                         true,
-	"hoylandet.no":                        true,
-	"ha.no":                               true,
-	"ibestad.no":                          true,
-	"inderoy.no":                          true,
-	"iveland.no":                          true,
-	"jevnaker.no":                         true,
-	"jondal.no":                           true,
-	"jolster.no":                          true,
-	"karasjok.no":                         true,
-	"karasjohka.no":                       true,
-	"karlsoy.no":                          true,
-	"galsa.no":                            true,
-	"karmoy.no":                           true,
-	"kautokeino.no":                       true,
-	"guovdageaidnu.no":                    true,
-	"klepp.no":                            true,
-	"klabu.no":                            true,
-	"kongsberg.no":                        true,
-	"kongsvinger.no":                      true,
-	"kragero.no":                          true,
-	"kristiansand.no":                     true,
-	"kristiansund.no":                     true,
-	"krodsherad.no":                       true,
-	"kvalsund.no":                         true,
-	"rahkkeravju.no":                      true,
-	"kvam.no":                             true,
-	"kvinesdal.no":                        true,
-	"kvinnherad.no":                       true,
-	"kviteseid.no":                        true,
-	"kvitsoy.no":                          true,
-	"kvafjord.no":                         true,
-	"giehtavuoatna.no":                    true,
-	"kvanangen.no":                        true,
-	"navuotna.no":                         true,
-	"kafjord.no":                          true,
-	"gaivuotna.no":                        true,
-	"larvik.no":                           true,
-	"lavangen.no":                         true,
-	"lavagis.no":                          true,
-	"loabat.no":                           true,
-	"lebesby.no":                          true,
-	"davvesiida.no":                       true,
-	"leikanger.no":                        true,
-	"leirfjord.no":                        true,
-	"leka.no":                             true,
-	"leksvik.no":                          true,
-	"lenvik.no":                           true,
-	"leangaviika.no":                      true,
-	"lesja.no":                            true,
-	"levanger.no":                         true,
-	"lier.no":                             true,
-	"lierne.no":                           true,
-	"lillehammer.no":                      true,
-	"lillesand.no":                        true,
-	"lindesnes.no":                        true,
-	"lindas.no":                           true,
-	"lom.no":                              true,
-	"loppa.no":                            true,
-	"lahppi.no":                           true,
-	"lund.no":                             true,
-	"lunner.no":                           true,
-	"luroy.no":                            true,
-	"luster.no":                           true,
-	"lyngdal.no":                          true,
-	"lyngen.no":                           true,
-	"ivgu.no":                             true,
-	"lardal.no":                           true,
-	"lerdal.no":                           true,
-	"lodingen.no":                         true,
-	"lorenskog.no":                        true,
-	"loten.no":                            true,
-	"malvik.no":                           true,
-	"masoy.no":                            true,
-	"muosat.no":                           true,
-	"mandal.no":                           true,
-	"marker.no":                           true,
-	"marnardal.no":                        true,
-	"masfjorden.no":                       true,
-	"meland.no":                           true,
-	"meldal.no":                           true,
-	"melhus.no":                           true,
-	"meloy.no":                            true,
-	"meraker.no":                          true,
-	"moareke.no":                          true,
-	"midsund.no":                          true,
-	"midtre-gauldal.no":                   true,
-	"modalen.no":                          true,
-	"modum.no":                            true,
-	"molde.no":                            true,
-	"moskenes.no":                         true,
-	"moss.no":                             true,
-	"mosvik.no":                           true,
-	"malselv.no":                          true,
-	"malatvuopmi.no":                      true,
-	"namdalseid.no":                       true,
-	"aejrie.no":                           true,
-	"namsos.no":                           true,
-	"namsskogan.no":                       true,
-	"naamesjevuemie.no":                   true,
-	"laakesvuemie.no":                     true,
-	"nannestad.no":                        true,
-	"narvik.no":                           true,
-	"narviika.no":                         true,
-	"naustdal.no":                         true,
-	"nedre-eiker.no":                      true,
-	"nes.akershus.no":                     true,
-	"nes.buskerud.no":                     true,
-	"nesna.no":                            true,
-	"nesodden.no":                         true,
-	"nesseby.no":                          true,
-	"unjarga.no":                          true,
-	"nesset.no":                           true,
-	"nissedal.no":                         true,
-	"nittedal.no":                         true,
-	"nord-aurdal.no":                      true,
-	"nord-fron.no":                        true,
-	"nord-odal.no":                        true,
-	"norddal.no":                          true,
-	"nordkapp.no":                         true,
-	"davvenjarga.no":                      true,
-	"nordre-land.no":                      true,
-	"nordreisa.no":                        true,
-	"raisa.no":                            true,
-	"nore-og-uvdal.no":                    true,
-	"notodden.no":                         true,
-	"naroy.no":                            true,
-	"notteroy.no":                         true,
-	"odda.no":                             true,
-	"oksnes.no":                           true,
-	"oppdal.no":                           true,
-	"oppegard.no":                         true,
-	"orkdal.no":                           true,
-	"orland.no":                           true,
-	"orskog.no":                           true,
-	"orsta.no":                            true,
-	"os.hedmark.no":                       true,
-	"os.hordaland.no":                     true,
-	"osen.no":                             true,
-	"osteroy.no":                          true,
-	"ostre-toten.no":                      true,
-	"overhalla.no":                        true,
-	"ovre-eiker.no":                       true,
-	"oyer.no":                             true,
-	"oygarden.no":                         true,
-	"oystre-slidre.no":                    true,
-	"porsanger.no":                        true,
-	"porsangu.no":                         true,
-	"porsgrunn.no":                        true,
-	"radoy.no":                            true,
-	"rakkestad.no":                        true,
-	"rana.no":                             true,
-	"ruovat.no":                           true,
-	"randaberg.no":                        true,
-	"rauma.no":                            true,
-	"rendalen.no":                         true,
-	"rennebu.no":                          true,
-	"rennesoy.no":                         true,
-	"rindal.no":                           true,
-	"ringebu.no":                          true,
-	"ringerike.no":                        true,
-	"ringsaker.no":                        true,
-	"rissa.no":                            true,
-	"risor.no":                            true,
-	"roan.no":                             true,
-	"rollag.no":                           true,
-	"rygge.no":                            true,
-	"ralingen.no":                         true,
-	"rodoy.no":                            true,
-	"romskog.no":                          true,
-	"roros.no":                            true,
-	"rost.no":                             true,
-	"royken.no":                           true,
-	"royrvik.no":                          true,
-	"rade.no":                             true,
-	"salangen.no":                         true,
-	"siellak.no":                          true,
-	"saltdal.no":                          true,
-	"salat.no":                            true,
-	"samnanger.no":                        true,
-	"sande.more-og-romsdal.no":            true,
-	"sande.vestfold.no":                   true,
-	"sandefjord.no":                       true,
-	"sandnes.no":                          true,
-	"sandoy.no":                           true,
-	"sarpsborg.no":                        true,
-	"sauda.no":                            true,
-	"sauherad.no":                         true,
-	"sel.no":                              true,
-	"selbu.no":                            true,
-	"selje.no":                            true,
-	"seljord.no":                          true,
-	"sigdal.no":                           true,
-	"siljan.no":                           true,
-	"sirdal.no":                           true,
-	"skaun.no":                            true,
-	"skedsmo.no":                          true,
-	"ski.no":                              true,
-	"skien.no":                            true,
-	"skiptvet.no":                         true,
-	"skjervoy.no":                         true,
-	"skierva.no":                          true,
-	"skjak.no":                            true,
-	"skodje.no":                           true,
-	"skanland.no":                         true,
-	"skanit.no":                           true,
-	"smola.no":                            true,
-	"snillfjord.no":                       true,
-	"snasa.no":                            true,
-	"snoasa.no":                           true,
-	"snaase.no":                           true,
-	"sogndal.no":                          true,
-	"sokndal.no":                          true,
-	"sola.no":                             true,
-	"solund.no":                           true,
-	"songdalen.no":                        true,
-	"sortland.no":                         true,
-	"spydeberg.no":                        true,
-	"stange.no":                           true,
-	"stavanger.no":                        true,
-	"steigen.no":                          true,
-	"steinkjer.no":                        true,
-	"stjordal.no":                         true,
-	"stokke.no":                           true,
-	"stor-elvdal.no":                      true,
-	"stord.no":                            true,
-	"stordal.no":                          true,
-	"storfjord.no":                        true,
-	"omasvuotna.no":                       true,
-	"strand.no":                           true,
-	"stranda.no":                          true,
-	"stryn.no":                            true,
-	"sula.no":                             true,
-	"suldal.no":                           true,
-	"sund.no":                             true,
-	"sunndal.no":                          true,
-	"surnadal.no":                         true,
-	"sveio.no":                            true,
-	"svelvik.no":                          true,
-	"sykkylven.no":                        true,
-	"sogne.no":                            true,
-	"somna.no":                            true,
-	"sondre-land.no":                      true,
-	"sor-aurdal.no":                       true,
-	"sor-fron.no":                         true,
-	"sor-odal.no":                         true,
-	"sor-varanger.no":                     true,
-	"matta-varjjat.no":                    true,
-	"sorfold.no":                          true,
-	"sorreisa.no":                         true,
-	"sorum.no":                            true,
-	"tana.no":                             true,
-	"deatnu.no":                           true,
-	"time.no":                             true,
-	"tingvoll.no":                         true,
-	"tinn.no":                             true,
-	"tjeldsund.no":                        true,
-	"dielddanuorri.no":                    true,
-	"tjome.no":                            true,
-	"tokke.no":                            true,
-	"tolga.no":                            true,
-	"torsken.no":                          true,
-	"tranoy.no":                           true,
-	"tromso.no":                           true,
-	"tromsa.no":                           true,
-	"romsa.no":                            true,
-	"trondheim.no":                        true,
-	"troandin.no":                         true,
-	"trysil.no":                           true,
-	"trana.no":                            true,
-	"trogstad.no":                         true,
-	"tvedestrand.no":                      true,
-	"tydal.no":                            true,
-	"tynset.no":                           true,
-	"tysfjord.no":                         true,
-	"divtasvuodna.no":                     true,
-	"divttasvuotna.no":                    true,
-	"tysnes.no":                           true,
-	"tysvar.no":                           true,
-	"tonsberg.no":                         true,
-	"ullensaker.no":                       true,
-	"ullensvang.no":                       true,
-	"ulvik.no":                            true,
-	"utsira.no":                           true,
-	"vadso.no":                            true,
-	"cahcesuolo.no":                       true,
-	"vaksdal.no":                          true,
-	"valle.no":                            true,
-	"vang.no":                             true,
-	"vanylven.no":                         true,
-	"vardo.no":                            true,
-	"varggat.no":                          true,
-	"vefsn.no":                            true,
-	"vaapste.no":                          true,
-	"vega.no":                             true,
-	"vegarshei.no":                        true,
-	"vennesla.no":                         true,
-	"verdal.no":                           true,
-	"verran.no":                           true,
-	"vestby.no":                           true,
-	"vestnes.no":                          true,
-	"vestre-slidre.no":                    true,
-	"vestre-toten.no":                     true,
-	"vestvagoy.no":                        true,
-	"vevelstad.no":                        true,
-	"vik.no":                              true,
-	"vikna.no":                            true,
-	"vindafjord.no":                       true,
-	"volda.no":                            true,
-	"voss.no":                             true,
-	"varoy.no":                            true,
-	"vagan.no":                            true,
-	"voagat.no":                           true,
-	"vagsoy.no":                           true,
-	"vaga.no":                             true,
-	"valer.ostfold.no":                    true,
-	"valer.hedmark.no":                    true,
-	"nr":                                  true,
-	"biz.nr":                              true,
-	"info.nr":                             true,
-	"gov.nr":                              true,
-	"edu.nr":                              true,
-	"org.nr":                              true,
-	"net.nr":                              true,
-	"com.nr":                              true,
-	"nu":                                  true,
-	"org":                                 true,
-	"ae.org":                              true,
-	"za.org":                              true,
-	"pa":                                  true,
-	"ac.pa":                               true,
-	"gob.pa":                              true,
-	"com.pa":                              true,
-	"org.pa":                              true,
-	"sld.pa":                              true,
-	"edu.pa":                              true,
-	"net.pa":                              true,
-	"ing.pa":                              true,
-	"abo.pa":                              true,
-	"med.pa":                              true,
-	"nom.pa":                              true,
-	"pe":                                  true,
-	"edu.pe":                              true,
-	"gob.pe":                              true,
-	"nom.pe":                              true,
-	"mil.pe":                              true,
-	"org.pe":                              true,
-	"com.pe":                              true,
-	"net.pe":                              true,
-	"pf":                                  true,
-	"com.pf":                              true,
-	"org.pf":                              true,
-	"edu.pf":                              true,
-	"ph":                                  true,
-	"com.ph":                              true,
-	"net.ph":                              true,
-	"org.ph":                              true,
-	"gov.ph":                              true,
-	"edu.ph":                              true,
-	"ngo.ph":                              true,
-	"mil.ph":                              true,
-	"i.ph":                                true,
-	"pk":                                  true,
-	"com.pk":                              true,
-	"net.pk":                              true,
-	"edu.pk":                              true,
-	"org.pk":                              true,
-	"fam.pk":                              true,
-	"biz.pk":                              true,
-	"web.pk":                              true,
-	"gov.pk":                              true,
-	"gob.pk":                              true,
-	"gok.pk":                              true,
-	"gon.pk":                              true,
-	"gop.pk":                              true,
-	"gos.pk":                              true,
-	"info.pk":                             true,
-	"pl":                                  true,
-	"aid.pl":                              true,
-	"agro.pl":                             true,
-	"atm.pl":                              true,
-	"auto.pl":                             true,
-	"biz.pl":                              true,
-	"com.pl":                              true,
-	"edu.pl":                              true,
-	"gmina.pl":                            true,
-	"gsm.pl":                              true,
-	"info.pl":                             true,
-	"mail.pl":                             true,
-	"miasta.pl":                           true,
-	"media.pl":                            true,
-	"mil.pl":                              true,
-	"net.pl":                              true,
-	"nieruchomosci.pl":                    true,
-	"nom.pl":                              true,
-	"org.pl":                              true,
-	"pc.pl":                               true,
-	"powiat.pl":                           true,
-	"priv.pl":                             true,
-	"realestate.pl":                       true,
-	"rel.pl":                              true,
-	"sex.pl":                              true,
-	"shop.pl":                             true,
-	"sklep.pl":                            true,
-	"sos.pl":                              true,
-	"szkola.pl":                           true,
-	"targi.pl":                            true,
-	"tm.pl":                               true,
-	"tourism.pl":                          true,
-	"travel.pl":                           true,
-	"turystyka.pl":                        true,
-	"6bone.pl":                            true,
-	"art.pl":                              true,
-	"mbone.pl":                            true,
-	"gov.pl":                              true,
-	"uw.gov.pl":                           true,
-	"um.gov.pl":                           true,
-	"ug.gov.pl":                           true,
-	"upow.gov.pl":                         true,
-	"starostwo.gov.pl":                    true,
-	"so.gov.pl":                           true,
-	"sr.gov.pl":                           true,
-	"po.gov.pl":                           true,
-	"pa.gov.pl":                           true,
-	"med.pl":                              true,
-	"ngo.pl":                              true,
-	"irc.pl":                              true,
-	"usenet.pl":                           true,
-	"augustow.pl":                         true,
-	"babia-gora.pl":                       true,
-	"bedzin.pl":                           true,
-	"beskidy.pl":                          true,
-	"bialowieza.pl":                       true,
-	"bialystok.pl":                        true,
-	"bielawa.pl":                          true,
-	"bieszczady.pl":                       true,
-	"boleslawiec.pl":                      true,
-	"bydgoszcz.pl":                        true,
-	"bytom.pl":                            true,
-	"cieszyn.pl":                          true,
-	"czeladz.pl":                          true,
-	"czest.pl":                            true,
-	"dlugoleka.pl":                        true,
-	"elblag.pl":                           true,
-	"elk.pl":                              true,
-	"glogow.pl":                           true,
-	"gniezno.pl":                          true,
-	"gorlice.pl":                          true,
-	"grajewo.pl":                          true,
-	"ilawa.pl":                            true,
-	"jaworzno.pl":                         true,
-	"jelenia-gora.pl":                     true,
-	"jgora.pl":                            true,
-	"kalisz.pl":                           true,
-	"kazimierz-dolny.pl":                  true,
-	"karpacz.pl":                          true,
-	"kartuzy.pl":                          true,
-	"kaszuby.pl":                          true,
-	"katowice.pl":                         true,
-	"kepno.pl":                            true,
-	"ketrzyn.pl":                          true,
-	"klodzko.pl":                          true,
-	"kobierzyce.pl":                       true,
-	"kolobrzeg.pl":                        true,
-	"konin.pl":                            true,
-	"konskowola.pl":                       true,
-	"kutno.pl":                            true,
-	"lapy.pl":                             true,
-	"lebork.pl":                           true,
-	"legnica.pl":                          true,
-	"lezajsk.pl":                          true,
-	"limanowa.pl":                         true,
-	"lomza.pl":                            true,
-	"lowicz.pl":                           true,
-	"lubin.pl":                            true,
-	"lukow.pl":                            true,
-	"malbork.pl":                          true,
-	"malopolska.pl":                       true,
-	"mazowsze.pl":                         true,
-	"mazury.pl":                           true,
-	"mielec.pl":                           true,
-	"mielno.pl":                           true,
-	"mragowo.pl":                          true,
-	"naklo.pl":                            true,
-	"nowaruda.pl":                         true,
-	"nysa.pl":                             true,
-	"olawa.pl":                            true,
-	"olecko.pl":                           true,
-	"olkusz.pl":                           true,
-	"olsztyn.pl":                          true,
-	"opoczno.pl":                          true,
-	"opole.pl":                            true,
-	"ostroda.pl":                          true,
-	"ostroleka.pl":                        true,
-	"ostrowiec.pl":                        true,
-	"ostrowwlkp.pl":                       true,
-	"pila.pl":                             true,
-	"pisz.pl":                             true,
-	"podhale.pl":                          true,
-	"podlasie.pl":                         true,
-	"polkowice.pl":                        true,
-	"pomorze.pl":                          true,
-	"pomorskie.pl":                        true,
-	"prochowice.pl":                       true,
-	"pruszkow.pl":                         true,
-	"przeworsk.pl":                        true,
-	"pulawy.pl":                           true,
-	"radom.pl":                            true,
-	"rawa-maz.pl":                         true,
-	"rybnik.pl":                           true,
-	"rzeszow.pl":                          true,
-	"sanok.pl":                            true,
-	"sejny.pl":                            true,
-	"slask.pl":                            true,
-	"slupsk.pl":                           true,
-	"sosnowiec.pl":                        true,
-	"stalowa-wola.pl":                     true,
-	"skoczow.pl":                          true,
-	"starachowice.pl":                     true,
-	"stargard.pl":                         true,
-	"suwalki.pl":                          true,
-	"swidnica.pl":                         true,
-	"swiebodzin.pl":                       true,
-	"swinoujscie.pl":                      true,
-	"szczecin.pl":                         true,
-	"szczytno.pl":                         true,
-	"tarnobrzeg.pl":                       true,
-	"tgory.pl":                            true,
-	"turek.pl":                            true,
-	"tychy.pl":                            true,
-	"ustka.pl":                            true,
-	"walbrzych.pl":                        true,
-	"warmia.pl":                           true,
-	"warszawa.pl":                         true,
-	"waw.pl":                              true,
-	"wegrow.pl":                           true,
-	"wielun.pl":                           true,
-	"wlocl.pl":                            true,
-	"wloclawek.pl":                        true,
-	"wodzislaw.pl":                        true,
-	"wolomin.pl":                          true,
-	"wroclaw.pl":                          true,
-	"zachpomor.pl":                        true,
-	"zagan.pl":                            true,
-	"zarow.pl":                            true,
-	"zgora.pl":                            true,
-	"zgorzelec.pl":                        true,
-	"gda.pl":                              true,
-	"gdansk.pl":                           true,
-	"gdynia.pl":                           true,
-	"sopot.pl":                            true,
-	"gliwice.pl":                          true,
-	"krakow.pl":                           true,
-	"poznan.pl":                           true,
-	"wroc.pl":                             true,
-	"zakopane.pl":                         true,
-	"pn":                                  true,
-	"gov.pn":                              true,
-	"co.pn":                               true,
-	"org.pn":                              true,
-	"edu.pn":                              true,
-	"net.pn":                              true,
-	"pr":                                  true,
-	"com.pr":                              true,
-	"net.pr":                              true,
-	"org.pr":                              true,
-	"gov.pr":                              true,
-	"edu.pr":                              true,
-	"isla.pr":                             true,
-	"pro.pr":                              true,
-	"biz.pr":                              true,
-	"info.pr":                             true,
-	"name.pr":                             true,
-	"est.pr":                              true,
-	"prof.pr":                             true,
-	"ac.pr":                               true,
-	"pro":                                 true,
-	"aca.pro":                             true,
-	"bar.pro":                             true,
-	"cpa.pro":                             true,
-	"jur.pro":                             true,
-	"law.pro":                             true,
-	"med.pro":                             true,
-	"eng.pro":                             true,
-	"ps":                                  true,
-	"edu.ps":                              true,
-	"gov.ps":                              true,
-	"sec.ps":                              true,
-	"plo.ps":                              true,
-	"com.ps":                              true,
-	"org.ps":                              true,
-	"net.ps":                              true,
-	"pt":                                  true,
-	"net.pt":                              true,
-	"gov.pt":                              true,
-	"org.pt":                              true,
-	"edu.pt":                              true,
-	"int.pt":                              true,
-	"publ.pt":                             true,
-	"com.pt":                              true,
-	"nome.pt":                             true,
-	"pw":                                  true,
-	"co.pw":                               true,
-	"ne.pw":                               true,
-	"or.pw":                               true,
-	"ed.pw":                               true,
-	"go.pw":                               true,
-	"belau.pw":                            true,
-	"re":                                  true,
-	"com.re":                              true,
-	"asso.re":                             true,
-	"nom.re":                              true,
-	"ro":                                  true,
-	"com.ro":                              true,
-	"org.ro":                              true,
-	"tm.ro":                               true,
-	"nt.ro":                               true,
-	"nom.ro":                              true,
-	"info.ro":                             true,
-	"rec.ro":                              true,
-	"arts.ro":                             true,
-	"firm.ro":                             true,
-	"store.ro":                            true,
-	"www.ro":                              true,
-	"rs":                                  true,
-	"co.rs":                               true,
-	"org.rs":                              true,
-	"edu.rs":                              true,
-	"ac.rs":                               true,
-	"gov.rs":                              true,
-	"in.rs":                               true,
-	"ru":                                  true,
-	"ac.ru":                               true,
-	"com.ru":                              true,
-	"edu.ru":                              true,
-	"int.ru":                              true,
-	"net.ru":                              true,
-	"org.ru":                              true,
-	"pp.ru":                               true,
-	"adygeya.ru":                          true,
-	"altai.ru":                            true,
-	"amur.ru":                             true,
-	"arkhangelsk.ru":                      true,
-	"astrakhan.ru":                        true,
-	"bashkiria.ru":                        true,
-	"belgorod.ru":                         true,
-	"bir.ru":                              true,
-	"bryansk.ru":                          true,
-	"buryatia.ru":                         true,
-	"cbg.ru":                              true,
-	"chel.ru":                             true,
-	"chelyabinsk.ru":                      true,
-	"chita.ru":                            true,
-	"chukotka.ru":                         true,
-	"chuvashia.ru":                        true,
-	"dagestan.ru":                         true,
-	"dudinka.ru":                          true,
-	"e-burg.ru":                           true,
-	"grozny.ru":                           true,
-	"irkutsk.ru":                          true,
-	"ivanovo.ru":                          true,
-	"izhevsk.ru":                          true,
-	"jar.ru":                              true,
-	"joshkar-ola.ru":                      true,
-	"kalmykia.ru":                         true,
-	"kaluga.ru":                           true,
-	"kamchatka.ru":                        true,
-	"karelia.ru":                          true,
-	"kazan.ru":                            true,
-	"kchr.ru":                             true,
-	"kemerovo.ru":                         true,
-	"khabarovsk.ru":                       true,
-	"khakassia.ru":                        true,
-	"khv.ru":                              true,
-	"kirov.ru":                            true,
-	"koenig.ru":                           true,
-	"komi.ru":                             true,
-	"kostroma.ru":                         true,
-	"krasnoyarsk.ru":                      true,
-	"kuban.ru":                            true,
-	"kurgan.ru":                           true,
-	"kursk.ru":                            true,
-	"lipetsk.ru":                          true,
-	"magadan.ru":                          true,
-	"mari.ru":                             true,
-	"mari-el.ru":                          true,
-	"marine.ru":                           true,
-	"mordovia.ru":                         true,
-	"mosreg.ru":                           true,
-	"msk.ru":                              true,
-	"murmansk.ru":                         true,
-	"nalchik.ru":                          true,
-	"nnov.ru":                             true,
-	"nov.ru":                              true,
-	"novosibirsk.ru":                      true,
-	"nsk.ru":                              true,
-	"omsk.ru":                             true,
-	"orenburg.ru":                         true,
-	"oryol.ru":                            true,
-	"palana.ru":                           true,
-	"penza.ru":                            true,
-	"perm.ru":                             true,
-	"pskov.ru":                            true,
-	"ptz.ru":                              true,
-	"rnd.ru":                              true,
-	"ryazan.ru":                           true,
-	"sakhalin.ru":                         true,
-	"samara.ru":                           true,
-	"saratov.ru":                          true,
-	"simbirsk.ru":                         true,
-	"smolensk.ru":                         true,
-	"spb.ru":                              true,
-	"stavropol.ru":                        true,
-	"stv.ru":                              true,
-	"surgut.ru":                           true,
-	"tambov.ru":                           true,
-	"tatarstan.ru":                        true,
-	"tom.ru":                              true,
-	"tomsk.ru":                            true,
-	"tsaritsyn.ru":                        true,
-	"tsk.ru":                              true,
-	"tula.ru":                             true,
-	"tuva.ru":                             true,
-	"tver.ru":                             true,
-	"tyumen.ru":                           true,
-	"udm.ru":                              true,
-	"udmurtia.ru":                         true,
-	"ulan-ude.ru":                         true,
-	"vladikavkaz.ru":                      true,
-	"vladimir.ru":                         true,
-	"vladivostok.ru":                      true,
-	"volgograd.ru":                        true,
-	"vologda.ru":                          true,
-	"voronezh.ru":                         true,
-	"vrn.ru":                              true,
-	"vyatka.ru":                           true,
-	"yakutia.ru":                          true,
-	"yamal.ru":                            true,
-	"yaroslavl.ru":                        true,
-	"yekaterinburg.ru":                    true,
-	"yuzhno-sakhalinsk.ru":                true,
-	"amursk.ru":                           true,
-	"baikal.ru":                           true,
-	"cmw.ru":                              true,
-	"fareast.ru":                          true,
-	"jamal.ru":                            true,
-	"kms.ru":                              true,
-	"k-uralsk.ru":                         true,
-	"kustanai.ru":                         true,
-	"kuzbass.ru":                          true,
-	"magnitka.ru":                         true,
-	"mytis.ru":                            true,
-	"nakhodka.ru":                         true,
-	"nkz.ru":                              true,
-	"norilsk.ru":                          true,
-	"oskol.ru":                            true,
-	"pyatigorsk.ru":                       true,
-	"rubtsovsk.ru":                        true,
-	"snz.ru":                              true,
-	"syzran.ru":                           true,
-	"vdonsk.ru":                           true,
-	"zgrad.ru":                            true,
-	"gov.ru":                              true,
-	"mil.ru":                              true,
-	"test.ru":                             true,
-	"rw":                                  true,
-	"gov.rw":                              true,
-	"net.rw":                              true,
-	"edu.rw":                              true,
-	"ac.rw":                               true,
-	"com.rw":                              true,
-	"co.rw":                               true,
-	"int.rw":                              true,
-	"mil.rw":                              true,
-	"gouv.rw":                             true,
-	"com.sa":                              true,
-	"net.sa":                              true,
-	"org.sa":                              true,
-	"gov.sa":                              true,
-	"med.sa":                              true,
-	"pub.sa":                              true,
-	"edu.sa":                              true,
-	"sch.sa":                              true,
-	"sb":                                  true,
-	"com.sb":                              true,
-	"edu.sb":                              true,
-	"gov.sb":                              true,
-	"net.sb":                              true,
-	"org.sb":                              true,
-	"sc":                                  true,
-	"com.sc":                              true,
-	"gov.sc":                              true,
-	"net.sc":                              true,
-	"org.sc":                              true,
-	"edu.sc":                              true,
-	"sd":                                  true,
-	"com.sd":                              true,
-	"net.sd":                              true,
-	"org.sd":                              true,
-	"edu.sd":                              true,
-	"med.sd":                              true,
-	"gov.sd":                              true,
-	"info.sd":                             true,
-	"se":                                  true,
-	"a.se":                                true,
-	"ac.se":                               true,
-	"b.se":                                true,
-	"bd.se":                               true,
-	"brand.se":                            true,
-	"c.se":                                true,
-	"d.se":                                true,
-	"e.se":                                true,
-	"f.se":                                true,
-	"fh.se":                               true,
-	"fhsk.se":                             true,
-	"fhv.se":                              true,
-	"g.se":                                true,
-	"h.se":                                true,
-	"i.se":                                true,
-	"k.se":                                true,
-	"komforb.se":                          true,
-	"kommunalforbund.se":                  true,
-	"komvux.se":                           true,
-	"l.se":                                true,
-	"lanbib.se":                           true,
-	"m.se":                                true,
-	"n.se":                                true,
-	"naturbruksgymn.se":                   true,
-	"o.se":                                true,
-	"org.se":                              true,
-	"p.se":                                true,
-	"parti.se":                            true,
-	"pp.se":                               true,
-	"press.se":                            true,
-	"r.se":                                true,
-	"s.se":                                true,
-	"sshn.se":                             true,
-	"t.se":                                true,
-	"tm.se":                               true,
-	"u.se":                                true,
-	"w.se":                                true,
-	"x.se":                                true,
-	"y.se":                                true,
-	"z.se":                                true,
-	"sg":                                  true,
-	"com.sg":                              true,
-	"net.sg":                              true,
-	"org.sg":                              true,
-	"gov.sg":                              true,
-	"edu.sg":                              true,
-	"per.sg":                              true,
-	"sh":                                  true,
-	"si":                                  true,
-	"sk":                                  true,
-	"sl":                                  true,
-	"com.sl":                              true,
-	"net.sl":                              true,
-	"edu.sl":                              true,
-	"gov.sl":                              true,
-	"org.sl":                              true,
-	"sm":                                  true,
-	"sn":                                  true,
-	"sr":                                  true,
-	"st":                                  true,
-	"gov.st":                              true,
-	"saotome.st":                          true,
-	"principe.st":                         true,
-	"consulado.st":                        true,
-	"org.st":                              true,
-	"edu.st":                              true,
-	"net.st":                              true,
-	"com.st":                              true,
-	"store.st":                            true,
-	"mil.st":                              true,
-	"co.st":                               true,
-	"su":                                  true,
-	"sy":                                  true,
-	"edu.sy":                              true,
-	"gov.sy":                              true,
-	"net.sy":                              true,
-	"mil.sy":                              true,
-	"com.sy":                              true,
-	"org.sy":                              true,
-	"sz":                                  true,
-	"co.sz":                               true,
-	"ac.sz":                               true,
-	"org.sz":                              true,
-	"tc":                                  true,
-	"td":                                  true,
-	"tel":                                 true,
-	"tf":                                  true,
-	"tg":                                  true,
-	"th":                                  true,
-	"ac.th":                               true,
-	"co.th":                               true,
-	"go.th":                               true,
-	"in.th":                               true,
-	"mi.th":                               true,
-	"net.th":                              true,
-	"or.th":                               true,
-	"tj":                                  true,
-	"ac.tj":                               true,
-	"biz.tj":                              true,
-	"com.tj":                              true,
-	"co.tj":                               true,
-	"edu.tj":                              true,
-	"int.tj":                              true,
-	"name.tj":                             true,
-	"net.tj":                              true,
-	"org.tj":                              true,
-	"web.tj":                              true,
-	"gov.tj":                              true,
-	"go.tj":                               true,
-	"mil.tj":                              true,
-	"tk":                                  true,
-	"tl":                                  true,
-	"gov.tl":                              true,
-	"tm":                                  true,
-	"tn":                                  true,
-	"com.tn":                              true,
-	"ens.tn":                              true,
-	"fin.tn":                              true,
-	"gov.tn":                              true,
-	"ind.tn":                              true,
-	"intl.tn":                             true,
-	"nat.tn":                              true,
-	"net.tn":                              true,
-	"org.tn":                              true,
-	"info.tn":                             true,
-	"perso.tn":                            true,
-	"tourism.tn":                          true,
-	"edunet.tn":                           true,
-	"rnrt.tn":                             true,
-	"rns.tn":                              true,
-	"rnu.tn":                              true,
-	"mincom.tn":                           true,
-	"agrinet.tn":                          true,
-	"defense.tn":                          true,
-	"turen.tn":                            true,
-	"to":                                  true,
-	"com.to":                              true,
-	"gov.to":                              true,
-	"net.to":                              true,
-	"org.to":                              true,
-	"edu.to":                              true,
-	"mil.to":                              true,
-	"travel":                              true,
-	"tt":                                  true,
-	"co.tt":                               true,
-	"com.tt":                              true,
-	"org.tt":                              true,
-	"net.tt":                              true,
-	"biz.tt":                              true,
-	"info.tt":                             true,
-	"pro.tt":                              true,
-	"int.tt":                              true,
-	"coop.tt":                             true,
-	"jobs.tt":                             true,
-	"mobi.tt":                             true,
-	"travel.tt":                           true,
-	"museum.tt":                           true,
-	"aero.tt":                             true,
-	"name.tt":                             true,
-	"gov.tt":                              true,
-	"edu.tt":                              true,
-	"tv":                                  true,
-	"com.tv":                              true,
-	"net.tv":                              true,
-	"org.tv":                              true,
-	"gov.tv":                              true,
-	"tw":                                  true,
-	"edu.tw":                              true,
-	"gov.tw":                              true,
-	"mil.tw":                              true,
-	"com.tw":                              true,
-	"net.tw":                              true,
-	"org.tw":                              true,
-	"idv.tw":                              true,
-	"game.tw":                             true,
-	"ebiz.tw":                             true,
-	"club.tw":                             true,
-	"ac.tz":                               true,
-	"co.tz":                               true,
-	"go.tz":                               true,
-	"ne.tz":                               true,
-	"or.tz":                               true,
-	"ua":                                  true,
-	"com.ua":                              true,
-	"edu.ua":                              true,
-	"gov.ua":                              true,
-	"in.ua":                               true,
-	"net.ua":                              true,
-	"org.ua":                              true,
-	"cherkassy.ua":                        true,
-	"chernigov.ua":                        true,
-	"chernovtsy.ua":                       true,
-	"ck.ua":                               true,
-	"cn.ua":                               true,
-	"crimea.ua":                           true,
-	"cv.ua":                               true,
-	"dn.ua":                               true,
-	"dnepropetrovsk.ua":                   true,
-	"donetsk.ua":                          true,
-	"dp.ua":                               true,
-	"if.ua":                               true,
-	"ivano-frankivsk.ua":                  true,
-	"kh.ua":                               true,
-	"kharkov.ua":                          true,
-	"kherson.ua":                          true,
-	"khmelnitskiy.ua":                     true,
-	"kiev.ua":                             true,
-	"kirovograd.ua":                       true,
-	"km.ua":                               true,
-	"kr.ua":                               true,
-	"ks.ua":                               true,
-	"kv.ua":                               true,
-	"lg.ua":                               true,
-	"lugansk.ua":                          true,
-	"lutsk.ua":                            true,
-	"lviv.ua":                             true,
-	"mk.ua":                               true,
-	"nikolaev.ua":                         true,
-	"od.ua":                               true,
-	"odessa.ua":                           true,
-	"pl.ua":                               true,
-	"poltava.ua":                          true,
-	"rovno.ua":                            true,
-	"rv.ua":                               true,
-	"sebastopol.ua":                       true,
-	"sumy.ua":                             true,
-	"te.ua":                               true,
-	"ternopil.ua":                         true,
-	"uzhgorod.ua":                         true,
-	"vinnica.ua":                          true,
-	"vn.ua":                               true,
-	"zaporizhzhe.ua":                      true,
-	"zp.ua":                               true,
-	"zhitomir.ua":                         true,
-	"zt.ua":                               true,
-	"ug":                                  true,
-	"co.ug":                               true,
-	"ac.ug":                               true,
-	"sc.ug":                               true,
-	"go.ug":                               true,
-	"ne.ug":                               true,
-	"or.ug":                               true,
-	"us":                                  true,
-	"dni.us":                              true,
-	"fed.us":                              true,
-	"isa.us":                              true,
-	"kids.us":                             true,
-	"nsn.us":                              true,
-	"ak.us":                               true,
-	"al.us":                               true,
-	"ar.us":                               true,
-	"as.us":                               true,
-	"az.us":                               true,
-	"ca.us":                               true,
-	"co.us":                               true,
-	"ct.us":                               true,
-	"dc.us":                               true,
-	"de.us":                               true,
-	"fl.us":                               true,
-	"ga.us":                               true,
-	"gu.us":                               true,
-	"hi.us":                               true,
-	"ia.us":                               true,
-	"id.us":                               true,
-	"il.us":                               true,
-	"in.us":                               true,
-	"ks.us":                               true,
-	"ky.us":                               true,
-	"la.us":                               true,
-	"ma.us":                               true,
-	"md.us":                               true,
-	"me.us":                               true,
-	"mi.us":                               true,
-	"mn.us":                               true,
-	"mo.us":                               true,
-	"ms.us":                               true,
-	"mt.us":                               true,
-	"nc.us":                               true,
-	"nd.us":                               true,
-	"ne.us":                               true,
-	"nh.us":                               true,
-	"nj.us":                               true,
-	"nm.us":                               true,
-	"nv.us":                               true,
-	"ny.us":                               true,
-	"oh.us":                               true,
-	"ok.us":                               true,
-	"or.us":                               true,
-	"pa.us":                               true,
-	"pr.us":                               true,
-	"ri.us":                               true,
-	"sc.us":                               true,
-	"sd.us":                               true,
-	"tn.us":                               true,
-	"tx.us":                               true,
-	"ut.us":                               true,
-	"vi.us":                               true,
-	"vt.us":                               true,
-	"va.us":                               true,
-	"wa.us":                               true,
-	"wi.us":                               true,
-	"wv.us":                               true,
-	"wy.us":                               true,
-	"uz":                                  true,
-	"com.uz":                              true,
-	"co.uz":                               true,
-	"va":                                  true,
-	"vc":                                  true,
-	"com.vc":                              true,
-	"net.vc":                              true,
-	"org.vc":                              true,
-	"gov.vc":                              true,
-	"mil.vc":                              true,
-	"edu.vc":                              true,
-	"vg":                                  true,
-	"vi":                                  true,
-	"co.vi":                               true,
-	"com.vi":                              true,
-	"k12.vi":                              true,
-	"net.vi":                              true,
-	"org.vi":                              true,
-	"vn":                                  true,
-	"com.vn":                              true,
-	"net.vn":                              true,
-	"org.vn":                              true,
-	"edu.vn":                              true,
-	"gov.vn":                              true,
-	"int.vn":                              true,
-	"ac.vn":                               true,
-	"biz.vn":                              true,
-	"info.vn":                             true,
-	"name.vn":                             true,
-	"pro.vn":                              true,
-	"health.vn":                           true,
-	"vu":                                  true,
-	"ws":                                  true,
-	"com.ws":                              true,
-	"net.ws":                              true,
-	"org.ws":                              true,
-	"gov.ws":                              true,
-	"edu.ws":                              true,
-}
-
-var notRegNames = map[string]bool{
-	"congresodelalengua3.ar":       true,
-	"educ.ar":                      true,
-	"gobiernoelectronico.ar":       true,
-	"mecon.ar":                     true,
-	"nacion.ar":                    true,
-	"nic.ar":                       true,
-	"promocion.ar":                 true,
-	"retina.ar":                    true,
-	"uba.ar":                       true,
-	"metro.tokyo.jp":               true,
-	"pref.aichi.jp":                true,
-	"pref.akita.jp":                true,
-	"pref.aomori.jp":               true,
-	"pref.chiba.jp":                true,
-	"pref.ehime.jp":                true,
-	"pref.fukui.jp":                true,
-	"pref.fukuoka.jp":              true,
-	"pref.fukushima.jp":            true,
-	"pref.gifu.jp":                 true,
-	"pref.gunma.jp":                true,
-	"pref.hiroshima.jp":            true,
-	"pref.hokkaido.jp":             true,
-	"pref.hyogo.jp":                true,
-	"pref.ibaraki.jp":              true,
-	"pref.ishikawa.jp":             true,
-	"pref.iwate.jp":                true,
-	"pref.kagawa.jp":               true,
-	"pref.kagoshima.jp":            true,
-	"pref.kanagawa.jp":             true,
-	"pref.kochi.jp":                true,
-	"pref.kumamoto.jp":             true,
-	"pref.kyoto.jp":                true,
-	"pref.mie.jp":                  true,
-	"pref.miyagi.jp":               true,
-	"pref.miyazaki.jp":             true,
-	"pref.nagano.jp":               true,
-	"pref.nagasaki.jp":             true,
-	"pref.nara.jp":                 true,
-	"pref.niigata.jp":              true,
-	"pref.oita.jp":                 true,
-	"pref.okayama.jp":              true,
-	"pref.okinawa.jp":              true,
-	"pref.osaka.jp":                true,
-	"pref.saga.jp":                 true,
-	"pref.saitama.jp":              true,
-	"pref.shiga.jp":                true,
-	"pref.shimane.jp":              true,
-	"pref.shizuoka.jp":             true,
-	"pref.tochigi.jp":              true,
-	"pref.tokushima.jp":            true,
-	"pref.tottori.jp":              true,
-	"pref.toyama.jp":               true,
-	"pref.wakayama.jp":             true,
-	"pref.yamagata.jp":             true,
-	"pref.yamaguchi.jp":            true,
-	"pref.yamanashi.jp":            true,
-	"city.chiba.jp":                true,
-	"city.fukuoka.jp":              true,
-	"city.hiroshima.jp":            true,
-	"city.kawasaki.jp":             true,
-	"city.kitakyushu.jp":           true,
-	"city.kobe.jp":                 true,
-	"city.kyoto.jp":                true,
-	"city.nagoya.jp":               true,
-	"city.niigata.jp":              true,
-	"city.okayama.jp":              true,
-	"city.osaka.jp":                true,
-	"city.saitama.jp":              true,
-	"city.sapporo.jp":              true,
-	"city.sendai.jp":               true,
-	"city.shizuoka.jp":             true,
-	"city.yokohama.jp":             true,
-	"bl.uk":                        true,
-	"british-library.uk":           true,
-	"icnet.uk":                     true,
-	"jet.uk":                       true,
-	"nel.uk":                       true,
-	"nhs.uk":                       true,
-	"nls.uk":                       true,
-	"national-library-scotland.uk": true,
-	"parliament.uk":                true,
-}
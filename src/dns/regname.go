@@ -1,42 +1,203 @@
 package dns
 
-func RegParts(name *Name) (registered *Name, registrar *Name) {
-	var last *Name
-	cur := name
-	curStr := cur.String()
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// flags of a name in the registrar list
+const (
+	_REG_NAME    = 1 << iota // a registrar
+	_SUPER_NAME              // all children are registrars
+	_NOTREG_NAME             // exception of a super name
+)
+
+// a public suffix list, see publicsuffix.org
+type SuffixList struct {
+	all   *NameTree // name -> flags
+	icann *NameTree // same, without the private section
+}
+
+type suffixListError struct {
+	line int
+	s    string
+}
 
-	parent := cur.Parent()
-	var parentStr string
-	if parent != nil {
-		parentStr = parent.String()
+func (e *suffixListError) Error() string {
+	return fmt.Sprintf("suffix list line %d: %s", e.line, e.s)
+}
+
+func addRule(tree *NameTree, n *Name, flag int) {
+	v, _ := tree.Get(n)
+	flags, _ := v.(int)
+	tree.Insert(n, flags|flag)
+}
+
+// parses a list in the publicsuffix.org format: one rule per line,
+// "*." for wildcards, "!" for exceptions, and rules between the
+// "===BEGIN PRIVATE DOMAINS===" and "===END PRIVATE DOMAINS===" comments
+// are private; unicode rules are converted to the xn-- form
+func ParseSuffixList(r io.Reader) (*SuffixList, error) {
+	ret := &SuffixList{
+		all:   NewNameTree(),
+		icann: NewNameTree(),
 	}
 
-	for {
-		if parent != nil && superNames[parentStr] && !notRegNames[curStr] {
-			return last, cur
+	private := false
+	scanner := bufio.NewScanner(r)
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "//") {
+			switch {
+			case strings.Contains(line, "===BEGIN PRIVATE DOMAINS==="):
+				private = true
+			case strings.Contains(line, "===END PRIVATE DOMAINS==="):
+				private = false
+			}
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
 		}
+		rule := fields[0]
 
-		if cur.IsRoot() {
-			return last, cur
+		flag := _REG_NAME
+		switch {
+		case strings.HasPrefix(rule, "!"):
+			rule = rule[1:]
+			flag = _NOTREG_NAME
+		case strings.HasPrefix(rule, "*."):
+			rule = rule[2:]
+			flag = _SUPER_NAME
+		}
+		if strings.Contains(rule, "*") {
+			return nil, &suffixListError{lineno, "wildcard not leftmost"}
 		}
 
-		if regNames[curStr] {
-			return last, cur
+		n, err := NewName(rule)
+		if err != nil {
+			return nil, &suffixListError{lineno, err.Error()}
 		}
+		addRule(ret.all, n, flag)
+		if !private {
+			addRule(ret.icann, n, flag)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func LoadSuffixList(path string) (*SuffixList, error) {
+	fin, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fin.Close()
+
+	return ParseSuffixList(fin)
+}
+
+// number of names that have rules
+func (l *SuffixList) Len() int {
+	return l.all.Len()
+}
+
+// the list with only the rules in the icann section
+func (l *SuffixList) ICANN() *SuffixList {
+	return &SuffixList{all: l.icann, icann: l.icann}
+}
 
-		// shift now, parent is not root
-		last = cur
-		cur = parent
-		curStr = parentStr
+// splits a name into the registered domain, and the registrar that it
+// is registered under; registered is nil if name is a registrar itself
+func (l *SuffixList) RegParts(name *Name) (registered, registrar *Name) {
+	// flags of the name and its parents, indexed by depth
+	flags := make([]int, len(name.labels)+1)
+	l.all.Ancestors(name, func(n *Name, v interface{}) bool {
+		flags[len(n.labels)] = v.(int)
+		return true
+	})
 
-		parent = parent.Parent()
-		if parent != nil {
-			parentStr = parent.String()
+	d := len(name.labels)
+	for ; d > 0; d-- {
+		if flags[d]&_NOTREG_NAME != 0 {
+			d-- // an exception is registered under its parent
+			break
 		}
+		if flags[d-1]&_SUPER_NAME != 0 {
+			break
+		}
+		if flags[d]&_REG_NAME != 0 {
+			break
+		}
+	}
+
+	registrar = name.ancestor(d)
+	if d < len(name.labels) {
+		registered = name.ancestor(d + 1)
 	}
+	return registered, registrar
 }
 
-func IsRegistrar(name *Name) bool {
-	_, reg := RegParts(name)
+func (l *SuffixList) IsRegistrar(name *Name) bool {
+	_, reg := l.RegParts(name)
 	return reg.Equal(name)
 }
+
+// a copy of the list from publicsuffix.org
+//
+//go:embed suffix_list.dat
+var defaultSuffixList string
+
+var (
+	suffixLock sync.Mutex
+	suffixList *SuffixList
+)
+
+// the list used by RegParts and IsRegistrar, the embedded one by default
+func TheSuffixList() *SuffixList {
+	suffixLock.Lock()
+	defer suffixLock.Unlock()
+
+	if suffixList == nil {
+		l, err := ParseSuffixList(strings.NewReader(defaultSuffixList))
+		if err != nil {
+			panic(err)
+		}
+		suffixList = l
+	}
+	return suffixList
+}
+
+// replaces the list used by RegParts and IsRegistrar
+func UseSuffixList(l *SuffixList) {
+	suffixLock.Lock()
+	defer suffixLock.Unlock()
+	suffixList = l
+}
+
+// the suffix of n with the last depth labels
+func (n *Name) ancestor(depth int) *Name {
+	ret := n
+	for i := len(n.labels); i > depth; i-- {
+		ret = ret.Parent()
+	}
+	return ret
+}
+
+func RegParts(name *Name) (registered *Name, registrar *Name) {
+	return TheSuffixList().RegParts(name)
+}
+
+func IsRegistrar(name *Name) bool {
+	return TheSuffixList().IsRegistrar(name)
+}
@@ -1,6 +1,9 @@
 package dns
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestRegName(t *testing.T) {
 	o := func(n, r1, r2 *Name) {
@@ -34,5 +37,44 @@ func TestRegName(t *testing.T) {
 	p(Domain("google.com.tw"), false)
 	p(Domain("www.google.com.tw"), false)
 	p(Domain("google.com"), false)
-	p(Domain("google"), false)
+	p(Domain("google"), true)
+	p(Domain("example"), false)
+}
+
+func TestSuffixList(t *testing.T) {
+	list := `// a test list
+com
+*.ck
+!www.ck
+公司.cn
+// ===BEGIN PRIVATE DOMAINS===
+blogspot.com
+// ===END PRIVATE DOMAINS===
+`
+	l, e := ParseSuffixList(strings.NewReader(list))
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	o := func(l *SuffixList, n, r1, r2 string) {
+		reg1, reg2 := l.RegParts(Domain(n))
+		if reg1.String() != r1 || reg2.String() != r2 {
+			t.Errorf("RegParts(%s) = %s, %s   expecting: %s, %s",
+				n, reg1, reg2, r1, r2)
+		}
+	}
+	o(l, "a.b.blogspot.com", "b.blogspot.com", "blogspot.com")
+	o(l.ICANN(), "a.b.blogspot.com", "blogspot.com", "com")
+	o(l, "a.b.ck", "a.b.ck", "b.ck")
+	o(l, "a.www.ck", "www.ck", "ck")
+	o(l, "x.公司.cn", "x.xn--55qx5d.cn", "xn--55qx5d.cn")
+
+	if _, e = ParseSuffixList(strings.NewReader("a.*.com")); e == nil {
+		t.Error("wildcard in the middle should fail")
+	}
+	if TheSuffixList().Len() < 3600 {
+		t.Errorf("embedded list too short: %d", TheSuffixList().Len())
+	}
+	o(TheSuffixList(), "a.b.github.io", "b.github.io", "github.io")
+	o(TheSuffixList().ICANN(), "a.b.github.io", "github.io", "io")
 }
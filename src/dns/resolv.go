@@ -0,0 +1,184 @@
+package dns
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the settings of a stub resolver, as in resolv.conf(5)
+type ResolvConf struct {
+	Servers  []*IPv4 // ipv6 servers are skipped
+	Port     uint16
+	Search   []*Name
+	Ndots    int
+	Timeout  time.Duration // for each query
+	Attempts int           // rounds over the servers
+	Rotate   bool          // spread the queries over the servers
+}
+
+// the path of the system settings
+const RESOLV_CONF = "/etc/resolv.conf"
+
+// limits of resolv.conf
+const (
+	_RESOLV_MAX_SERVERS  = 3
+	_RESOLV_MAX_SEARCH   = 6
+	_RESOLV_MAX_NDOTS    = 15
+	_RESOLV_MAX_TIMEOUT  = 30
+	_RESOLV_MAX_ATTEMPTS = 5
+)
+
+// the settings when there is no resolv.conf
+func DefaultResolvConf() *ResolvConf {
+	return &ResolvConf{
+		Servers:  []*IPv4{ParseIP("127.0.0.1")},
+		Port:     DNS_PORT,
+		Ndots:    1,
+		Timeout:  5 * time.Second,
+		Attempts: 2,
+	}
+}
+
+// clamps an option value to the range
+func resolvInt(s string, min, max int) (int, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if i < min {
+		return min, nil
+	}
+	if i > max {
+		return max, nil
+	}
+	return i, nil
+}
+
+func (c *ResolvConf) parseOption(opt string) error {
+	key, value := opt, ""
+	if i := strings.Index(opt, ":"); i >= 0 {
+		key, value = opt[:i], opt[i+1:]
+	}
+	var err error
+	switch key {
+	case "ndots":
+		c.Ndots, err = resolvInt(value, 0, _RESOLV_MAX_NDOTS)
+	case "timeout":
+		var sec int
+		sec, err = resolvInt(value, 1, _RESOLV_MAX_TIMEOUT)
+		c.Timeout = time.Duration(sec) * time.Second
+	case "attempts":
+		c.Attempts, err = resolvInt(value, 1, _RESOLV_MAX_ATTEMPTS)
+	case "rotate":
+		c.Rotate = true
+	}
+	return err
+}
+
+func (c *ResolvConf) parseSearch(fields []string) error {
+	c.Search = c.Search[:0]
+	for _, f := range fields {
+		if len(c.Search) >= _RESOLV_MAX_SEARCH {
+			break
+		}
+		n, err := NewName(f)
+		if err != nil {
+			return err
+		}
+		c.Search = append(c.Search, n)
+	}
+	return nil
+}
+
+// parses the settings in the format of resolv.conf; unknown keywords
+// and options are ignored, and the last of domain or search wins
+func ParseResolvConf(in io.Reader) (*ResolvConf, error) {
+	ret := DefaultResolvConf()
+	ret.Servers = nil
+
+	scanner := bufio.NewScanner(in)
+	line := 0
+	for scanner.Scan() {
+		line++
+		s := scanner.Text()
+		if i := strings.IndexAny(s, "#;"); i >= 0 {
+			s = s[:i]
+		}
+		fields := strings.Fields(s)
+		if len(fields) < 2 {
+			continue
+		}
+
+		var err error
+		switch fields[0] {
+		case "nameserver":
+			ip := ParseIP(fields[1])
+			if ip != nil && len(ret.Servers) < _RESOLV_MAX_SERVERS {
+				ret.Servers = append(ret.Servers, ip)
+			}
+		case "domain":
+			err = ret.parseSearch(fields[1:2])
+		case "search":
+			err = ret.parseSearch(fields[1:])
+		case "options":
+			for _, opt := range fields[1:] {
+				if err = ret.parseOption(opt); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("resolv.conf line %d: %s", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(ret.Servers) == 0 {
+		ret.Servers = DefaultResolvConf().Servers
+	}
+	return ret, nil
+}
+
+// reads the settings from the file, RESOLV_CONF for the system ones
+func LoadResolvConf(path string) (*ResolvConf, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseResolvConf(f)
+}
+
+// the names to try for a name in order, with the search list applied;
+// a name ending with a dot is absolute and tried alone
+func (c *ResolvConf) Names(s string) ([]*Name, error) {
+	n, err := NewName(s)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(s, ".") || n.IsRoot() {
+		return []*Name{n}, nil
+	}
+
+	ret := make([]*Name, 0, len(c.Search)+1)
+	asIs := len(n.labels)-1 >= c.Ndots // the number of dots
+	if asIs {
+		ret = append(ret, n)
+	}
+	for _, domain := range c.Search {
+		if full, err := n.Concat(domain); err == nil {
+			ret = append(ret, full)
+		}
+	}
+	if !asIs {
+		ret = append(ret, n)
+	}
+	return ret, nil
+}
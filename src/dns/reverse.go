@@ -0,0 +1,90 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+var (
+	inAddrArpa = Domain("in-addr.arpa")
+	ip6Arpa    = Domain("ip6.arpa")
+)
+
+// the in-addr.arpa name of the ip
+func (ip *IPv4) Reverse() *Name {
+	labels := make([]string, 0, 6)
+	for i := 3; i >= 0; i-- {
+		labels = append(labels, strconv.Itoa(int(ip.ip[i])))
+	}
+	labels = append(labels, inAddrArpa.labels...)
+	return &Name{labels: labels}
+}
+
+// the in-addr.arpa or ip6.arpa name of an ipv4 or ipv6 address
+// returns nil if ip is not a valid address
+func ReverseIP(ip net.IP) *Name {
+	if ip4 := IPFromIP(ip); ip4 != nil {
+		return ip4.Reverse()
+	}
+
+	ip16 := ip.To16()
+	if ip16 == nil {
+		return nil
+	}
+	labels := make([]string, 0, 34)
+	for i := 15; i >= 0; i-- {
+		b := ip16[i]
+		labels = append(labels,
+			fmt.Sprintf("%x", b&0xf),
+			fmt.Sprintf("%x", b>>4))
+	}
+	labels = append(labels, ip6Arpa.labels...)
+	return &Name{labels: labels}
+}
+
+// parses a full in-addr.arpa or ip6.arpa name back to the address
+// returns nil if the name is not one
+func ParseReverse(n *Name) net.IP {
+	if n.SubOf(inAddrArpa) {
+		labels := n.labels[:len(n.labels)-len(inAddrArpa.labels)]
+		if len(labels) != 4 {
+			return nil
+		}
+		ret := make([]byte, 4)
+		for i, lab := range labels {
+			b, err := strconv.ParseUint(lab, 10, 8)
+			if err != nil || strconv.Itoa(int(b)) != lab {
+				return nil
+			}
+			ret[3-i] = byte(b)
+		}
+		return net.IPv4(ret[0], ret[1], ret[2], ret[3])
+	}
+
+	if n.SubOf(ip6Arpa) {
+		labels := n.labels[:len(n.labels)-len(ip6Arpa.labels)]
+		if len(labels) != 32 {
+			return nil
+		}
+		ret := make(net.IP, 16)
+		for i, lab := range labels {
+			if len(lab) != 1 {
+				return nil
+			}
+			nibble, err := strconv.ParseUint(lab, 16, 8)
+			if err != nil {
+				return nil
+			}
+			pos := 31 - i
+			if pos%2 == 0 {
+				ret[pos/2] |= byte(nibble) << 4
+			} else {
+				ret[pos/2] |= byte(nibble)
+			}
+		}
+		return ret
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package dns
+
+import (
+	"net"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	n := ParseIP("192.0.2.10").Reverse()
+	if n.String() != "10.2.0.192.in-addr.arpa" {
+		t.Errorf("wrong reverse name: %s", n)
+	}
+	if !ParseReverse(n).Equal(net.ParseIP("192.0.2.10")) {
+		t.Errorf("wrong parse of %s", n)
+	}
+
+	ip6 := net.ParseIP("2001:db8::567:89ab")
+	n = ReverseIP(ip6)
+	expect := "b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0." +
+		"0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"
+	if n.String() != expect {
+		t.Errorf("wrong reverse name: %s", n)
+	}
+	if !ParseReverse(n).Equal(ip6) {
+		t.Errorf("wrong parse of %s", n)
+	}
+
+	for _, s := range []string{
+		"2.0.192.in-addr.arpa",
+		"256.2.0.192.in-addr.arpa",
+		"www.google.com",
+	} {
+		if ParseReverse(Domain(s)) != nil {
+			t.Errorf("%s should not parse", s)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package dns
+
+import (
+	"errors"
+	"os"
+	"strings"
+)
+
+// the root name servers, as in the named.root file of internic
+const defaultRootHints = `
+;       This file holds the information on root name servers needed to
+;       initialize cache of Internet domain name servers
+;
+;       last update:     July 03, 2024
+;       related version of root zone:     2024070301
+;
+.                        3600000      NS    A.ROOT-SERVERS.NET.
+A.ROOT-SERVERS.NET.      3600000      A     198.41.0.4
+A.ROOT-SERVERS.NET.      3600000      AAAA  2001:503:ba3e::2:30
+.                        3600000      NS    B.ROOT-SERVERS.NET.
+B.ROOT-SERVERS.NET.      3600000      A     170.247.170.2
+B.ROOT-SERVERS.NET.      3600000      AAAA  2801:1b8:10::b
+.                        3600000      NS    C.ROOT-SERVERS.NET.
+C.ROOT-SERVERS.NET.      3600000      A     192.33.4.12
+C.ROOT-SERVERS.NET.      3600000      AAAA  2001:500:2::c
+.                        3600000      NS    D.ROOT-SERVERS.NET.
+D.ROOT-SERVERS.NET.      3600000      A     199.7.91.13
+D.ROOT-SERVERS.NET.      3600000      AAAA  2001:500:2d::d
+.                        3600000      NS    E.ROOT-SERVERS.NET.
+E.ROOT-SERVERS.NET.      3600000      A     192.203.230.10
+E.ROOT-SERVERS.NET.      3600000      AAAA  2001:500:a8::e
+.                        3600000      NS    F.ROOT-SERVERS.NET.
+F.ROOT-SERVERS.NET.      3600000      A     192.5.5.241
+F.ROOT-SERVERS.NET.      3600000      AAAA  2001:500:2f::f
+.                        3600000      NS    G.ROOT-SERVERS.NET.
+G.ROOT-SERVERS.NET.      3600000      A     192.112.36.4
+G.ROOT-SERVERS.NET.      3600000      AAAA  2001:500:12::d0d
+.                        3600000      NS    H.ROOT-SERVERS.NET.
+H.ROOT-SERVERS.NET.      3600000      A     198.97.190.53
+H.ROOT-SERVERS.NET.      3600000      AAAA  2001:500:1::53
+.                        3600000      NS    I.ROOT-SERVERS.NET.
+I.ROOT-SERVERS.NET.      3600000      A     192.36.148.17
+I.ROOT-SERVERS.NET.      3600000      AAAA  2001:7fe::53
+.                        3600000      NS    J.ROOT-SERVERS.NET.
+J.ROOT-SERVERS.NET.      3600000      A     192.58.128.30
+J.ROOT-SERVERS.NET.      3600000      AAAA  2001:503:c27::2:30
+.                        3600000      NS    K.ROOT-SERVERS.NET.
+K.ROOT-SERVERS.NET.      3600000      A     193.0.14.129
+K.ROOT-SERVERS.NET.      3600000      AAAA  2001:7fd::1
+.                        3600000      NS    L.ROOT-SERVERS.NET.
+L.ROOT-SERVERS.NET.      3600000      A     199.7.83.42
+L.ROOT-SERVERS.NET.      3600000      AAAA  2001:500:9f::42
+.                        3600000      NS    M.ROOT-SERVERS.NET.
+M.ROOT-SERVERS.NET.      3600000      A     202.12.27.33
+M.ROOT-SERVERS.NET.      3600000      AAAA  2001:dc3::35
+; End of file
+`
+
+var errNoRootServer = errors.New("root hints: no server with an ipv4 address")
+
+// the root zone with the servers and their ipv4 addresses in the records;
+// ipv6 addresses are skipped, for the queries are over ipv4
+func rootZone(rrs []RR) (*Zone, error) {
+	ret := NewZone(rootName)
+	for _, rr := range rrs {
+		if rr.Type != NS || rr.Class != IN || !rr.Name.IsRoot() {
+			continue
+		}
+		server := rr.Rdata.(*RdName).Name
+		ips := make([]*IPv4, 0, 1)
+		for _, glue := range rrs {
+			if glue.Type == A && glue.Class == IN &&
+				glue.Name.Equal(server) {
+				ips = append(ips, glue.Rdata.(*RdIP).IP)
+			}
+		}
+		if len(ips) > 0 {
+			ret.Add(server, ips...)
+		}
+	}
+	if len(ret.List()) == 0 {
+		return nil, errNoRootServer
+	}
+	return ret, nil
+}
+
+// parses root hints in the master file format, like named.root
+func ParseRootHints(s string) (*Zone, error) {
+	rrs, err := ReadMaster(strings.NewReader(s), rootName)
+	if err != nil {
+		return nil, err
+	}
+	return rootZone(rrs)
+}
+
+// reads root hints from a file
+func LoadRootHints(path string) (*Zone, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	rrs, err := ReadMaster(f, rootName)
+	if err != nil {
+		return nil, err
+	}
+	return rootZone(rrs)
+}
+
+// the default root servers
+var rootServers = makeRootServers()
+
+func makeRootServers() *Zone {
+	ret, err := ParseRootHints(defaultRootHints)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// the default root servers from the embedded hints
+func DefaultRootHints() *Zone {
+	return rootServers.Copy()
+}
@@ -0,0 +1,61 @@
+package dns
+
+import (
+	"testing"
+)
+
+func TestRootHints(t *testing.T) {
+	roots := DefaultRootHints()
+	if n := len(roots.List()); n != 13 {
+		t.Errorf("%d root servers", n)
+	}
+	for _, server := range roots.List() {
+		if len(server.IPs) != 1 {
+			t.Errorf("%s: %v", server.Name, server.IPs)
+		}
+	}
+
+	lab, err := ParseRootHints(`
+. 3600 NS ns.lab.
+ns.lab. 3600 A 10.0.0.53
+ns.lab. 3600 AAAA 2001:db8::53
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := lab.List()
+	if len(list) != 1 || !list[0].IPs[0].Equal(ParseIP("10.0.0.53")) {
+		t.Errorf("lab roots: %v", list)
+	}
+
+	if _, err := ParseRootHints(". 3600 NS ns.lab.\n"); err != errNoRootServer {
+		t.Errorf("no glue: %v", err)
+	}
+}
+
+func TestPrime(t *testing.T) {
+	s := newFakeSolver()
+	s.answerRoot(".", NS, &Msg{Flags: F_AA,
+		Answ: []RR{
+			nsRR(".", "a.root.test"),
+			nsRR(".", "b.root.test"),
+		},
+		Addi: []RR{
+			aRR("a.root.test", "192.0.2.1"),
+			aRR("b.root.test", "192.0.2.2"),
+		}})
+
+	p := NewProbPrime()
+	s.SolveSub(p)
+	if p.Roots == nil || len(p.Roots.List()) != 2 {
+		t.Fatalf("primed: %v, %v", p.Roots, p.Problems)
+	}
+
+	s = newFakeSolver()
+	s.answerRoot(".", NS, &Msg{Answ: []RR{nsRR(".", "a.root.test")}})
+	p = NewProbPrime()
+	s.SolveSub(p)
+	if p.Roots != nil || len(p.Problems) == 0 {
+		t.Error("primed from a response that is not authoritative")
+	}
+}
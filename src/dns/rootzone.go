@@ -0,0 +1,212 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// a local copy of the root zone, see rfc8806; its delegations go into
+// the cache, so that recursion starts at the tld servers
+
+var errRootZoneSOA = errors.New("root zone: no soa")
+
+// the delegations of a root zone file
+type RootZone struct {
+	SOA    *RdSOA
+	Zones  []*Zone   // the tlds with the glue addresses, sorted by name
+	Signed time.Time // the date in the serial, zero if not a date
+}
+
+// reads a root zone in the master file format; records of types without
+// a known presentation, like zonemd, are skipped
+func ReadRootZone(in io.Reader) (*RootZone, error) {
+	m := NewMasterReader(in, rootName)
+	m.SkipUnknown = true
+
+	ret := new(RootZone)
+	zones := make(map[string]*Zone)
+	glue := make(map[string][]*IPv4)
+	for {
+		rr, err := m.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if rr.Class != IN {
+			continue
+		}
+		switch {
+		case rr.Type == SOA && rr.Name.IsRoot():
+			ret.SOA = rr.Rdata.(*RdSOA)
+		case rr.Type == NS && !rr.Name.IsRoot():
+			key := rr.Name.String()
+			if zones[key] == nil {
+				zones[key] = NewZone(rr.Name)
+			}
+			zones[key].AddName(rr.Rdata.(*RdName).Name)
+		case rr.Type == A:
+			key := rr.Name.String()
+			glue[key] = append(glue[key], rr.Rdata.(*RdIP).IP)
+		}
+	}
+	if ret.SOA == nil {
+		return nil, errRootZoneSOA
+	}
+
+	for _, zone := range zones {
+		for _, server := range zone.List() {
+			if ips := glue[server.Name.String()]; len(ips) > 0 {
+				zone.Add(server.Name, ips...)
+			}
+		}
+		ret.Zones = append(ret.Zones, zone)
+	}
+	sort.Slice(ret.Zones, func(i, j int) bool {
+		return ret.Zones[i].Name().String() < ret.Zones[j].Name().String()
+	})
+	ret.Signed = serialDate(ret.SOA.Serial)
+	return ret, nil
+}
+
+// the date of a serial like 2024070301, zero if it is not one
+func serialDate(serial uint32) time.Time {
+	s := strconv.FormatUint(uint64(serial), 10)
+	if len(s) != 10 {
+		return time.Time{}
+	}
+	t, err := time.Parse("20060102", s[:8])
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func LoadRootZone(path string) (*RootZone, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadRootZone(f)
+}
+
+// true if the copy is older than the expire time of the soa
+func (z *RootZone) Stale(now time.Time) bool {
+	if z.Signed.IsZero() {
+		return false
+	}
+	return now.Sub(z.Signed) > time.Duration(z.SOA.Expire)*time.Second
+}
+
+// puts the delegations into the cache until the expire time
+func (z *RootZone) AddTo(c *NSCache, expire time.Time) {
+	for _, zone := range z.Zones {
+		c.AddUntil(zone, expire)
+	}
+}
+
+// default interval for reloading the root zone file
+const _ROOT_ZONE_RELOAD = time.Hour / 2
+
+// keeps the cache filled from a root zone file, reloading it
+// periodically; the delegations expire after two intervals, so that
+// recursion falls back to the root servers if the file stops loading
+type RootZoneLoader struct {
+	path     string
+	cache    *NSCache
+	interval time.Duration
+	logf     func(e error)
+
+	lock sync.Mutex
+	last *RootZone
+	stop chan struct{}
+	done chan struct{}
+}
+
+// interval is for reloading, 0 for the default
+func NewRootZoneLoader(path string, cache *NSCache,
+	interval time.Duration) *RootZoneLoader {
+	if interval <= 0 {
+		interval = _ROOT_ZONE_RELOAD
+	}
+	return &RootZoneLoader{
+		path:     path,
+		cache:    cache,
+		interval: interval,
+		logf:     stderrLogger,
+	}
+}
+
+// sets where the reload errors and staleness warnings go, stderr by
+// default
+func (l *RootZoneLoader) LogTo(f func(e error)) {
+	l.logf = f
+}
+
+// the last zone loaded, nil if none
+func (l *RootZoneLoader) Zone() *RootZone {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.last
+}
+
+// loads the file into the cache once
+func (l *RootZoneLoader) Load() error {
+	z, err := LoadRootZone(l.path)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if z.Stale(now) {
+		l.logf(fmt.Errorf("root zone %s is stale, serial %d is from %s",
+			l.path, z.SOA.Serial, z.Signed.Format("2006-01-02")))
+	}
+	z.AddTo(l.cache, now.Add(2*l.interval))
+
+	l.lock.Lock()
+	l.last = z
+	l.lock.Unlock()
+	return nil
+}
+
+// loads the file, and keeps reloading it in the background until Stop
+func (l *RootZoneLoader) Start() error {
+	if err := l.Load(); err != nil {
+		return err
+	}
+	l.stop = make(chan struct{})
+	l.done = make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(l.interval)
+		defer ticker.Stop()
+		defer close(l.done)
+		for {
+			select {
+			case <-ticker.C:
+				if err := l.Load(); err != nil {
+					l.logf(err)
+				}
+			case <-l.stop:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (l *RootZoneLoader) Stop() {
+	if l.stop == nil {
+		return
+	}
+	close(l.stop)
+	<-l.done
+	l.stop = nil
+}
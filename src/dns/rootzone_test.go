@@ -0,0 +1,80 @@
+package dns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testRootZone = `
+.	86400	IN	SOA	a.root-servers.net. nstld.verisign-grs.com. 2024070301 1800 900 604800 86400
+.	86400	IN	RRSIG	SOA 8 0 86400 20240716050000 20240703040000 20038 . c2ln
+.	518400	IN	NS	a.root-servers.net.
+.	86400	IN	ZONEMD	2024070301 1 241 AABB
+test.	172800	IN	NS	ns1.nic.test.
+test.	172800	IN	NS	ns2.nic.test.
+test.	172800	IN	NS	ns.elsewhere.example.
+test.	86400	IN	DS	12345 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBB683457104237C7F8EC8D
+test.	86400	IN	NSEC	xn--test. NS DS RRSIG NSEC
+ns1.nic.test.	172800	IN	A	192.0.2.1
+ns1.nic.test.	172800	IN	AAAA	2001:db8::1
+ns2.nic.test.	172800	IN	A	192.0.2.2
+a.root-servers.net.	518400	IN	A	198.41.0.4
+`
+
+func TestReadRootZone(t *testing.T) {
+	z, err := ReadRootZone(strings.NewReader(testRootZone))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z.SOA.Serial != 2024070301 || len(z.Zones) != 1 {
+		t.Fatalf("serial %d, %d zones", z.SOA.Serial, len(z.Zones))
+	}
+	if z.Signed.Format("2006-01-02") != "2024-07-03" {
+		t.Errorf("signed %s", z.Signed)
+	}
+	if !z.Stale(z.Signed.Add(8*24*time.Hour)) ||
+		z.Stale(z.Signed.Add(24*time.Hour)) {
+		t.Error("staleness by the soa expire")
+	}
+
+	cache := NewNSCache()
+	defer cache.Close()
+	z.AddTo(cache, time.Now().Add(time.Hour))
+	tld := cache.Query(Domain("www.example.test"))
+	if tld == nil || !tld.Name().Equal(Domain("test")) ||
+		len(tld.List()) != 2 {
+		t.Fatalf("cached: %v", tld)
+	}
+
+	if _, err := ReadRootZone(strings.NewReader("test. NS ns.test.\n")); err !=
+		errRootZoneSOA {
+		t.Errorf("no soa: %v", err)
+	}
+}
+
+func TestRootZoneLoader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "root.zone")
+	if err := os.WriteFile(path, []byte(testRootZone), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cache := NewNSCache()
+	defer cache.Close()
+	l := NewRootZoneLoader(path, cache, time.Hour)
+	var warnings []error
+	l.LogTo(func(e error) { warnings = append(warnings, e) })
+	if err := l.Start(); err != nil {
+		t.Fatal(err)
+	}
+	l.Stop()
+
+	if l.Zone() == nil || cache.Query(Domain("nic.test")) == nil {
+		t.Error("not loaded")
+	}
+	if len(warnings) != 1 { // the test zone is from 2024
+		t.Errorf("warnings: %v", warnings)
+	}
+}
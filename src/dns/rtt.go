@@ -0,0 +1,149 @@
+package dns
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// orders the name servers of a zone before querying
+type ServerSelector interface {
+	Order(servers []*NameServer) []*NameServer
+}
+
+type randomSelector struct{}
+
+func (s randomSelector) Order(servers []*NameServer) []*NameServer {
+	return shuffle(servers)
+}
+
+// the old strategy: random order, servers without ips last
+var RandomSelector ServerSelector = randomSelector{}
+
+const (
+	_RTT_ALPHA     = 0.3                   // weight of a new sample
+	_RTT_TIMEOUT   = 5 * time.Second       // the sample for a time out
+	_RTT_HALF_LIFE = 10 * time.Minute      // for recovered servers
+	_RTT_UNKNOWN   = 32 * time.Millisecond // max initial guess
+)
+
+type rttEntry struct {
+	srtt    float64 // in seconds
+	updated time.Time
+}
+
+// keeps smoothed round trip times of server ips, like the srtt in bind
+// the srtt decays over time, so that slow or dead servers will be
+// retried after a while
+// it is also a ServerSelector that prefers servers with smaller srtt
+type RTTTable struct {
+	lock    sync.Mutex
+	entries map[uint32]*rttEntry
+}
+
+func NewRTTTable() *RTTTable {
+	return &RTTTable{entries: make(map[uint32]*rttEntry)}
+}
+
+func (t *RTTTable) decayed(e *rttEntry, now time.Time) float64 {
+	elapsed := now.Sub(e.updated).Seconds()
+	return e.srtt * math.Pow(0.5, elapsed/_RTT_HALF_LIFE.Seconds())
+}
+
+func (t *RTTTable) record(ip *IPv4, sample time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	i := ip.Uint()
+	e := t.entries[i]
+	if e == nil {
+		t.entries[i] = &rttEntry{sample.Seconds(), now}
+		return
+	}
+	srtt := t.decayed(e, now)
+	e.srtt = (1-_RTT_ALPHA)*srtt + _RTT_ALPHA*sample.Seconds()
+	e.updated = now
+}
+
+// records a successful response
+func (t *RTTTable) Record(ip *IPv4, rtt time.Duration) {
+	t.record(ip, rtt)
+}
+
+// records a time out, which counts as a very slow response
+func (t *RTTTable) Timeout(ip *IPv4) {
+	t.record(ip, _RTT_TIMEOUT)
+}
+
+// returns the current smoothed rtt of an ip, false if never measured
+func (t *RTTTable) SRTT(ip *IPv4) (time.Duration, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	e := t.entries[ip.Uint()]
+	if e == nil {
+		return 0, false
+	}
+	d := t.decayed(e, time.Now())
+	return time.Duration(d * float64(time.Second)), true
+}
+
+func (t *RTTTable) guess(ip *IPv4, now time.Time) float64 {
+	e := t.entries[ip.Uint()]
+	if e == nil {
+		// unknown servers get a small random guess, so they are tried
+		return rand.Float64() * _RTT_UNKNOWN.Seconds()
+	}
+	return t.decayed(e, now)
+}
+
+// orders servers and their ips by srtt, servers without ips last
+func (t *RTTTable) Order(servers []*NameServer) []*NameServer {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	type ranked struct {
+		server *NameServer
+		best   float64
+	}
+
+	now := time.Now()
+	withIP := make([]*ranked, 0, len(servers))
+	nameOnly := make([]*NameServer, 0, len(servers))
+
+	for _, ns := range servers {
+		if len(ns.IPs) == 0 {
+			nameOnly = append(nameOnly, ns)
+			continue
+		}
+
+		srtts := make(map[uint32]float64)
+		for _, ip := range ns.IPs {
+			srtts[ip.Uint()] = t.guess(ip, now)
+		}
+		ips := make([]*IPv4, len(ns.IPs))
+		copy(ips, ns.IPs)
+		sort.SliceStable(ips, func(i, j int) bool {
+			return srtts[ips[i].Uint()] < srtts[ips[j].Uint()]
+		})
+
+		withIP = append(withIP, &ranked{
+			&NameServer{ns.Name, ips},
+			srtts[ips[0].Uint()],
+		})
+	}
+
+	sort.SliceStable(withIP, func(i, j int) bool {
+		return withIP[i].best < withIP[j].best
+	})
+
+	ret := make([]*NameServer, 0, len(servers))
+	for _, r := range withIP {
+		ret = append(ret, r.server)
+	}
+	ret = append(ret, randOrder(nameOnly)...)
+	return ret
+}
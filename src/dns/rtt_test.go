@@ -0,0 +1,46 @@
+package dns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRTTOrder(t *testing.T) {
+	rtt := NewRTTTable()
+	fast := ParseIP("1.1.1.1")
+	slow := ParseIP("2.2.2.2")
+	dead := ParseIP("3.3.3.3")
+
+	rtt.Record(fast, 10*time.Millisecond)
+	rtt.Record(slow, 300*time.Millisecond)
+	rtt.Timeout(dead)
+
+	z := NewZone(Domain("example.com"))
+	z.Add(Domain("ns3.example.com"), dead)
+	z.Add(Domain("ns2.example.com"), slow)
+	z.AddName(Domain("ns4.example.net"))
+	z.Add(Domain("ns1.example.com"), fast)
+
+	servers := z.PrepareWith(rtt)
+	expect := []string{
+		"ns1.example.com",
+		"ns2.example.com",
+		"ns3.example.com",
+		"ns4.example.net",
+	}
+	for i, s := range servers {
+		if s.Name.String() != expect[i] {
+			t.Errorf("server %d: %s, expecting %s", i, s.Name, expect[i])
+		}
+	}
+
+	d, ok := rtt.SRTT(slow)
+	if !ok || d < 250*time.Millisecond || d > 300*time.Millisecond {
+		t.Errorf("srtt of slow server: %s", d)
+	}
+	rtt.Record(slow, 100*time.Millisecond)
+	d2, _ := rtt.SRTT(slow)
+	if d2 >= d {
+		t.Error("srtt not smoothed down")
+	}
+}
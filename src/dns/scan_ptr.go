@@ -0,0 +1,67 @@
+package dns
+
+import (
+	"sync"
+)
+
+// a row of a ptr scan
+type PTRRow struct {
+	IP    *IPv4
+	Names []*Name
+	Rcode int // -1 if no server responded
+}
+
+// looks up the names of every address in the prefix with the given
+// number of concurrent workers, and calls f for each row
+// f is called from one goroutine at a time, in no particular order
+func (c *Client) ScanPTR(p *Prefix, workers int, f func(row *PTRRow)) {
+	// reverse zones are delegated deep, so cache all of them
+	policy := &SuffixPolicy{
+		Allow: []*Name{inAddrArpa},
+		Else:  c.policy,
+	}
+
+	scanPTR(p, workers, func(prob Prob) {
+		solver := c.newSolver(nil)
+		solver.UsePolicy(policy)
+		solver.Solve(prob)
+	}, f)
+}
+
+// runs the ptr problems of a scan on workers that solve with solve
+func scanPTR(p *Prefix, workers int, solve func(prob Prob),
+	f func(row *PTRRow)) {
+	if workers <= 0 {
+		workers = 1
+	}
+
+	ips := make(chan *IPv4, workers)
+	rows := make(chan *PTRRow, workers)
+	wg := new(sync.WaitGroup)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ip := range ips {
+				prob := NewProbPTR(ip.IP())
+				solve(prob)
+				rows <- &PTRRow{ip, prob.Names, prob.Rcode}
+			}
+		}()
+	}
+
+	go func() {
+		p.Each(func(ip *IPv4) bool {
+			ips <- ip
+			return true
+		})
+		close(ips)
+		wg.Wait()
+		close(rows)
+	}()
+
+	for row := range rows {
+		f(row)
+	}
+}
@@ -0,0 +1,48 @@
+package dns
+
+import (
+	"testing"
+)
+
+func TestScanPTR(t *testing.T) {
+	s := newFakeSolver()
+	s.zones = []*Zone{fakeZone("2.0.192.in-addr.arpa",
+		"ns.rev.test", "192.0.2.53")}
+	s.answer("192.0.2.53", "1.2.0.192.in-addr.arpa", PTR, &Msg{
+		Flags: F_AA,
+		Answ: []RR{{Domain("1.2.0.192.in-addr.arpa"), PTR, IN, 300,
+			&RdName{Domain("one.test")}}},
+	})
+	s.fallback = func(h *IPv4, n *Name, t uint16) *Msg {
+		if n.Equal(Domain("3.2.0.192.in-addr.arpa")) {
+			return nil // no response
+		}
+		return &Msg{Flags: F_RESPONSE | F_AA | RCODE_NAMEERROR}
+	}
+
+	prefix, err := ParsePrefix("192.0.2.0/30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := make(map[string]*PTRRow)
+	scanPTR(prefix, 3, func(p Prob) { s.SolveSub(p) }, func(r *PTRRow) {
+		rows[r.IP.String()] = r
+	})
+
+	if len(rows) != 4 {
+		t.Fatalf("%d rows", len(rows))
+	}
+	r := rows["192.0.2.1"]
+	if len(r.Names) != 1 || !r.Names[0].Equal(Domain("one.test")) ||
+		r.Rcode != RCODE_OKAY {
+		t.Errorf("192.0.2.1: %v, rcode %d", r.Names, r.Rcode)
+	}
+	for _, ip := range []string{"192.0.2.0", "192.0.2.2"} {
+		if r := rows[ip]; len(r.Names) != 0 || r.Rcode != RCODE_NAMEERROR {
+			t.Errorf("%s: %v, rcode %d", ip, r.Names, r.Rcode)
+		}
+	}
+	if r := rows["192.0.2.3"]; r.Rcode != -1 {
+		t.Errorf("192.0.2.3: rcode %d, expecting -1", r.Rcode)
+	}
+}
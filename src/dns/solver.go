@@ -1,6 +1,7 @@
 package dns
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -12,13 +13,25 @@ const (
 	_SOLVER_MAX_QUERY = 50
 )
 
+var errQueryLimit = errors.New("too many queries")
+
 // the instruction set that a problem can use
 type Solver interface {
 	Query(host *IPv4, name *Name, t uint16) (resp *Response)
+	QueryWith(host *IPv4, name *Name, t uint16,
+		opts *QueryOptions) (resp *Response)
+	// like Query, but also tells why there is no response
+	QueryErr(host *IPv4, name *Name, t uint16) (*Response, error)
 	SolveSub(p Prob) bool
 	Log(args ...string)
-	Cache(servers *Zone)
+	Cache(servers *Zone) // the solver decides if it is really cached
 	QueryCache(zone *Name) *Zone
+	Prepare(zone *Zone) []*NameServer
+	MarkLame(zone *Name, ip *IPv4, reason int) *LameRecord
+	CheckLame(zone *Name, ip *IPv4) *LameRecord
+	TrustAnchor() *TrustAnchor // nil if not validating
+	RootKeys() *RootKeyCache   // the validated root keys
+	Roots() *Zone              // the root servers to start from
 }
 
 // a solver solves a problem recursively
@@ -32,19 +45,32 @@ type solver struct {
 	log        io.Writer
 	signal     chan error
 	cache      *NSCache
+	policy     CachePolicy
+	selector   ServerSelector // nil for using the cache's rtt table
+	anchor     *TrustAnchor   // nil for not validating
+	rootKeys   *RootKeyCache
+	roots      *Zone
+	timeout    time.Duration // 0 for the default of the query options
+	tries      int
 	rootProb   Prob
 	checkpoint time.Time
 	depth      int
 	count      int
+	maxQuery   int
 }
 
 func newSolver(conn *Conn, log io.Writer) *solver {
 	return &solver{
-		conn:   conn,
-		p:      newPrinter(),
-		log:    log,
-		signal: make(chan error, 1),
-		cache:  TheCache,
+		conn:     conn,
+		p:        newPrinter(),
+		log:      log,
+		signal:   make(chan error, 1),
+		cache:    TheCache,
+		policy:   CacheRegistrars,
+		rootKeys: NewRootKeyCache(),
+		roots:    rootServers,
+		tries:    _SOLVER_RETRY,
+		maxQuery: _SOLVER_MAX_QUERY,
 	}
 }
 
@@ -52,6 +78,70 @@ func (s *solver) UseCache(c *NSCache) {
 	s.cache = c
 }
 
+func (s *solver) UseSelector(sel ServerSelector) {
+	s.selector = sel
+}
+
+// sets which delegations are cached, nil for CacheRegistrars
+func (s *solver) UsePolicy(p CachePolicy) {
+	if p == nil {
+		p = CacheRegistrars
+	}
+	s.policy = p
+}
+
+// sets the time to wait for a response and the times to send a query,
+// 0 for the defaults
+func (s *solver) UseTimeout(timeout time.Duration, tries int) {
+	if tries <= 0 {
+		tries = _SOLVER_RETRY
+	}
+	s.timeout = timeout
+	s.tries = tries
+}
+
+// sets the max number of queries for solving a problem
+func (s *solver) UseQueryLimit(n int) {
+	s.maxQuery = n
+}
+
+func (s *solver) UseTrustAnchor(t *TrustAnchor) {
+	s.anchor = t
+}
+
+// shares the validated root keys with other solvers of the same anchor
+func (s *solver) UseRootKeys(c *RootKeyCache) {
+	s.rootKeys = c
+}
+
+// sets the root servers, nil for the default ones
+func (s *solver) UseRoots(z *Zone) {
+	if z == nil {
+		z = rootServers
+	}
+	s.roots = z
+}
+
+func (s *solver) Roots() *Zone {
+	return s.roots
+}
+
+func (s *solver) TrustAnchor() *TrustAnchor {
+	return s.anchor
+}
+
+func (s *solver) RootKeys() *RootKeyCache {
+	return s.rootKeys
+}
+
+// queries ask for dnssec records when validating
+func (s *solver) queryOptions() *QueryOptions {
+	if s.anchor == nil {
+		return nil
+	}
+	return &QueryOptions{EDNSSize: 4096, DNSSEC: true}
+}
+
 func (s *solver) flushLog() {
 	if s.log != nil {
 		s.p.FlushTo(s.log)
@@ -80,34 +170,71 @@ func (s *solver) lapse(t time.Time) time.Duration {
 	return ret
 }
 
-func (s *solver) Query(h *IPv4, n *Name, t uint16) (resp *Response) {
-	if s.count >= _SOLVER_MAX_QUERY {
+func (s *solver) Query(h *IPv4, n *Name, t uint16) *Response {
+	return s.QueryWith(h, n, t, s.queryOptions())
+}
+
+func (s *solver) QueryWith(h *IPv4, n *Name, t uint16,
+	opts *QueryOptions) (resp *Response) {
+	resp, _ = s.query(h, n, t, opts)
+	return resp
+}
+
+func (s *solver) QueryErr(h *IPv4, n *Name, t uint16) (*Response, error) {
+	return s.query(h, n, t, s.queryOptions())
+}
+
+// returns the error of the last try when there is no response
+func (s *solver) query(h *IPv4, n *Name, t uint16,
+	opts *QueryOptions) (resp *Response, err error) {
+	if s.count >= s.maxQuery {
 		s.Log("err", fmt.Sprintf("too many queries (%d)", s.count))
-		return nil // max count
+		return nil, errQueryLimit // max count
 	}
 	s.count++
 
-	for i := 0; i < _SOLVER_RETRY; i++ {
+	if s.timeout > 0 && (opts == nil || opts.Timeout == 0) {
+		o := QueryOptions{}
+		if opts != nil {
+			o = *opts
+		}
+		o.Timeout = s.timeout
+		opts = &o
+	}
+
+	for i := 0; i < s.tries; i++ {
 		s.Log("q", n.String(), TypeStr(t),
 			fmt.Sprintf("@%s", h),
 			durationStr(s.lapse(time.Now())))
 		s.flushLog()
-		s.conn.SendQuery(h, n, t,
+		sent := time.Now()
+		s.conn.SendQueryOpts(h, n, t, opts,
 			func(r *Response, e error) {
 				resp = r
 				s.signal <- e
 			})
-		err := <-s.signal
+		err = <-s.signal
+		if err == ErrTimeout {
+			s.cache.RTT().Timeout(h)
+		}
+		if err == nil {
+			s.cache.RTT().Record(h, resp.RecvTime.Sub(sent))
+			if (resp.Msg.Flags & F_TC) != 0 {
+				// large dnssec answers do not fit in udp
+				s.Log("// truncated, retrying over tcp")
+				resp, err = queryTCP(h, n, t, opts)
+			}
+		}
 		if err == nil {
 			s.p.PrintIndent("a", durationStr(s.lapse(resp.RecvTime)))
 			resp.Msg.printTo(s.p)
 			s.p.EndIndent()
-			return
+			return resp, nil
 		}
 		s.Log("err", err.Error(), durationStr(s.lapse(time.Now())))
 	}
 
-	return nil
+	return nil, err
 }
 
 func (s *solver) SolveSub(p Prob) bool {
@@ -144,9 +271,32 @@ func (s *solver) Log(args ...string) {
 }
 
 func (s *solver) Cache(zone *Zone) {
+	if !s.policy.ShouldCache(zone.Name()) {
+		return
+	}
+	s.Log("// caching for zone:", zone.Name().String())
 	s.cache.Add(zone)
 }
 
+func (s *solver) Prepare(zone *Zone) []*NameServer {
+	if s.selector == nil {
+		return zone.PrepareWith(s.cache.RTT())
+	}
+	return zone.PrepareWith(s.selector)
+}
+
+func (s *solver) MarkLame(zone *Name, ip *IPv4, reason int) *LameRecord {
+	return s.cache.Lame().Add(zone, ip, reason)
+}
+
+func (s *solver) CheckLame(zone *Name, ip *IPv4) *LameRecord {
+	return s.cache.Lame().Check(zone, ip)
+}
+
 func (s *solver) QueryCache(name *Name) *Zone {
-	return s.cache.Query(name)
+	ret := s.cache.Query(name)
+	if ret != nil {
+		s.Log("// cache hit:", ret.Name().String())
+	}
+	return ret
 }
@@ -0,0 +1,128 @@
+package dns
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSolverTimeout(t *testing.T) {
+	// a server that never answers
+	silent, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("no udp:", err)
+	}
+	defer silent.Close()
+	port := uint16(silent.LocalAddr().(*net.UDPAddr).Port)
+
+	conn := NewConn()
+	defer conn.Close()
+	log := new(bytes.Buffer)
+	s := newSolver(conn, log)
+	cache := NewNSCache()
+	defer cache.Close()
+	s.UseCache(cache)
+	s.UseTimeout(100*time.Millisecond, 2)
+
+	start := time.Now()
+	resp := s.QueryWith(ParseIP("127.0.0.1"), Domain("a.test"), A,
+		&QueryOptions{Port: port})
+	if resp != nil {
+		t.Fatal("answered")
+	}
+	s.flushLog()
+	if n := strings.Count(log.String(), "time out"); n != 2 {
+		t.Errorf("%d time outs:\n%s", n, log)
+	}
+	if d := time.Since(start); d > 3*time.Second {
+		t.Errorf("took %s", d)
+	}
+}
+
+// answers every query with the records on a local port, truncated over
+// udp and in full over tcp
+func truncServer(t *testing.T, rrs []RR) uint16 {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("no tcp:", err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	u, err := net.ListenPacket("udp", l.Addr().String())
+	if err != nil {
+		l.Close()
+		t.Skip("no udp on the tcp port:", err)
+	}
+	answer := func(buf []byte, tc bool) []byte {
+		q, err := ParseMsg(buf)
+		if err != nil {
+			return nil
+		}
+		m := &Msg{ID: q.ID, Flags: F_RESPONSE | F_AA | q.Flags&F_RD,
+			Ques: q.Ques}
+		if tc {
+			m.Flags |= F_TC
+		} else {
+			m.Answ = rrs
+		}
+		wire, _ := m.Wire()
+		return wire
+	}
+
+	go func() {
+		defer u.Close()
+		buf := make([]byte, 65536)
+		for {
+			u.SetReadDeadline(time.Now().Add(10 * time.Second))
+			n, addr, err := u.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			u.WriteTo(answer(buf[:n], true), addr)
+		}
+	}()
+	go func() {
+		defer l.Close()
+		l.(*net.TCPListener).SetDeadline(time.Now().Add(10 * time.Second))
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			var size [2]byte
+			if _, err := io.ReadFull(conn, size[:]); err == nil {
+				buf := make([]byte, binary.BigEndian.Uint16(size[:]))
+				if _, err := io.ReadFull(conn, buf); err == nil {
+					wire := answer(buf, false)
+					binary.BigEndian.PutUint16(size[:], uint16(len(wire)))
+					conn.Write(append(size[:], wire...))
+				}
+			}
+			conn.Close()
+		}
+	}()
+	return uint16(port)
+}
+
+func TestSolverTruncated(t *testing.T) {
+	port := truncServer(t, []RR{aRR("a.test", "192.0.2.1")})
+
+	conn := NewConn()
+	defer conn.Close()
+	s := newSolver(conn, nil)
+	cache := NewNSCache()
+	defer cache.Close()
+	s.UseCache(cache)
+
+	resp := s.QueryWith(ParseIP("127.0.0.1"), Domain("a.test"), A,
+		&QueryOptions{Port: port, EDNSSize: 4096, DNSSEC: true})
+	if resp == nil {
+		t.Fatal("no response")
+	}
+	if (resp.Msg.Flags&F_TC) != 0 || len(resp.Msg.Answ) != 1 {
+		t.Errorf("truncated response: %v", resp.Msg)
+	}
+}
@@ -0,0 +1,200 @@
+package dns
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// the edns buffer size of stub queries, which avoids fragmentation
+const _STUB_EDNS_SIZE = 1232
+
+// a stub resolver, which asks the recursive servers of a resolv.conf
+// instead of walking from the roots
+type StubClient struct {
+	conn *Conn
+	conf *ResolvConf
+
+	lock sync.Mutex
+	next int // the first server for rotating
+}
+
+func NewStubClient(conf *ResolvConf) *StubClient {
+	return &StubClient{conn: NewConn(), conf: conf}
+}
+
+// a stub client with the system settings, or the defaults if there are
+// none
+func NewSystemStubClient() *StubClient {
+	conf, err := LoadResolvConf(RESOLV_CONF)
+	if err != nil {
+		conf = DefaultResolvConf()
+	}
+	return NewStubClient(conf)
+}
+
+func (c *StubClient) Close() {
+	c.conn.Close()
+}
+
+// the servers in the order to try
+func (c *StubClient) servers() []*IPv4 {
+	servers := c.conf.Servers
+	if !c.conf.Rotate || len(servers) < 2 {
+		return servers
+	}
+	c.lock.Lock()
+	start := c.next % len(servers)
+	c.next++
+	c.lock.Unlock()
+
+	ret := make([]*IPv4, 0, len(servers))
+	ret = append(ret, servers[start:]...)
+	return append(ret, servers[:start]...)
+}
+
+// a stub lookup, logging like a solver
+type stubLookup struct {
+	c          *StubClient
+	p          *printer
+	checkpoint time.Time
+}
+
+func (l *stubLookup) lapse(t time.Time) string {
+	ret := t.Sub(l.checkpoint)
+	l.checkpoint = t
+	return durationStr(ret)
+}
+
+func (l *stubLookup) query(h *IPv4, n *Name, t uint16) (*Response, error) {
+	opts := &QueryOptions{
+		Recursion: true,
+		EDNSSize:  _STUB_EDNS_SIZE,
+		Port:      l.c.conf.Port,
+		Timeout:   l.c.conf.Timeout,
+	}
+	l.p.Print("q", n.String(), TypeStr(t), fmt.Sprintf("@%s", h),
+		l.lapse(time.Now()))
+
+	var resp *Response
+	signal := make(chan error, 1)
+	l.c.conn.SendQueryOpts(h, n, t, opts, func(r *Response, e error) {
+		resp = r
+		signal <- e
+	})
+	err := <-signal
+	if err == nil && (resp.Msg.Flags&F_TC) != 0 {
+		l.p.Print("// truncated, retrying over tcp")
+		resp, err = queryTCP(h, n, t, opts)
+	}
+	if err != nil {
+		l.p.Print("err", err.Error(), l.lapse(time.Now()))
+		return nil, err
+	}
+	l.p.PrintIndent("a", l.lapse(resp.RecvTime))
+	resp.Msg.printTo(l.p)
+	l.p.EndIndent()
+	return resp, nil
+}
+
+// asks the servers in turn until one answers with no error or a name
+// error; fills the history of the problem
+func (l *stubLookup) ask(p *ProbRecur) *Msg {
+	for i := 0; i < l.c.conf.Attempts; i++ {
+		for _, h := range l.c.servers() {
+			issued := time.Now()
+			resp, err := l.query(h, p.n, p.t)
+			p.History = append(p.History,
+				&QueryRecord{h, p.n, p.t, nil, issued, resp})
+			if err != nil {
+				continue
+			}
+			rcode := int(resp.Msg.Flags & F_RCODEMASK)
+			if rcode == RCODE_OKAY || rcode == RCODE_NAMEERROR {
+				return resp.Msg
+			}
+			l.p.Print("// failing over:", RcodeStr(rcode))
+		}
+	}
+	return nil
+}
+
+// follows the cnames in the answer, and sets the answer code
+func (l *stubLookup) solve(p *ProbRecur) {
+	msg := l.ask(p)
+	if msg == nil {
+		p.AnsCode = NORESP
+		return
+	}
+	p.Answer = msg
+
+	target := p.n
+	for {
+		var next *Name
+		for i := range msg.Answ {
+			rr := &msg.Answ[i]
+			if rr.Type == CNAME && p.t != CNAME && rr.Name.Equal(target) {
+				next = rr.Rdata.(*RdName).Name
+				p.Chain = append(p.Chain, rr)
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+		if len(p.Chain) > _MAX_CHAIN {
+			p.AnsCode = BADCHAIN
+			return
+		}
+		target = next
+	}
+	p.target = target
+
+	for i := range msg.Answ {
+		rr := &msg.Answ[i]
+		if rr.Name.Equal(target) && (rr.Type == p.t || p.t == ANY) {
+			p.AnsCode = OKAY
+			return
+		}
+	}
+	p.AnsCode = NONEXIST
+}
+
+// tries the names in turn, and returns the result of the first one that
+// exists, or of the last one
+func (c *StubClient) lookup(names []*Name, t uint16,
+	logTo io.Writer) *ProbRecur {
+	l := &stubLookup{c: c, p: newPrinter(), checkpoint: time.Now()}
+	var p *ProbRecur
+	for _, n := range names {
+		p = NewProbRecur(n, t)
+		l.p.Print("stub", n.String(), TypeStr(t))
+		l.p.Indent()
+		l.solve(p)
+		l.p.EndIndent()
+		if logTo != nil {
+			l.p.FlushTo(logTo)
+		}
+		if p.AnsCode != NONEXIST {
+			break
+		}
+	}
+	return p
+}
+
+// looks up the name with the search list applied
+func (c *StubClient) Query(name string, t uint16,
+	logTo io.Writer) (*ProbRecur, error) {
+	names, err := c.conf.Names(name)
+	if err != nil {
+		return nil, err
+	}
+	return c.lookup(names, t, logTo), nil
+}
+
+// looks up the absolute name, without the search list
+func (c *StubClient) RecurQuery(n *Name, t uint16,
+	logTo io.Writer) *ProbRecur {
+	return c.lookup([]*Name{n}, t, logTo)
+}
@@ -0,0 +1,157 @@
+package dns
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseResolvConf(t *testing.T) {
+	conf, err := ParseResolvConf(strings.NewReader(`
+# comment
+nameserver 192.0.2.1
+nameserver 2001:db8::1
+nameserver 192.0.2.2 ; trailing
+domain old.test
+search corp.test example.test
+options ndots:2 timeout:3 attempts:9 rotate unknown
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conf.Servers) != 2 || !conf.Servers[1].Equal(ParseIP("192.0.2.2")) {
+		t.Errorf("servers: %v", conf.Servers)
+	}
+	if len(conf.Search) != 2 || !conf.Search[0].Equal(Domain("corp.test")) {
+		t.Errorf("search: %v", conf.Search)
+	}
+	if conf.Ndots != 2 || conf.Timeout != 3*time.Second ||
+		conf.Attempts != _RESOLV_MAX_ATTEMPTS || !conf.Rotate {
+		t.Errorf("options: %+v", conf)
+	}
+
+	for _, c := range []struct {
+		name string
+		want []string
+	}{
+		{"www", []string{"www.corp.test", "www.example.test", "www"}},
+		{"a.b.c", []string{"a.b.c", "a.b.c.corp.test", "a.b.c.example.test"}},
+		{"www.", []string{"www"}},
+	} {
+		names, err := conf.Names(c.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make([]string, len(names))
+		for i, n := range names {
+			got[i] = n.String()
+		}
+		if strings.Join(got, " ") != strings.Join(c.want, " ") {
+			t.Errorf("%s: %v, expecting %v", c.name, got, c.want)
+		}
+	}
+
+	conf, err = ParseResolvConf(strings.NewReader("options ndots:x\n"))
+	if err == nil {
+		t.Error("bad ndots parsed")
+	}
+}
+
+// answers recursive queries on a local udp port from the answer records
+// by name, with a name error for the unknown names
+func stubServer(t *testing.T, answers map[string][]RR) uint16 {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("no udp:", err)
+	}
+	go func() {
+		defer conn.Close()
+		buf := make([]byte, 65536)
+		for {
+			conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			q, err := ParseMsg(buf[:n])
+			if err != nil || (q.Flags&F_RD) == 0 {
+				continue
+			}
+			m := &Msg{ID: q.ID, Flags: F_RESPONSE | F_RD | F_RA,
+				Ques: q.Ques}
+			if rrs, ok := answers[q.Ques[0].Name.String()]; ok {
+				m.Answ = rrs
+			} else {
+				m.Flags |= RCODE_NAMEERROR
+			}
+			wire, _ := m.Wire()
+			conn.WriteTo(wire, addr)
+		}
+	}()
+	return uint16(conn.LocalAddr().(*net.UDPAddr).Port)
+}
+
+func TestStubClient(t *testing.T) {
+	port := stubServer(t, map[string][]RR{
+		"www.corp.test": {
+			{Domain("www.corp.test"), CNAME, IN, 300,
+				&RdName{Domain("web.corp.test")}},
+			aRR("web.corp.test", "192.0.2.80"),
+		},
+	})
+	conf := DefaultResolvConf()
+	conf.Port = port
+	conf.Search = []*Name{Domain("other.test"), Domain("corp.test")}
+	conf.Timeout = time.Second
+	c := NewStubClient(conf)
+	defer c.Close()
+
+	p, err := c.Query("www", A, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.AnsCode != OKAY || !p.Target().Equal(Domain("web.corp.test")) ||
+		len(p.Chain) != 1 || len(p.History) != 1 {
+		t.Errorf("www: code %d, target %s, %d queries", p.AnsCode,
+			p.Target(), len(p.History))
+	}
+
+	p = c.RecurQuery(Domain("www"), A, nil)
+	if p.AnsCode != NONEXIST {
+		t.Errorf("absolute www: code %d", p.AnsCode)
+	}
+}
+
+func TestStubFailover(t *testing.T) {
+	port := stubServer(t, map[string][]RR{
+		"a.test": {aRR("a.test", "192.0.2.1")},
+	})
+	conf := DefaultResolvConf()
+	conf.Port = port
+	conf.Servers = []*IPv4{ParseIP("127.0.0.2"), ParseIP("127.0.0.1")}
+	conf.Timeout = 200 * time.Millisecond
+	conf.Attempts = 1
+	c := NewStubClient(conf)
+	defer c.Close()
+
+	p := c.RecurQuery(Domain("a.test"), A, nil)
+	if p.AnsCode != OKAY || len(p.History) != 2 {
+		t.Errorf("code %d after %d queries", p.AnsCode, len(p.History))
+	}
+}
+
+func TestStubTruncated(t *testing.T) {
+	port := truncServer(t, []RR{aRR("big.test", "192.0.2.1")})
+	conf := DefaultResolvConf()
+	conf.Servers = []*IPv4{ParseIP("127.0.0.1")}
+	conf.Port = port
+	conf.Timeout = time.Second
+	c := NewStubClient(conf)
+	defer c.Close()
+
+	p := c.RecurQuery(Domain("big.test"), A, nil)
+	if p.AnsCode != OKAY || (p.Answer.Flags&F_TC) != 0 {
+		t.Errorf("code %d, answer %v", p.AnsCode, p.Answer)
+	}
+}
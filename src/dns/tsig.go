@@ -0,0 +1,513 @@
+package dns
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+	"sync"
+	"time"
+)
+
+// transaction signatures, see rfc8945
+
+// tsig algorithms
+var (
+	HmacMD5    = Domain("hmac-md5.sig-alg.reg.int")
+	HmacSHA1   = Domain("hmac-sha1")
+	HmacSHA256 = Domain("hmac-sha256")
+)
+
+// tsig error codes
+const (
+	TSIG_BADSIG   = 16
+	TSIG_BADKEY   = 17
+	TSIG_BADTIME  = 18
+	TSIG_BADTRUNC = 22
+)
+
+// the default allowed time difference in seconds
+const _TSIG_FUDGE = 300
+
+// max number of unsigned messages between signed ones in a stream
+const _TSIG_MAX_UNSIGNED = 99
+
+var (
+	errTsigMissing  = errors.New("tsig: message not signed")
+	errTsigNotLast  = errors.New("tsig: not the last record")
+	errTsigUnsigned = errors.New("tsig: too many unsigned messages")
+	errTsigAlg      = errors.New("tsig: unknown algorithm")
+)
+
+// a failed tsig check, with the error code to answer
+type TsigError struct {
+	Code int
+	s    string
+}
+
+func (e *TsigError) Error() string {
+	return "tsig: " + e.s
+}
+
+// for tsig records
+type RdTSIG struct {
+	Algorithm  *Name
+	TimeSigned uint64 // 48 bits
+	Fudge      uint16
+	MAC        []byte
+	OrigID     uint16
+	Error      uint16
+	Other      []byte
+}
+
+func (rd *RdTSIG) printOut() ([]string, func(p *printer)) {
+	return []string{
+		rd.Algorithm.String(),
+		sigTimeStr(uint32(rd.TimeSigned)),
+		fmt.Sprintf("%d", rd.Fudge),
+		base64.StdEncoding.EncodeToString(rd.MAC),
+		fmt.Sprintf("%d", rd.OrigID),
+		fmt.Sprintf("%d", rd.Error),
+	}, nil
+}
+
+func (w *writer) writeUint48(i uint64) {
+	w.writeUint16(uint16(i >> 32))
+	w.writeUint32(uint32(i))
+}
+
+// the time and fudge, which are all that is signed in the middle of a
+// multi-message stream
+func (rd *RdTSIG) writeTimers(w *writer) {
+	w.writeUint48(rd.TimeSigned)
+	w.writeUint16(rd.Fudge)
+}
+
+func (rd *RdTSIG) writeTo(w *writer) error {
+	w.writeName(rd.Algorithm)
+	rd.writeTimers(w)
+	w.writeUint16(uint16(len(rd.MAC)))
+	w.writeBytes(rd.MAC)
+	w.writeUint16(rd.OrigID)
+	w.writeUint16(rd.Error)
+	w.writeUint16(uint16(len(rd.Other)))
+	w.writeBytes(rd.Other)
+	return nil
+}
+
+func (r *reader) readUint16Sized() ([]byte, error) {
+	size, err := r.readUint16()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]byte, size)
+	if size == 0 {
+		return ret, nil
+	}
+	if err = r.readBytes(ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (rd *RdTSIG) readFrom(r *reader, n uint16) (err error) {
+	if rd.Algorithm, err = r.readName(); err != nil {
+		return err
+	}
+	hi, err := r.readUint16()
+	if err != nil {
+		return err
+	}
+	lo, err := r.readUint32()
+	if err != nil {
+		return err
+	}
+	rd.TimeSigned = uint64(hi)<<32 | uint64(lo)
+	if rd.Fudge, err = r.readUint16(); err != nil {
+		return err
+	}
+	if rd.MAC, err = r.readUint16Sized(); err != nil {
+		return err
+	}
+	if rd.OrigID, err = r.readUint16(); err != nil {
+		return err
+	}
+	if rd.Error, err = r.readUint16(); err != nil {
+		return err
+	}
+	rd.Other, err = r.readUint16Sized()
+	return err
+}
+
+// a shared secret for signing messages
+type TsigKey struct {
+	Name      *Name
+	Algorithm *Name
+	Secret    []byte
+}
+
+func tsigAlg(s string) *Name {
+	switch strings.ToLower(strings.TrimSuffix(s, ".")) {
+	case "hmac-md5", "hmac-md5.sig-alg.reg.int":
+		return HmacMD5
+	case "hmac-sha1":
+		return HmacSHA1
+	case "hmac-sha256":
+		return HmacSHA256
+	}
+	return nil
+}
+
+// makes a key from a name, an algorithm like "hmac-sha256" and the
+// secret in base64
+func NewTsigKey(name, alg, secret string) (*TsigKey, error) {
+	n, err := NewName(name)
+	if err != nil {
+		return nil, err
+	}
+	a := tsigAlg(alg)
+	if a == nil {
+		return nil, errTsigAlg
+	}
+	s, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, err
+	}
+	return &TsigKey{n, a, s}, nil
+}
+
+// parses a key in the form of "[alg:]name:secret", the algorithm is
+// hmac-sha256 by default
+func ParseTsigKey(s string) (*TsigKey, error) {
+	fields := strings.Split(s, ":")
+	switch len(fields) {
+	case 2:
+		return NewTsigKey(fields[0], "hmac-sha256", fields[1])
+	case 3:
+		return NewTsigKey(fields[1], fields[0], fields[2])
+	}
+	return nil, fmt.Errorf("tsig: bad key %q", s)
+}
+
+func (k *TsigKey) newHash() hash.Hash {
+	switch {
+	case k.Algorithm.Equal(HmacMD5):
+		return hmac.New(md5.New, k.Secret)
+	case k.Algorithm.Equal(HmacSHA1):
+		return hmac.New(sha1.New, k.Secret)
+	case k.Algorithm.Equal(HmacSHA256):
+		return hmac.New(sha256.New, k.Secret)
+	}
+	return nil
+}
+
+// keys by name, safe for concurrent use
+type TsigKeyStore struct {
+	lock sync.RWMutex
+	keys map[string]*TsigKey
+}
+
+func NewTsigKeyStore() *TsigKeyStore {
+	return &TsigKeyStore{keys: make(map[string]*TsigKey)}
+}
+
+func (s *TsigKeyStore) Add(k *TsigKey) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.keys[k.Name.String()] = k
+}
+
+func (s *TsigKeyStore) Remove(name *Name) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.keys, name.String())
+}
+
+func (s *TsigKeyStore) Get(name *Name) *TsigKey {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.keys[name.String()]
+}
+
+// writes the data that the mac covers
+// prevMAC is the mac of the request for a response, or of the previous
+// message in a stream; only the timers are covered in a stream
+func tsigData(h hash.Hash, prevMAC []byte, wire []byte, key *Name,
+	rd *RdTSIG, timersOnly bool) {
+	w := &writer{canonical: true}
+	if prevMAC != nil {
+		w.writeUint16(uint16(len(prevMAC)))
+		w.writeBytes(prevMAC)
+	}
+	w.writeBytes(wire)
+	if timersOnly {
+		rd.writeTimers(w)
+	} else {
+		w.writeName(key)
+		w.writeUint16(ANY)
+		w.writeUint32(0)
+		w.writeName(rd.Algorithm)
+		rd.writeTimers(w)
+		w.writeUint16(rd.Error)
+		w.writeUint16(uint16(len(rd.Other)))
+		w.writeBytes(rd.Other)
+	}
+	h.Write(w.wire())
+}
+
+// signs the wire of a message, and returns the wire with the tsig
+// record appended, and the mac
+// extra is the unsigned messages before it in a stream, covered too
+func signWire(extra, wire []byte, id uint16, key *TsigKey, prevMAC []byte,
+	now time.Time, timersOnly bool) ([]byte, []byte, error) {
+	h := key.newHash()
+	if h == nil {
+		return nil, nil, errTsigAlg
+	}
+	rd := &RdTSIG{
+		Algorithm:  key.Algorithm,
+		TimeSigned: uint64(now.Unix()),
+		Fudge:      _TSIG_FUDGE,
+		OrigID:     id,
+	}
+	tsigData(h, prevMAC, append(extra, wire...), key.Name, rd, timersOnly)
+	rd.MAC = h.Sum(nil)
+
+	w := new(writer)
+	w.writeBytes(wire)
+	if err := w.writeRR(&RR{key.Name, TSIG, ANY, 0, rd}); err != nil {
+		return nil, nil, err
+	}
+	ret := w.wire()
+	arcount := binary.BigEndian.Uint16(ret[10:])
+	binary.BigEndian.PutUint16(ret[10:], arcount+1)
+	return ret, rd.MAC, nil
+}
+
+// the wire of the message signed with the key, and the mac
+// requestMAC is the mac of the request when signing a response, nil
+// when signing a request
+func (m *Msg) WireTSIG(key *TsigKey, requestMAC []byte) (
+	wire []byte, mac []byte, err error) {
+	if wire, err = m.Wire(); err != nil {
+		return nil, nil, err
+	}
+	return signWire(nil, wire, m.ID, key, requestMAC, time.Now(), false)
+}
+
+// a parsed message with its tsig record taken out
+type tsigMsg struct {
+	msg      *Msg
+	owner    *Name
+	rd       *RdTSIG
+	unsigned []byte // the wire without tsig, with the original id
+}
+
+func parseTSIG(buf []byte) (*tsigMsg, error) {
+	r := newReader(buf)
+	msg := new(Msg)
+	if err := r.readMsg(msg); err != nil {
+		return nil, err
+	}
+	ret := &tsigMsg{msg: msg}
+
+	n := len(msg.Addi)
+	for i, rr := range msg.Addi {
+		if rr.Type == TSIG && i != n-1 {
+			return nil, errTsigNotLast
+		}
+	}
+	if n == 0 || msg.Addi[n-1].Type != TSIG {
+		return ret, nil
+	}
+
+	last := msg.Addi[n-1]
+	ret.owner = last.Name
+	ret.rd = last.Rdata.(*RdTSIG)
+	msg.Addi = msg.Addi[:n-1]
+
+	ret.unsigned = make([]byte, r.rrStart)
+	copy(ret.unsigned, buf)
+	binary.BigEndian.PutUint16(ret.unsigned, ret.rd.OrigID)
+	binary.BigEndian.PutUint16(ret.unsigned[10:], uint16(n-1))
+	return ret, nil
+}
+
+// checks the mac and the time of a signed message
+func (t *tsigMsg) verify(key *TsigKey, prevMAC []byte, extra []byte,
+	timersOnly bool, now time.Time) error {
+	if !t.owner.Equal(key.Name) || !t.rd.Algorithm.Equal(key.Algorithm) {
+		return &TsigError{TSIG_BADKEY, "unknown key " + t.owner.String()}
+	}
+	h := key.newHash()
+	if h == nil {
+		return errTsigAlg
+	}
+	if t.rd.Error != 0 {
+		return &TsigError{int(t.rd.Error),
+			fmt.Sprintf("error %d from the peer", t.rd.Error)}
+	}
+
+	tsigData(h, prevMAC, append(extra, t.unsigned...), key.Name, t.rd,
+		timersOnly)
+	expect := h.Sum(nil)
+	mac := t.rd.MAC
+	if len(mac) < len(expect) {
+		// truncated macs, rfc8945 section 5.2.2.1
+		if len(mac) < 10 || len(mac) < len(expect)/2 {
+			return &TsigError{TSIG_BADTRUNC, "mac truncated too much"}
+		}
+		expect = expect[:len(mac)]
+	}
+	if !hmac.Equal(mac, expect) {
+		return &TsigError{TSIG_BADSIG, "bad signature"}
+	}
+
+	diff := now.Unix() - int64(t.rd.TimeSigned)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > int64(t.rd.Fudge) {
+		return &TsigError{TSIG_BADTIME, "signed time out of the fudge"}
+	}
+	return nil
+}
+
+// verifies a message signed with the key; requestMAC is the mac of the
+// request when verifying a response
+// returns the message without the tsig record, and its mac
+func VerifyTSIG(buf []byte, key *TsigKey, requestMAC []byte) (
+	*Msg, []byte, error) {
+	t, err := parseTSIG(buf)
+	if err != nil {
+		return nil, nil, err
+	}
+	if t.rd == nil {
+		return nil, nil, errTsigMissing
+	}
+	if err = t.verify(key, requestMAC, nil, false, time.Now()); err != nil {
+		return nil, nil, err
+	}
+	return t.msg, t.rd.MAC, nil
+}
+
+// verifies a request with the key of its name in the store, for servers
+// returns the key to sign the response with and the mac of the request;
+// a TsigError tells the code to answer with
+func (s *TsigKeyStore) VerifyRequest(buf []byte) (*Msg, *TsigKey, []byte,
+	error) {
+	t, err := parseTSIG(buf)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if t.rd == nil {
+		return nil, nil, nil, errTsigMissing
+	}
+	key := s.Get(t.owner)
+	if key == nil {
+		return nil, nil, nil,
+			&TsigError{TSIG_BADKEY, "unknown key " + t.owner.String()}
+	}
+	if err = t.verify(key, nil, nil, false, time.Now()); err != nil {
+		return nil, nil, nil, err
+	}
+	return t.msg, key, t.rd.MAC, nil
+}
+
+// signs or verifies the messages of a multi-message response, like a
+// zone transfer, rfc8945 section 5.3.1
+// a stream is used for one direction only
+type TsigStream struct {
+	key      *TsigKey
+	prevMAC  []byte
+	first    bool
+	unsigned bytes.Buffer // messages since the last signed one
+	nUnsign  int
+}
+
+// requestMAC is the mac of the request that the stream answers
+func NewTsigStream(key *TsigKey, requestMAC []byte) *TsigStream {
+	return &TsigStream{key: key, prevMAC: requestMAC, first: true}
+}
+
+// signs the next message of the stream, and returns its wire
+func (s *TsigStream) Sign(m *Msg) ([]byte, error) {
+	wire, err := m.Wire()
+	if err != nil {
+		return nil, err
+	}
+	ret, mac, err := signWire(s.unsigned.Bytes(), wire, m.ID, s.key,
+		s.prevMAC, time.Now(), !s.first)
+	if err != nil {
+		return nil, err
+	}
+	s.prevMAC = mac
+	s.first = false
+	s.unsigned.Reset()
+	s.nUnsign = 0
+	return ret, nil
+}
+
+// the wire of a message sent unsigned in the middle of the stream, which
+// the next signed message covers
+func (s *TsigStream) Unsigned(m *Msg) ([]byte, error) {
+	if s.first {
+		return nil, errTsigMissing
+	}
+	if s.nUnsign >= _TSIG_MAX_UNSIGNED {
+		return nil, errTsigUnsigned
+	}
+	wire, err := m.Wire()
+	if err != nil {
+		return nil, err
+	}
+	s.unsigned.Write(wire)
+	s.nUnsign++
+	return wire, nil
+}
+
+// verifies the next message of the stream; a message in the middle may
+// be unsigned, which is covered by the next signed one
+func (s *TsigStream) Verify(buf []byte) (*Msg, error) {
+	t, err := parseTSIG(buf)
+	if err != nil {
+		return nil, err
+	}
+	if t.rd == nil {
+		if s.first {
+			return nil, errTsigMissing
+		}
+		s.nUnsign++
+		if s.nUnsign > _TSIG_MAX_UNSIGNED {
+			return nil, errTsigUnsigned
+		}
+		s.unsigned.Write(buf)
+		return t.msg, nil
+	}
+
+	err = t.verify(s.key, s.prevMAC, s.unsigned.Bytes(), !s.first,
+		time.Now())
+	if err != nil {
+		return nil, err
+	}
+	s.prevMAC = t.rd.MAC
+	s.first = false
+	s.unsigned.Reset()
+	s.nUnsign = 0
+	return t.msg, nil
+}
+
+// checks that the last message of the stream is signed
+func (s *TsigStream) Done() error {
+	if s.first || s.nUnsign > 0 {
+		return errTsigMissing
+	}
+	return nil
+}
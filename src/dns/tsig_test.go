@@ -0,0 +1,222 @@
+package dns
+
+import (
+	"encoding/hex"
+	"net"
+	"testing"
+	"time"
+)
+
+func testTsigKey(t *testing.T, alg string) *TsigKey {
+	key, err := NewTsigKey("xfr.key", alg, "c2VjcmV0IGtleSBmb3IgdGVzdGluZw==")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func tsigCode(err error) int {
+	if e, ok := err.(*TsigError); ok {
+		return e.Code
+	}
+	return -1
+}
+
+func TestTSIG(t *testing.T) {
+	for _, alg := range []string{"hmac-md5", "hmac-sha1", "hmac-sha256"} {
+		key := testTsigKey(t, alg)
+		q := NewQuery(Domain("x.test"), AXFR)
+		wire, mac, err := q.WireTSIG(key, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		store := NewTsigKeyStore()
+		store.Add(key)
+		got, k, reqMAC, err := store.VerifyRequest(wire)
+		if err != nil || k != key || len(got.Addi) != 0 ||
+			string(reqMAC) != string(mac) {
+			t.Fatalf("%s: verify request: %v", alg, err)
+		}
+
+		// a response signed over the request mac
+		resp := &Msg{ID: q.ID, Flags: F_RESPONSE, Ques: q.Ques}
+		rwire, _, err := resp.WireTSIG(key, mac)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err = VerifyTSIG(rwire, key, mac); err != nil {
+			t.Errorf("%s: verify response: %v", alg, err)
+		}
+		if _, _, err = VerifyTSIG(rwire, key, nil); tsigCode(err) != TSIG_BADSIG {
+			t.Errorf("%s: response without request mac: %v", alg, err)
+		}
+	}
+}
+
+func TestTSIGErrors(t *testing.T) {
+	key := testTsigKey(t, "hmac-sha256")
+	q := NewQuery(Domain("x.test"), SOA)
+	wire, _, _ := q.WireTSIG(key, nil)
+
+	tampered := append([]byte{}, wire...)
+	tampered[2] ^= F_RD >> 8
+	if _, _, err := VerifyTSIG(tampered, key, nil); tsigCode(err) != TSIG_BADSIG {
+		t.Errorf("tampered: %v", err)
+	}
+
+	if _, _, _, err := NewTsigKeyStore().VerifyRequest(wire); tsigCode(err) != TSIG_BADKEY {
+		t.Errorf("unknown key: %v", err)
+	}
+
+	plain, _ := q.Wire()
+	if _, _, err := VerifyTSIG(plain, key, nil); err != errTsigMissing {
+		t.Errorf("unsigned: %v", err)
+	}
+
+	old, _, _ := signWire(nil, plain, q.ID, key, nil,
+		time.Now().Add(-time.Hour), false)
+	if _, _, err := VerifyTSIG(old, key, nil); tsigCode(err) != TSIG_BADTIME {
+		t.Errorf("old: %v", err)
+	}
+}
+
+// known answers computed by another implementation, the TsigGenerate
+// tests of github.com/miekg/dns
+func TestTSIGKnownAnswers(t *testing.T) {
+	key, err := NewTsigKey("testkey", "hmac-sha256",
+		"NoTCJU+DMqFWywaPyxSijrDEA/eC3nK0xi3AMEZuPVk=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &Msg{ID: 42, Flags: OPUPDATE, // the original id
+		Ques: []Ques{{Domain("example.com"), SOA, IN}}}
+	wire, err := m.Wire()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		prevMAC, other string
+		mac            string
+	}{
+		{"", "",
+			"385449a425c6d52b9bf2c65c0726eefa0ad8084cdaf488f24547e686605b9610"},
+		{"3684c225", "",
+			"c110e3f62694755c10761dc8717462431ee34340b7c9d1eee09449150757c5b1"},
+		{"3684c225", "666f6f",
+			"15b91571ca80b3b410a77e2b44f8cc4f35ace22b26020138439dd94803e23b5d"},
+	} {
+		rd := &RdTSIG{
+			Algorithm:  key.Algorithm,
+			TimeSigned: 1594855491,
+			Fudge:      300,
+			OrigID:     42,
+			Error:      TSIG_BADTIME,
+		}
+		rd.Other, _ = hex.DecodeString(c.other)
+		var prevMAC []byte
+		if c.prevMAC != "" {
+			prevMAC, _ = hex.DecodeString(c.prevMAC)
+		}
+		h := key.newHash()
+		tsigData(h, prevMAC, wire, key.Name, rd, false)
+		if mac := hex.EncodeToString(h.Sum(nil)); mac != c.mac {
+			t.Errorf("mac %s, expecting %s", mac, c.mac)
+		}
+	}
+}
+
+func TestTsigStream(t *testing.T) {
+	key := testTsigKey(t, "hmac-sha256")
+	reqMAC := []byte("request mac")
+	signer := NewTsigStream(key, reqMAC)
+	verifier := NewTsigStream(key, reqMAC)
+
+	for i := 0; i < 4; i++ {
+		m := &Msg{ID: 7, Flags: F_RESPONSE,
+			Answ: []RR{aRR("a.x.test", "192.0.2.1")}}
+		var wire []byte
+		if i == 2 {
+			wire, _ = signer.Unsigned(m) // unsigned in the middle
+		} else {
+			wire, _ = signer.Sign(m)
+		}
+		if _, err := verifier.Verify(wire); err != nil {
+			t.Fatalf("message %d: %v", i, err)
+		}
+	}
+	if err := verifier.Done(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestXfrTSIG(t *testing.T) {
+	key := testTsigKey(t, "hmac-sha256")
+	soa := soaRR("x.test", 5)
+	zone := []RR{soa, nsRR("x.test", "ns.x.test"), aRR("ns.x.test", "192.0.2.1")}
+	c := xfrServer(t, append(append([]RR{}, zone...), soa), 1, key)
+	got, err := c.AXFR(Domain("x.test"))
+	if err != nil || !sameRRs(got, zone) {
+		t.Errorf("signed axfr: %v %v", got, err)
+	}
+
+	c = xfrServer(t, append(append([]RR{}, zone...), soa), 1, key)
+	c.TSIG = testTsigKey(t, "hmac-sha1")
+	if _, err = c.AXFR(Domain("x.test")); err == nil {
+		t.Error("wrong key should fail")
+	}
+}
+
+// a spoofed unsigned response before the signed one must not fail the query
+func TestConnTSIG(t *testing.T) {
+	key := testTsigKey(t, "hmac-sha256")
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("no udp:", err)
+	}
+	defer conn.Close()
+	go func() {
+		buf := make([]byte, 65536)
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		store := NewTsigKeyStore()
+		store.Add(key)
+		q, k, mac, err := store.VerifyRequest(buf[:n])
+		if err != nil {
+			return
+		}
+		m := &Msg{ID: q.ID, Flags: F_RESPONSE, Ques: q.Ques,
+			Answ: []RR{aRR("x.test", "192.0.2.66")}}
+		spoofed, _ := m.Wire()
+		conn.WriteTo(spoofed, addr)
+
+		m.Answ = []RR{aRR("x.test", "192.0.2.1")}
+		signed, _, _ := m.WireTSIG(k, mac)
+		conn.WriteTo(signed, addr)
+	}()
+
+	c := NewConn()
+	c.LogTo(func(e error) {})
+	defer c.Close()
+	opts := &QueryOptions{
+		Port:    uint16(conn.LocalAddr().(*net.UDPAddr).Port),
+		Timeout: 2 * time.Second,
+		TSIG:    key,
+	}
+	done := make(chan *Response, 1)
+	c.SendQueryOpts(ParseIP("127.0.0.1"), Domain("x.test"), A, opts,
+		func(r *Response, e error) {
+			if e != nil {
+				t.Error(e)
+			}
+			done <- r
+		})
+	r := <-done
+	if r == nil || len(r.Msg.Answ) != 1 ||
+		r.Msg.Answ[0].Rdata.(*RdIP).IP.String() != "192.0.2.1" {
+		t.Errorf("response: %v", r)
+	}
+}
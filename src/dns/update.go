@@ -0,0 +1,154 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+)
+
+// dynamic updates, see rfc2136
+// the sections of an update message are the zone, the prerequisites,
+// the updates and the additional records, which are kept in the ques,
+// answ, auth and addi of a msg
+
+var (
+	errUpdateNotZone = errors.New("update: name not in the zone")
+	errUpdateReply   = errors.New("update: not an update response")
+)
+
+// an error rcode in an update response
+type UpdateError struct {
+	Rcode int
+}
+
+var updateErrStrs = map[int]string{
+	RCODE_FORMATERROR:  "format error",
+	RCODE_SERVERFAIL:   "server failure",
+	RCODE_NAMEERROR:    "name not in use",
+	RCODE_NOTIMPLEMENT: "update not implemented",
+	RCODE_REFUSED:      "refused",
+	RCODE_YXDOMAIN:     "name in use",
+	RCODE_YXRRSET:      "rrset exists",
+	RCODE_NXRRSET:      "rrset does not exist",
+	RCODE_NOTAUTH:      "server not authoritative for the zone",
+	RCODE_NOTZONE:      "name not in the zone",
+}
+
+func (e *UpdateError) Error() string {
+	if s, ok := updateErrStrs[e.Rcode]; ok {
+		return fmt.Sprintf("update: %s (%s)", s, RcodeStr(e.Rcode))
+	}
+	return fmt.Sprintf("update: rcode %d", e.Rcode)
+}
+
+// an update message being built
+type Update struct {
+	*Msg
+}
+
+// an empty update of the zone
+func NewUpdate(zone *Name) *Update {
+	m := &Msg{Flags: OPUPDATE, Ques: []Ques{{zone, SOA, IN}}}
+	m.RollAnID()
+	return &Update{m}
+}
+
+func (u *Update) Zone() *Name {
+	return u.Ques[0].Name
+}
+
+func (u *Update) prereq(n *Name, t, c uint16, rd Rdata) {
+	u.Answ = append(u.Answ, RR{n, t, c, 0, rd})
+}
+
+func (u *Update) update(rr RR) {
+	u.Auth = append(u.Auth, rr)
+}
+
+// requires the name to have some record
+func (u *Update) NameInUse(n *Name) {
+	u.prereq(n, ANY, ANY, &RdBytes{})
+}
+
+// requires the name to have no record
+func (u *Update) NameNotInUse(n *Name) {
+	u.prereq(n, ANY, NONE, &RdBytes{})
+}
+
+// requires the rrset to exist, whatever its records
+func (u *Update) RRsetExists(n *Name, t uint16) {
+	u.prereq(n, t, ANY, &RdBytes{})
+}
+
+// requires the rrset not to exist
+func (u *Update) RRsetNotExists(n *Name, t uint16) {
+	u.prereq(n, t, NONE, &RdBytes{})
+}
+
+// requires the rrset to be exactly the records, which must all have
+// the same name and type; the ttls are ignored
+func (u *Update) RRsetEquals(rrs ...RR) {
+	for _, rr := range rrs {
+		u.prereq(rr.Name, rr.Type, rr.Class, rr.Rdata)
+	}
+}
+
+// adds the record to its rrset
+func (u *Update) Add(rr RR) {
+	u.update(rr)
+}
+
+// deletes the record from its rrset
+func (u *Update) Delete(rr RR) {
+	u.update(RR{rr.Name, rr.Type, NONE, 0, rr.Rdata})
+}
+
+// deletes the rrset of the type at the name
+func (u *Update) DeleteRRset(n *Name, t uint16) {
+	u.update(RR{n, t, ANY, 0, &RdBytes{}})
+}
+
+// deletes all the rrsets at the name
+func (u *Update) DeleteName(n *Name) {
+	u.update(RR{n, ANY, ANY, 0, &RdBytes{}})
+}
+
+// checks that the prerequisites and the updates are in the zone
+func (u *Update) check() error {
+	zone := u.Zone()
+	for _, rrs := range [][]RR{u.Answ, u.Auth} {
+		for i := range rrs {
+			if !inZone(rrs[i].Name, zone) {
+				return errUpdateNotZone
+			}
+		}
+	}
+	return nil
+}
+
+// sends updates to the primary server of a zone
+type UpdateClient struct {
+	Exchanger
+}
+
+func NewUpdateClient(host *IPv4) *UpdateClient {
+	return &UpdateClient{*NewExchanger(host)}
+}
+
+// sends the update and waits for the response; an error rcode is
+// returned as an UpdateError
+func (c *UpdateClient) Send(u *Update) (*Msg, error) {
+	if err := u.check(); err != nil {
+		return nil, err
+	}
+	msg, err := c.Exchange(u.Msg)
+	if err != nil {
+		return nil, err
+	}
+	if (msg.Flags & F_OPMASK) != OPUPDATE {
+		return nil, errUpdateReply
+	}
+	if rcode := int(msg.Flags & F_RCODEMASK); rcode != RCODE_OKAY {
+		return msg, &UpdateError{rcode}
+	}
+	return msg, nil
+}
@@ -0,0 +1,109 @@
+package dns
+
+import (
+	"net"
+	"testing"
+)
+
+// answers one update on a local udp port with the rcode, signed if key
+// is not nil; got receives the parsed update
+func updateServer(t *testing.T, rcode uint16, key *TsigKey,
+	got chan<- *Msg) *UpdateClient {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("no udp:", err)
+	}
+	go func() {
+		defer conn.Close()
+		buf := make([]byte, 65536)
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		var q *Msg
+		var mac []byte
+		if key != nil {
+			store := NewTsigKeyStore()
+			store.Add(key)
+			if q, _, mac, err = store.VerifyRequest(buf[:n]); err != nil {
+				close(got)
+				return
+			}
+		} else if q, err = ParseMsg(buf[:n]); err != nil {
+			close(got)
+			return
+		}
+		got <- q
+
+		m := &Msg{ID: q.ID, Flags: F_RESPONSE | OPUPDATE | rcode,
+			Ques: q.Ques}
+		var wire []byte
+		if key != nil {
+			wire, _, _ = m.WireTSIG(key, mac)
+		} else {
+			wire, _ = m.Wire()
+		}
+		conn.WriteTo(wire, addr)
+	}()
+
+	c := NewUpdateClient(ParseIP("127.0.0.1"))
+	c.Port = uint16(conn.LocalAddr().(*net.UDPAddr).Port)
+	c.TSIG = key
+	return c
+}
+
+func TestUpdate(t *testing.T) {
+	u := NewUpdate(Domain("up.test"))
+	u.NameNotInUse(Domain("new.up.test"))
+	u.RRsetExists(Domain("www.up.test"), A)
+	u.Add(aRR("new.up.test", "192.0.2.1"))
+	u.Delete(aRR("www.up.test", "192.0.2.2"))
+	u.DeleteRRset(Domain("old.up.test"), TXT)
+	u.DeleteName(Domain("gone.up.test"))
+
+	got := make(chan *Msg, 1)
+	c := updateServer(t, RCODE_OKAY, testTsigKey(t, "hmac-sha256"), got)
+	if _, err := c.Send(u); err != nil {
+		t.Fatal(err)
+	}
+	q := <-got
+	if q == nil {
+		t.Fatal("update not verified")
+	}
+	if (q.Flags&F_OPMASK) != OPUPDATE || len(q.Ques) != 1 ||
+		q.Ques[0].Type != SOA {
+		t.Fatalf("zone section: %v", q)
+	}
+	classes := func(rrs []RR) (ret []uint16) {
+		for _, rr := range rrs {
+			ret = append(ret, rr.Class)
+		}
+		return ret
+	}
+	prereq, update := classes(q.Answ), classes(q.Auth)
+	if len(prereq) != 2 || prereq[0] != NONE || prereq[1] != ANY {
+		t.Errorf("prerequisites: %v", prereq)
+	}
+	if len(update) != 4 || update[0] != IN || update[1] != NONE ||
+		update[2] != ANY || update[3] != ANY || q.Auth[3].Type != ANY {
+		t.Errorf("updates: %v", update)
+	}
+}
+
+func TestUpdateErrors(t *testing.T) {
+	u := NewUpdate(Domain("up.test"))
+	u.Add(aRR("www.other.test", "192.0.2.1"))
+	if _, err := NewUpdateClient(ParseIP("127.0.0.1")).Send(u); err !=
+		errUpdateNotZone {
+		t.Errorf("out of zone: %v", err)
+	}
+
+	u = NewUpdate(Domain("up.test"))
+	u.RRsetExists(Domain("www.up.test"), A)
+	u.DeleteRRset(Domain("www.up.test"), A)
+	c := updateServer(t, RCODE_NXRRSET, nil, make(chan *Msg, 1))
+	_, err := c.Send(u)
+	if e, ok := err.(*UpdateError); !ok || e.Rcode != RCODE_NXRRSET {
+		t.Errorf("expecting nxrrset, got %v", err)
+	}
+}
@@ -0,0 +1,662 @@
+package dns
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// security status of a result
+const (
+	SEC_UNKNOWN  = iota // not validated
+	SEC_SECURE          // validated from the trust anchor
+	SEC_INSECURE        // under a delegation that is proven unsigned
+	SEC_BOGUS           // validation failed
+)
+
+var secStrs = map[int]string{
+	SEC_UNKNOWN:  "unknown",
+	SEC_SECURE:   "secure",
+	SEC_INSECURE: "insecure",
+	SEC_BOGUS:    "bogus",
+}
+
+func SecStr(s int) string {
+	if ret, ok := secStrs[s]; ok {
+		return ret
+	}
+	return fmt.Sprintf("sec%d", s)
+}
+
+// nsec3 records with more iterations are treated as insecure, rfc9276
+const _MAX_NSEC3_ITER = 150
+
+// the state of validation along the zones that a recursion walks
+type validator struct {
+	anchor   *TrustAnchor
+	zone     *Name       // the zone of the trusted keys
+	keys     []*RdDNSKEY // trusted keys of zone
+	rootKeys []*RdDNSKEY
+	dsZone   *Name   // the child zone that a validated referral points to
+	ds       []*RdDS // the validated ds of dsZone
+	state    int
+	reason   string
+}
+
+func newValidator(anchor *TrustAnchor) *validator {
+	return &validator{anchor: anchor, zone: rootName, state: SEC_SECURE}
+}
+
+func (v *validator) secure() bool {
+	return v.state == SEC_SECURE
+}
+
+func (v *validator) fail(a Solver, format string, args ...interface{}) {
+	if v.state == SEC_BOGUS {
+		return
+	}
+	v.state = SEC_BOGUS
+	v.reason = fmt.Sprintf(format, args...)
+	a.Log("// bogus:", v.reason)
+}
+
+func (v *validator) insecure(a Solver, format string, args ...interface{}) {
+	if v.state != SEC_SECURE {
+		return
+	}
+	v.state = SEC_INSECURE
+	v.reason = fmt.Sprintf(format, args...)
+	a.Log("// insecure:", v.reason)
+}
+
+// validated root keys, kept until their rrsigs or ttl expire; shared by
+// the problems of a solver or a client, so that the root dnskey set is
+// not fetched and verified again for every recursion
+type RootKeyCache struct {
+	lock   sync.Mutex
+	keys   []*RdDNSKEY
+	expire time.Time
+}
+
+func NewRootKeyCache() *RootKeyCache {
+	return new(RootKeyCache)
+}
+
+// the keys, nil if none or expired
+func (c *RootKeyCache) Get(now time.Time) []*RdDNSKEY {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !now.Before(c.expire) {
+		return nil
+	}
+	return c.keys
+}
+
+func (c *RootKeyCache) Put(keys []*RdDNSKEY, expire time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.keys = keys
+	c.expire = expire
+}
+
+// loads the root keys with the trust anchor, or from the cache
+func (v *validator) start(a Solver) {
+	cache := a.RootKeys()
+	now := time.Now()
+	if keys := cache.Get(now); keys != nil {
+		a.Log("// dnskey cached: .")
+		v.rootKeys = keys
+	} else {
+		keys, expire := v.fetchKeys(a, a.Roots(), v.anchor.DS, v.anchor.Keys)
+		if keys != nil {
+			cache.Put(keys, expire)
+		}
+		v.rootKeys = keys
+	}
+	v.restart()
+}
+
+// goes back to the root, for following a cname to another zone
+func (v *validator) restart() {
+	v.zone = rootName
+	v.keys = v.rootKeys
+	v.dsZone = nil
+	v.ds = nil
+}
+
+// loads the keys of a zone before querying it
+func (v *validator) enter(a Solver, zone *Zone) {
+	if !v.secure() || zone.Name().Equal(v.zone) {
+		return
+	}
+	if v.dsZone == nil || !v.dsZone.Equal(zone.Name()) {
+		v.fail(a, "no validated ds for %s", zone.Name())
+		return
+	}
+	ds := v.ds
+	v.dsZone, v.ds = nil, nil
+	if keys, _ := v.fetchKeys(a, zone, ds, nil); keys != nil {
+		v.zone = zone.Name()
+		v.keys = keys
+	}
+}
+
+// queries a server of the zone that answers
+func (v *validator) query(a Solver, zone *Zone, n *Name, t uint16) *Msg {
+	for _, server := range a.Prepare(zone) {
+		ips := server.IPs
+		if len(ips) == 0 {
+			addr := NewProbAddr(server.Name)
+			if !a.SolveSub(addr) {
+				continue
+			}
+			ips = addr.IPs
+		}
+		for _, ip := range ips {
+			if a.CheckLame(zone.Name(), ip) != nil {
+				continue
+			}
+			resp := a.Query(ip, n, t)
+			if resp != nil && lameReason(zone.Name(), resp.Msg) == 0 {
+				return resp.Msg
+			}
+		}
+	}
+	return nil
+}
+
+// true if the ds uses an algorithm and a digest that can be checked
+func supportedDS(ds *RdDS) bool {
+	if _, ok := hashFor(ds.Algorithm); !ok && ds.Algorithm != ALG_ED25519 {
+		return false
+	}
+	switch ds.DigestType {
+	case DIGEST_SHA1, DIGEST_SHA256, DIGEST_SHA384:
+		return true
+	}
+	return false
+}
+
+// fetches the dnskey set of a zone, and verifies it with the keys that
+// match the ds records or are trusted already
+// returns the keys and when they expire, by the ttl or the rrsigs
+func (v *validator) fetchKeys(a Solver, zone *Zone, ds []*RdDS,
+	trusted []*RdDNSKEY) ([]*RdDNSKEY, time.Time) {
+	var expire time.Time
+	name := zone.Name()
+	msg := v.query(a, zone, name, DNSKEY)
+	if msg == nil {
+		v.fail(a, "no dnskey response for %s", name)
+		return nil, expire
+	}
+
+	set := findRRset(msg, name, DNSKEY, ANSW)
+	keys := make([]*RdDNSKEY, 0, len(set.rrs))
+	for _, rr := range set.rrs {
+		keys = append(keys, rr.Rdata.(*RdDNSKEY))
+	}
+
+	sep := append([]*RdDNSKEY{}, trusted...)
+	for _, key := range keys {
+		for _, d := range ds {
+			if dsMatch(name, key, d) {
+				sep = append(sep, key)
+				break
+			}
+		}
+	}
+	if len(sep) == 0 {
+		v.fail(a, "no dnskey of %s matches the ds", name)
+		return nil, expire
+	}
+	now := time.Now()
+	if err := verifyRRset(set.rrs, set.sigs, name, sep, now); err != nil {
+		v.fail(a, "dnskey of %s: %s", name, err)
+		return nil, expire
+	}
+	a.Log("// dnskey verified:", name.String())
+
+	expire = now.Add(time.Duration(set.rrs[0].TTL) * time.Second)
+	for _, sig := range set.sigs {
+		if t := time.Unix(int64(sig.Expiration), 0); t.Before(expire) {
+			expire = t
+		}
+	}
+	return keys, expire
+}
+
+// validates a response of the current zone; found and redirect are the
+// results of looking for the answer in msg
+func (v *validator) check(a Solver, p *ProbRecur, msg *Msg, found bool,
+	redirect *Zone) {
+	if !v.secure() {
+		return
+	}
+	zone := p.current
+	if !v.checkAnswers(a, zone, msg) {
+		return
+	}
+
+	switch {
+	case found:
+		a.Log("// answer validated")
+	case redirect == nil:
+		if p.AnsCode != BADCHAIN {
+			v.checkDenial(a, msg, p.Target(), p.t)
+		}
+	case redirect != a.Roots() && redirect.Name().SubOf(zone.Name()):
+		v.checkReferral(a, msg, redirect.Name())
+	default:
+		v.restart() // a cname that leads to another zone
+	}
+}
+
+func inZone(n, zone *Name) bool {
+	return n.Equal(zone) || n.SubOf(zone)
+}
+
+// verifies the in-zone rrsets in the answer section
+func (v *validator) checkAnswers(a Solver, zone *Zone, msg *Msg) bool {
+	types := make(map[uint16]bool)
+	msg.ForEachIN(func(rr *RR, seg int) {
+		if seg == ANSW && rr.Type != RRSIG {
+			types[rr.Type] = true
+		}
+	})
+
+	for t := range types {
+		for _, set := range findRRsets(msg, t, ANSW) {
+			owner := set.rrs[0].Name
+			if !inZone(owner, v.zone) {
+				continue // not used, the cname target is resolved again
+			}
+			if t == CNAME && len(set.sigs) == 0 && synthesized(msg, owner) {
+				continue // the dname is verified instead
+			}
+			if !v.checkSet(a, zone, msg, set) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// true if a dname in the message redirects the name
+func synthesized(msg *Msg, n *Name) bool {
+	rrs := msg.FilterIN(func(rr *RR, seg int) bool {
+		return rr.Type == DNAME && n.SubOf(rr.Name)
+	})
+	return len(rrs) > 0
+}
+
+func (v *validator) checkSet(a Solver, zone *Zone, msg *Msg,
+	set *signedSet) bool {
+	owner := set.rrs[0].Name
+	t := set.rrs[0].Type
+	if len(set.sigs) > 0 {
+		signer := set.sigs[0].Signer
+		if signer.SubOf(v.zone) && inZone(owner, signer) {
+			// the server is also authoritative for a child zone
+			v.descend(a, zone, signer)
+			if !v.secure() {
+				return false
+			}
+		}
+	}
+
+	err := verifyRRset(set.rrs, set.sigs, v.zone, v.keys, time.Now())
+	if err != nil {
+		v.fail(a, "%s %s: %s", owner, TypeStr(t), err)
+		return false
+	}
+
+	labels := int(set.sigs[0].Labels)
+	if labels < owner.NumLabels() && !v.proveWildcard(a, msg, owner, labels) {
+		v.fail(a, "no proof for the wildcard expansion of %s", owner)
+		return false
+	}
+	return true
+}
+
+// moves the keys down to a child zone that is served by the same servers
+func (v *validator) descend(a Solver, zone *Zone, child *Name) {
+	msg := v.query(a, zone, child, DS)
+	if msg == nil {
+		v.fail(a, "no ds response for %s", child)
+		return
+	}
+	v.checkReferral(a, msg, child)
+	if !v.secure() {
+		return
+	}
+	v.dsZone, v.ds = nil, nil
+
+	childZone := NewZone(child)
+	for _, server := range zone.sortedList() {
+		childZone.Add(server.Name, server.IPs...)
+	}
+	keys, _ := v.fetchKeys(a, childZone, dsList(msg, child), nil)
+	if keys != nil {
+		v.zone = child
+		v.keys = keys
+	}
+}
+
+// the supported ds records of a child zone in a message
+func dsList(m *Msg, child *Name) []*RdDS {
+	ret := make([]*RdDS, 0, 2)
+	m.ForEachIN(func(rr *RR, seg int) {
+		if seg != ADDI && rr.Type == DS && rr.Name.Equal(child) {
+			ds := rr.Rdata.(*RdDS)
+			if supportedDS(ds) {
+				ret = append(ret, ds)
+			}
+		}
+	})
+	return ret
+}
+
+// checks the ds of a child zone in a referral, or the proof that there is
+// no ds and the child is not signed
+func (v *validator) checkReferral(a Solver, msg *Msg, child *Name) {
+	section := AUTH
+	if (msg.Flags & F_AA) == F_AA {
+		section = ANSW // an answer to a ds query
+	}
+	set := findRRset(msg, child, DS, section)
+	if len(set.rrs) == 0 {
+		if v.proveNoData(a, msg, child, DS) {
+			v.insecure(a, "%s is not signed", child)
+			return
+		}
+		if v.secure() {
+			v.fail(a, "no ds for %s and no proof of absence", child)
+		}
+		return
+	}
+
+	err := verifyRRset(set.rrs, set.sigs, v.zone, v.keys, time.Now())
+	if err != nil {
+		v.fail(a, "ds of %s: %s", child, err)
+		return
+	}
+	ds := dsList(msg, child)
+	if len(ds) == 0 {
+		v.insecure(a, "no supported algorithm for %s", child)
+		return
+	}
+	v.dsZone, v.ds = child, ds
+}
+
+func (v *validator) checkDenial(a Solver, msg *Msg, n *Name, t uint16) {
+	if (msg.Flags & F_RCODEMASK) == RCODE_NAMEERROR {
+		if !v.proveNXDomain(a, msg, n) && v.secure() {
+			v.fail(a, "no proof that %s does not exist", n)
+		}
+		return
+	}
+	if !v.proveNoData(a, msg, n, t) && v.secure() {
+		v.fail(a, "no proof that %s has no %s", n, TypeStr(t))
+	}
+}
+
+// the verified nsec records in the authority section
+func (v *validator) nsecs(a Solver, msg *Msg) []*RR {
+	ret := make([]*RR, 0, 4)
+	for _, set := range findRRsets(msg, NSEC, AUTH) {
+		if !inZone(set.rrs[0].Name, v.zone) {
+			continue
+		}
+		err := verifyRRset(set.rrs, set.sigs, v.zone, v.keys, time.Now())
+		if err != nil {
+			v.fail(a, "nsec %s: %s", set.rrs[0].Name, err)
+			return nil
+		}
+		ret = append(ret, set.rrs...)
+	}
+	return ret
+}
+
+// a verified nsec3 record with its decoded owner hash
+type nsec3Rec struct {
+	hash []byte
+	rd   *RdNSEC3
+}
+
+// the verified nsec3 records in the authority section that use the same
+// parameters as the first one
+func (v *validator) nsec3s(a Solver, msg *Msg) []*nsec3Rec {
+	ret := make([]*nsec3Rec, 0, 4)
+	for _, set := range findRRsets(msg, NSEC3, AUTH) {
+		owner := set.rrs[0].Name
+		if !owner.Parent().Equal(v.zone) {
+			continue
+		}
+		err := verifyRRset(set.rrs, set.sigs, v.zone, v.keys, time.Now())
+		if err != nil {
+			v.fail(a, "nsec3 %s: %s", owner, err)
+			return nil
+		}
+		hash, err := nsec3OwnerHash(owner)
+		if err != nil {
+			v.fail(a, "%s", err)
+			return nil
+		}
+		rd := set.rrs[0].Rdata.(*RdNSEC3)
+		if len(ret) > 0 && !sameNSEC3Params(ret[0].rd, rd) {
+			continue
+		}
+		ret = append(ret, &nsec3Rec{hash, rd})
+	}
+	return ret
+}
+
+func sameNSEC3Params(a, b *RdNSEC3) bool {
+	return a.HashAlg == b.HashAlg && a.Iterations == b.Iterations &&
+		bytes.Equal(a.Salt, b.Salt)
+}
+
+// looks for the nsec3 record that matches or covers the hash of a name
+func nsec3Find(recs []*nsec3Rec, n *Name) (match, cover *nsec3Rec) {
+	if n == nil {
+		return nil, nil
+	}
+	p := recs[0].rd
+	h, err := nsec3Hash(n, p.HashAlg, p.Salt, p.Iterations)
+	if err != nil {
+		return nil, nil
+	}
+	for _, r := range recs {
+		if bytes.Equal(r.hash, h) {
+			match = r
+		} else if nsec3Covers(r.hash, r.rd.NextHash, h) {
+			cover = r
+		}
+	}
+	return match, cover
+}
+
+// checks if the nsec3 records can be used, marks insecure if not
+func (v *validator) usableNSEC3(a Solver, recs []*nsec3Rec) bool {
+	if len(recs) == 0 {
+		return false
+	}
+	p := recs[0].rd
+	if p.HashAlg != 1 {
+		v.insecure(a, "unknown nsec3 hash algorithm %d", p.HashAlg)
+		return false
+	}
+	if p.Iterations > _MAX_NSEC3_ITER {
+		v.insecure(a, "nsec3 iterations %d too many", p.Iterations)
+		return false
+	}
+	return true
+}
+
+// true if the types in the bitmap of a name prove that it has no record
+// of the type; for ds, the name must be a delegation seen from the parent
+// side, rfc4035 section 5.2 and rfc5155 section 8.9
+func noData(has func(t uint16) bool, t uint16) bool {
+	if has(t) || has(CNAME) {
+		return false
+	}
+	if t == DS {
+		return has(NS) && !has(SOA)
+	}
+	return true
+}
+
+// proves that the name exists but has no record of the type, or that
+// the wildcard that would match it has no record of the type
+func (v *validator) proveNoData(a Solver, msg *Msg, n *Name, t uint16) bool {
+	nsecs := v.nsecs(a, msg)
+	for _, rr := range nsecs {
+		rd := rr.Rdata.(*RdNSEC)
+		if rr.Name.Equal(n) && noData(rd.HasType, t) {
+			return true
+		}
+		// an empty non-terminal, rfc4035 section 3.1.3.2
+		if nsecCovers(rr.Name, rd.Next, n) && rd.Next.SubOf(n) {
+			return true
+		}
+	}
+	if len(nsecs) > 0 {
+		// wildcard no data, rfc4035 section 3.1.3.4
+		wild := wildcardOf(nsecEncloser(nsecs, n))
+		for _, rr := range nsecs {
+			rd := rr.Rdata.(*RdNSEC)
+			if wild != nil && rr.Name.Equal(wild) && noData(rd.HasType, t) {
+				return true
+			}
+		}
+		return false
+	}
+
+	recs := v.nsec3s(a, msg)
+	if !v.usableNSEC3(a, recs) {
+		return false
+	}
+	match, cover := nsec3Find(recs, n)
+	if match != nil {
+		return noData(match.rd.HasType, t)
+	}
+	// an unsigned delegation in an opt-out span
+	if t == DS && cover != nil && cover.rd.Flags&NSEC3_OPTOUT != 0 {
+		return true
+	}
+	// wildcard no data, rfc5155 section 8.7
+	ce, _ := nsec3Encloser(recs, n, v.zone)
+	if ce == nil {
+		return false
+	}
+	wmatch, _ := nsec3Find(recs, wildcardOf(ce))
+	return wmatch != nil && noData(wmatch.rd.HasType, t)
+}
+
+// the wildcard at a closest encloser, nil if none
+func wildcardOf(ce *Name) *Name {
+	if ce == nil {
+		return nil
+	}
+	ret, err := ce.Child("*")
+	if err != nil {
+		return nil
+	}
+	return ret
+}
+
+// the closest encloser of a name that an nsec record proves to not
+// exist, nil if no nsec covers the name or the name is under a
+// delegation or a dname, rfc4035 section 5.4
+func nsecEncloser(nsecs []*RR, n *Name) *Name {
+	var ce *Name
+	for _, rr := range nsecs {
+		rd := rr.Rdata.(*RdNSEC)
+		next := rd.Next
+		if n.SubOf(rr.Name) && (rd.HasType(DNAME) ||
+			(rd.HasType(NS) && !rd.HasType(SOA))) {
+			return nil // at a delegation or a dname above the name
+		}
+		if nsecCovers(rr.Name, next, n) {
+			ce = n.CommonAncestor(rr.Name)
+			if c := n.CommonAncestor(next); c.NumLabels() > ce.NumLabels() {
+				ce = c
+			}
+		}
+	}
+	return ce
+}
+
+// the closest encloser proof, rfc5155 section 8.3; returns the closest
+// encloser of the name and the record that covers the next closer name,
+// or nil if there is no proof
+func nsec3Encloser(recs []*nsec3Rec, n, zone *Name) (*Name, *nsec3Rec) {
+	for ce := n.Parent(); inZone(ce, zone); ce = ce.Parent() {
+		m, _ := nsec3Find(recs, ce)
+		if m == nil {
+			if ce.IsRoot() {
+				break
+			}
+			continue
+		}
+		// the parent side of a delegation, or a dname, says nothing
+		// about the names under it
+		if m.rd.HasType(DNAME) || (m.rd.HasType(NS) && !m.rd.HasType(SOA)) {
+			return nil, nil
+		}
+		_, cover := nsec3Find(recs, n.ancestor(ce.NumLabels()+1))
+		if cover == nil {
+			return nil, nil
+		}
+		return ce, cover
+	}
+	return nil, nil
+}
+
+// proves that the name does not exist, and no wildcard matches it
+func (v *validator) proveNXDomain(a Solver, msg *Msg, n *Name) bool {
+	nsecs := v.nsecs(a, msg)
+	if len(nsecs) > 0 {
+		wild := wildcardOf(nsecEncloser(nsecs, n))
+		for _, rr := range nsecs {
+			next := rr.Rdata.(*RdNSEC).Next
+			if wild != nil && nsecCovers(rr.Name, next, wild) {
+				return true
+			}
+		}
+		return false
+	}
+
+	recs := v.nsec3s(a, msg)
+	if !v.usableNSEC3(a, recs) {
+		return false
+	}
+	ce, cover := nsec3Encloser(recs, n, v.zone)
+	if ce == nil {
+		return false
+	}
+	if cover.rd.Flags&NSEC3_OPTOUT != 0 {
+		v.insecure(a, "%s is in an opt-out span", n)
+		return false
+	}
+	_, wcover := nsec3Find(recs, wildcardOf(ce))
+	return wcover != nil
+}
+
+// proves that no closer name than the wildcard exists for an answer
+// expanded from a wildcard with labels labels
+func (v *validator) proveWildcard(a Solver, msg *Msg, owner *Name,
+	labels int) bool {
+	for _, rr := range v.nsecs(a, msg) {
+		if nsecCovers(rr.Name, rr.Rdata.(*RdNSEC).Next, owner) {
+			return true
+		}
+	}
+	recs := v.nsec3s(a, msg)
+	if !v.usableNSEC3(a, recs) {
+		return !v.secure()
+	}
+	_, cover := nsec3Find(recs, owner.ancestor(labels+1))
+	return cover != nil
+}
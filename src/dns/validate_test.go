@@ -0,0 +1,461 @@
+package dns
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// an ed25519 zone signing key for building signed test zones
+type testKey struct {
+	zone *Name
+	priv ed25519.PrivateKey
+	rd   *RdDNSKEY
+}
+
+func newTestKey(zone string) *testKey {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return &testKey{
+		zone: Domain(zone),
+		priv: priv,
+		rd:   &RdDNSKEY{DNSKEY_ZONE | DNSKEY_SEP, 3, ALG_ED25519, pub},
+	}
+}
+
+func (k *testKey) keyRR() RR {
+	return RR{k.zone, DNSKEY, IN, 3600, k.rd}
+}
+
+func (k *testKey) ds() *RdDS {
+	w := &writer{canonical: true}
+	w.writeName(k.zone)
+	k.rd.writeTo(w)
+	d := sha256.Sum256(w.wire())
+	return &RdDS{k.rd.KeyTag(), ALG_ED25519, DIGEST_SHA256, d[:]}
+}
+
+// signs an rrset
+func (k *testKey) sign(rrs ...RR) RR {
+	now := uint32(time.Now().Unix())
+	sig := &RdRRSIG{
+		TypeCovered: rrs[0].Type,
+		Algorithm:   ALG_ED25519,
+		Labels:      uint8(rrs[0].Name.NumLabels()),
+		OrigTTL:     rrs[0].TTL,
+		Expiration:  now + 3600,
+		Inception:   now - 3600,
+		KeyTag:      k.rd.KeyTag(),
+		Signer:      k.zone,
+	}
+	ptrs := make([]*RR, len(rrs))
+	for i := range rrs {
+		ptrs[i] = &rrs[i]
+	}
+	sig.Signature = ed25519.Sign(k.priv, signedData(sig, ptrs))
+	return RR{rrs[0].Name, RRSIG, IN, rrs[0].TTL, sig}
+}
+
+func nsecRR(owner, next string, types ...uint16) RR {
+	return RR{Domain(owner), NSEC, IN, 300, &RdNSEC{Domain(next), types}}
+}
+
+// answers on all root server ips
+func (s *fakeSolver) answerRoot(n string, t uint16, msg *Msg) {
+	for _, server := range rootServers.sortedList() {
+		for _, ip := range server.IPs {
+			s.answer(ip.String(), n, t, msg)
+		}
+	}
+}
+
+// builds a signed root that delegates test. to 192.0.2.53, and a signed
+// test. that delegates u.test. to 192.0.2.54 without ds
+func signedTree() *fakeSolver {
+	s := newFakeSolver()
+	root := newTestKey(".")
+	tld := newTestKey("test")
+	s.anchor = &TrustAnchor{DS: []*RdDS{root.ds()}}
+
+	s.answerRoot(".", DNSKEY, &Msg{Flags: F_AA,
+		Answ: []RR{root.keyRR(), root.sign(root.keyRR())}})
+
+	ds := RR{Domain("test"), DS, IN, 3600, tld.ds()}
+	referral := &Msg{
+		Auth: []RR{nsRR("test", "ns.test"), ds, root.sign(ds)},
+		Addi: []RR{aRR("ns.test", "192.0.2.53")},
+	}
+	for _, n := range []string{"www.test", "nx.test", "x.u.test", "bad.test",
+		"x.www.test"} {
+		s.answerRoot(n, A, referral)
+	}
+	s.answerRoot("www.test", AAAA, referral)
+
+	s.answer("192.0.2.53", "test", DNSKEY, &Msg{Flags: F_AA,
+		Answ: []RR{tld.keyRR(), tld.sign(tld.keyRR())}})
+
+	www := aRR("www.test", "198.51.100.1")
+	s.answer("192.0.2.53", "www.test", A, &Msg{Flags: F_AA,
+		Answ: []RR{www, tld.sign(www)}})
+
+	bad := aRR("bad.test", "198.51.100.2")
+	sig := tld.sign(bad)
+	bad.Rdata = &RdIP{ParseIP("198.51.100.3")} // changed after signing
+	s.answer("192.0.2.53", "bad.test", A, &Msg{Flags: F_AA,
+		Answ: []RR{bad, sig}})
+
+	// the nsec chain: test. -> u.test. -> www.test.
+	apex := nsecRR("test", "u.test", NS, SOA, RRSIG, NSEC, DNSKEY)
+	s.answer("192.0.2.53", "nx.test", A, &Msg{
+		Flags: F_AA | RCODE_NAMEERROR,
+		Auth:  []RR{apex, tld.sign(apex)},
+	})
+	nodata := nsecRR("www.test", "test", A, RRSIG, NSEC)
+	s.answer("192.0.2.53", "www.test", AAAA, &Msg{Flags: F_AA,
+		Auth: []RR{nodata, tld.sign(nodata)}})
+
+	unsigned := nsecRR("u.test", "www.test", NS, RRSIG, NSEC)
+	s.answer("192.0.2.53", "x.u.test", A, &Msg{
+		Auth: []RR{nsRR("u.test", "ns.u.test"),
+			unsigned, tld.sign(unsigned)},
+		Addi: []RR{aRR("ns.u.test", "192.0.2.54")},
+	})
+	s.answer("192.0.2.54", "x.u.test", A, &Msg{Flags: F_AA,
+		Answ: []RR{aRR("x.u.test", "198.51.100.4")}})
+
+	// a forged referral at www.test., which is not a delegation, with
+	// its real nsec
+	s.answer("192.0.2.53", "x.www.test", A, &Msg{
+		Auth: []RR{nsRR("www.test", "ns.evil.test"),
+			nodata, tld.sign(nodata)},
+		Addi: []RR{aRR("ns.evil.test", "192.0.2.66")},
+	})
+	s.answer("192.0.2.66", "x.www.test", A, &Msg{Flags: F_AA,
+		Answ: []RR{aRR("x.www.test", "203.0.113.1")}})
+	return s
+}
+
+func TestValidate(t *testing.T) {
+	s := signedTree()
+	for _, c := range []struct {
+		name string
+		t    uint16
+		code int
+		sec  int
+	}{
+		{"www.test", A, OKAY, SEC_SECURE},
+		{"nx.test", A, NONEXIST, SEC_SECURE},
+		{"www.test", AAAA, NONEXIST, SEC_SECURE},
+		{"x.u.test", A, OKAY, SEC_INSECURE},
+		{"bad.test", A, OKAY, SEC_BOGUS},
+	} {
+		p := NewProbRecur(Domain(c.name), c.t)
+		s.SolveSub(p)
+		if p.AnsCode != c.code || p.Security != c.sec {
+			t.Errorf("%s %s: code %d %s (%s), expecting %d %s",
+				c.name, TypeStr(c.t), p.AnsCode, SecStr(p.Security),
+				p.SecReason, c.code, SecStr(c.sec))
+		}
+	}
+}
+
+func TestValidateBadAnchor(t *testing.T) {
+	s := signedTree()
+	s.anchor = &TrustAnchor{DS: []*RdDS{newTestKey(".").ds()}}
+
+	p := NewProbRecur(Domain("www.test"), A)
+	s.SolveSub(p)
+	if p.Security != SEC_BOGUS {
+		t.Errorf("security %s, expecting bogus", SecStr(p.Security))
+	}
+}
+
+func TestRootKeyCache(t *testing.T) {
+	s := signedTree()
+	p := NewProbRecur(Domain("www.test"), A)
+	s.SolveSub(p)
+
+	// the second problem uses the keys validated by the first one
+	deleted := 0
+	for k := range s.answers {
+		if strings.HasSuffix(k, " . dnskey") {
+			delete(s.answers, k)
+			deleted++
+		}
+	}
+	if deleted == 0 {
+		t.Fatal("no root dnskey answers")
+	}
+	p = NewProbRecur(Domain("nx.test"), A)
+	s.SolveSub(p)
+	if p.Security != SEC_SECURE {
+		t.Errorf("security %s (%s), expecting secure", SecStr(p.Security),
+			p.SecReason)
+	}
+
+	s.keys.Put(s.keys.Get(time.Now()), time.Now())
+	if s.keys.Get(time.Now()) != nil {
+		t.Error("expired keys returned")
+	}
+}
+
+// a referral at a name that is not a delegation, with the real nsec of
+// the name, must not pass as an unsigned delegation
+func TestValidateForgedReferral(t *testing.T) {
+	s := signedTree()
+	p := NewProbRecur(Domain("x.www.test"), A)
+	s.SolveSub(p)
+	if p.Security != SEC_BOGUS {
+		t.Errorf("security %s (%s), expecting bogus", SecStr(p.Security),
+			p.SecReason)
+	}
+}
+
+// the names of a signed zone for the denial proofs: b.w.test. is an
+// empty non-terminal, *.c.w.test. a wildcard, d.w.test. an unsigned
+// delegation, and dn.w.test. a dname
+var denialZone = map[string][]uint16{
+	"w.test":     {NS, SOA, RRSIG, NSEC, DNSKEY},
+	"a.b.w.test": {A, RRSIG, NSEC},
+	"*.c.w.test": {TXT, RRSIG, NSEC},
+	"d.w.test":   {NS, NSEC},
+	"dn.w.test":  {DNAME, RRSIG, NSEC},
+}
+
+// the signed nsec chain of denialZone
+func denialNSEC(k *testKey) []RR {
+	names := make([]*Name, 0, len(denialZone))
+	for n := range denialZone {
+		names = append(names, Domain(n))
+	}
+	SortNames(names)
+	ret := make([]RR, 0, 2*len(names))
+	for i, n := range names {
+		next := names[(i+1)%len(names)]
+		rr := nsecRR(n.String(), next.String(), denialZone[n.String()]...)
+		ret = append(ret, rr, k.sign(rr))
+	}
+	return ret
+}
+
+// the signed nsec3 chain of denialZone, with the empty non-terminals
+func denialNSEC3(k *testKey) []RR {
+	types := map[string][]uint16{"b.w.test": nil, "c.w.test": nil}
+	for n, ts := range denialZone {
+		var t3 []uint16
+		for _, t := range ts {
+			if t != NSEC {
+				t3 = append(t3, t)
+			}
+		}
+		types[n] = t3
+	}
+	salt := []byte{0xab}
+	hashes := make([][]byte, 0, len(types))
+	byHash := make(map[string][]uint16)
+	for n, ts := range types {
+		h, _ := nsec3Hash(Domain(n), 1, salt, 0)
+		hashes = append(hashes, h)
+		byHash[string(h)] = ts
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i], hashes[j]) < 0
+	})
+	ret := make([]RR, 0, 2*len(hashes))
+	for i, h := range hashes {
+		owner, _ := k.zone.Child(nsec3Label(h))
+		rr := RR{owner, NSEC3, IN, 300, &RdNSEC3{1, 0, 0, salt,
+			hashes[(i+1)%len(hashes)], byHash[string(h)]}}
+		ret = append(ret, rr, k.sign(rr))
+	}
+	return ret
+}
+
+func TestDenialProofs(t *testing.T) {
+	k := newTestKey("w.test")
+	s := newFakeSolver()
+	for _, c := range []struct {
+		name  string
+		t     uint16
+		nx    bool // a name error
+		nsec  bool
+		nsec3 bool
+	}{
+		{"a.b.w.test", AAAA, false, true, true},
+		{"a.b.w.test", A, false, false, false},
+		{"b.w.test", A, false, true, true},   // empty non-terminal
+		{"x.c.w.test", A, false, true, true}, // wildcard no data
+		{"x.c.w.test", TXT, false, false, false},
+		{"nx.w.test", A, true, true, true},
+		{"x.d.w.test", A, true, false, false},  // under a delegation
+		{"x.dn.w.test", A, true, false, false}, // under a dname
+	} {
+		for _, chain := range []struct {
+			name   string
+			rrs    []RR
+			expect bool
+		}{
+			{"nsec", denialNSEC(k), c.nsec},
+			{"nsec3", denialNSEC3(k), c.nsec3},
+		} {
+			v := newValidator(nil)
+			v.zone, v.keys = k.zone, []*RdDNSKEY{k.rd}
+			msg := &Msg{Flags: F_RESPONSE | F_AA, Auth: chain.rrs}
+			n := Domain(c.name)
+			var got bool
+			if c.nx {
+				got = v.proveNXDomain(s, msg, n)
+			} else {
+				got = v.proveNoData(s, msg, n, c.t)
+			}
+			if got != chain.expect || !v.secure() {
+				t.Errorf("%s %s %s: %v %s (%s), expecting %v", chain.name,
+					c.name, TypeStr(c.t), got, SecStr(v.state), v.reason,
+					chain.expect)
+			}
+		}
+	}
+}
+
+func b64(s string) []byte {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func sigTime(s string) uint32 {
+	t, err := time.Parse("20060102150405", s)
+	if err != nil {
+		panic(err)
+	}
+	return uint32(t.Unix())
+}
+
+func TestVerifyKnownAnswers(t *testing.T) {
+	rfc6605 := aRR("www.example.net", "192.0.2.1")
+	rfc6605.TTL = 3600
+	for _, c := range []struct {
+		name     string
+		rr       RR
+		key      *RdDNSKEY
+		sig      *RdRRSIG
+		tag      uint16
+		inceptAt string
+	}{
+		{
+			// the miek.nl. zone, signed in 2011
+			name: "rsasha256",
+			rr: RR{Domain("miek.nl"), SOA, IN, 14400, &RdSOA{
+				Domain("open.nlnetlabs.nl"), Domain("miekg.atoom.net"),
+				1293945905, 14400, 3600, 604800, 86400}},
+			key: &RdDNSKEY{DNSKEY_ZONE, 3, ALG_RSASHA256, b64(
+				"AwEAAcNEU67LJI5GEgF9QLNqLO1SMq1EdoQ6E9f85ha0k0ewQGCblyW2" +
+					"836GiVsm6k8Kr5ECIoMJ6fZWf3CQSQ9ycWfTyOHfmI3eQ/1Covhb" +
+					"2y4bAmL/07PhrL7ozWBW3wBfM335Ft9xjtXHPy7ztCbV9qZ4TVDT" +
+					"W/Iyg0PiwgoXVesz")},
+			sig: &RdRRSIG{SOA, ALG_RSASHA256, 2, 14400,
+				sigTime("20110201042505"), sigTime("20110102042505"),
+				12051, Domain("miek.nl"), b64(
+					"oMCbslaAVIp/8kVtLSms3tDABpcPRUgHLrOR48OOplkYo+8TeEGW" +
+						"wkSwaz/MRo2fB4FxW0qj/hTlIjUGuACSd+b1wKdH5GvzRJc2pFmx" +
+						"tCbm55ygAh4EUL0F6U5cKtGJGSXxxg6UFCQ0doJCmiGFa78Lola" +
+						"UOXImJrk6AFrGa0M=")},
+			tag:      12051,
+			inceptAt: "20110102042505",
+		},
+		{
+			// rfc6605 section 6.1
+			name: "ecdsap256sha256",
+			rr:   rfc6605,
+			key: &RdDNSKEY{DNSKEY_ZONE | DNSKEY_SEP, 3, ALG_ECDSAP256SHA256,
+				b64("GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edb" +
+					"krSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA==")},
+			sig: &RdRRSIG{A, ALG_ECDSAP256SHA256, 3, 3600,
+				sigTime("20100909100439"), sigTime("20100812100439"),
+				55648, Domain("example.net"), b64(
+					"qx6wLYqmh+l9oCKTN6qIc+bw6ya+KJ8oMz0YP107epXA" +
+						"yGmt+3SNruPFKG7tZoLBLlUzGGus7ZwmwWep666VCw==")},
+			tag:      55648,
+			inceptAt: "20100812100439",
+		},
+		{
+			// rfc6605 section 6.2
+			name: "ecdsap384sha384",
+			rr:   rfc6605,
+			key: &RdDNSKEY{DNSKEY_ZONE | DNSKEY_SEP, 3, ALG_ECDSAP384SHA384,
+				b64("xKYaNhWdGOfJ+nPrL8/arkwf2EY3MDJ+SErKivBVSum1" +
+					"w/egsXvSADtNJhyem5RCOpgQ6K8X1DRSEkrbYQ+OB+v8" +
+					"/uX45NBwY8rp65F6Glur8I/mlVNgF6W/qTI37m40")},
+			sig: &RdRRSIG{A, ALG_ECDSAP384SHA384, 3, 3600,
+				sigTime("20100909102025"), sigTime("20100812102025"),
+				10771, Domain("example.net"), b64(
+					"/L5hDKIvGDyI1fcARX3z65qrmPsVz73QD1Mr5CEqOiLP" +
+						"95hxQouuroGCeZOvzFaxsT8Glr74hbavRKayJNuydCuz" +
+						"WTSSPdz7wnqXL5bdcJzusdnI0RSMROxxwGipWcJm")},
+			tag:      10771,
+			inceptAt: "20100812102025",
+		},
+	} {
+		if tag := c.key.KeyTag(); tag != c.tag {
+			t.Errorf("%s: key tag %d, expecting %d", c.name, tag, c.tag)
+		}
+		now := time.Unix(int64(sigTime(c.inceptAt)), 0).Add(24 * time.Hour)
+		rrs := []*RR{&c.rr}
+		err := verifyRRset(rrs, []*RdRRSIG{c.sig}, c.sig.Signer,
+			[]*RdDNSKEY{c.key}, now)
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+		}
+
+		// the signature does not cover changed rdata
+		changed := c.rr
+		changed.Rdata = &RdIP{ParseIP("192.0.2.99")}
+		if c.rr.Type != A {
+			soa := *c.rr.Rdata.(*RdSOA)
+			soa.Serial++
+			changed.Rdata = &soa
+		}
+		err = verifyRRset([]*RR{&changed}, []*RdRRSIG{c.sig}, c.sig.Signer,
+			[]*RdDNSKEY{c.key}, now)
+		if err != errBadSig {
+			t.Errorf("%s: changed rdata: %v", c.name, err)
+		}
+	}
+}
+
+func TestNSEC3Hash(t *testing.T) {
+	// rfc5155 appendix a
+	salt := []byte{0xaa, 0xbb, 0xcc, 0xdd}
+	for _, c := range []struct {
+		name, hash string
+	}{
+		{"example", "0p9mhaveqvm6t7vbl5lop2u3t2rp3tom"},
+		{"a.example", "35mthgpgcu1qg68fab165klnsnk3dpvl"},
+	} {
+		h, err := nsec3Hash(Domain(c.name), 1, salt, 12)
+		if err != nil || nsec3Label(h) != c.hash {
+			t.Errorf("%s: %s %v", c.name, nsec3Label(h), err)
+		}
+	}
+}
+
+func TestParseTrustAnchor(t *testing.T) {
+	a := RootTrustAnchor()
+	if len(a.DS) != 2 || a.DS[0].KeyTag != 20326 || len(a.DS[0].Digest) != 32 {
+		t.Errorf("wrong root anchor: %v", a.DS)
+	}
+	if _, err := ParseTrustAnchor(
+		strings.NewReader("example. DS 1 8 2 00")); err == nil {
+		t.Error("non-root anchor should fail")
+	}
+}
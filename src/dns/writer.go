@@ -8,14 +8,16 @@ import (
 
 // message packer
 type writer struct {
-	buf bytes.Buffer
+	buf       bytes.Buffer
+	canonical bool // lower case names, for dnssec signing
 }
 
 var (
-	errManyQues = errors.New("too many questions to pack")
-	errManyAnsw = errors.New("too many answers to pack")
-	errManyAuth = errors.New("too many authorities to pack")
-	errManyAddi = errors.New("too many additionals to pack")
+	errManyQues  = errors.New("too many questions to pack")
+	errManyAnsw  = errors.New("too many answers to pack")
+	errManyAuth  = errors.New("too many authorities to pack")
+	errManyAddi  = errors.New("too many additionals to pack")
+	errLongRdata = errors.New("rdata too long")
 )
 
 func (w *writer) writeUint8(i uint8) {
@@ -58,8 +60,21 @@ func (w *writer) writeLabel(s string) (n int) {
 }
 
 func (w *writer) writeName(n *Name) {
+	labels := n.wireLabels()
+	if w.canonical {
+		labels = n.labels
+	}
+	w.writeLabels(labels)
+}
+
+// writes a name in its original case even in canonical form
+func (w *writer) writeNameKeepCase(n *Name) {
+	w.writeLabels(n.wireLabels())
+}
+
+func (w *writer) writeLabels(labels []string) {
 	sum := 0
-	for _, s := range n.labels {
+	for _, s := range labels {
 		sum += w.writeLabel(s)
 	}
 	if sum > 255 {
@@ -81,7 +96,17 @@ func (w *writer) writeRR(rr *RR) (err error) {
 }
 
 func (w *writer) writeRdata(rd Rdata) (err error) {
-	panic("not implemented")
+	sub := &writer{canonical: w.canonical}
+	if err = rd.writeTo(sub); err != nil {
+		return err
+	}
+	buf := sub.wire()
+	if len(buf) > 0xffff {
+		return errLongRdata
+	}
+	w.writeUint16(uint16(len(buf)))
+	w.writeBytes(buf)
+	return nil
 }
 
 func (w *writer) writeQues(q *Ques) {
@@ -0,0 +1,362 @@
+package dns
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// zone transfers over tcp, see rfc5936 for axfr and rfc1995 for ixfr
+
+var (
+	errXfrNoSOA    = errors.New("xfr: not starting with the soa of the zone")
+	errXfrID       = errors.New("xfr: message id mismatch")
+	errXfrTrunc    = errors.New("xfr: truncated message")
+	errXfrSerial   = errors.New("xfr: soa serial mismatch")
+	errXfrUnended  = errors.New("xfr: connection closed before the last soa")
+	errXfrMissing  = errors.New("xfr: deleted record not in the zone")
+	errXfrNotFound = errors.New("xfr: zone has no soa")
+)
+
+// an error rcode in a transfer response
+type XfrRcodeError struct {
+	Rcode int
+}
+
+func (e *XfrRcodeError) Error() string {
+	return fmt.Sprintf("xfr: rcode %d", e.Rcode)
+}
+
+// default timeout for connecting and for each message
+const _XFR_TIMEOUT = 30 * time.Second
+
+// a client for pulling zones from a primary server
+type XfrClient struct {
+	Host    *IPv4
+	Port    uint16
+	Timeout time.Duration // for connecting and for each message
+	TSIG    *TsigKey      // nil for not signing
+}
+
+func NewXfrClient(host *IPv4) *XfrClient {
+	return &XfrClient{Host: host, Port: DNS_PORT, Timeout: _XFR_TIMEOUT}
+}
+
+// one change between two serials in an ixfr
+type IxfrDiff struct {
+	From    *RR // the old soa
+	To      *RR // the new soa
+	Deleted []RR
+	Added   []RR
+}
+
+// the result of an ixfr
+type Ixfr struct {
+	Serial   uint32      // the serial of the server
+	UpToDate bool        // the client serial is current, nothing sent
+	Full     []RR        // the whole zone, if the server sent an axfr
+	Diffs    []*IxfrDiff // the changes in order otherwise
+}
+
+func soaSerial(rr *RR) uint32 {
+	return rr.Rdata.(*RdSOA).Serial
+}
+
+func isSOA(rr *RR, zone *Name) bool {
+	return rr.Type == SOA && rr.Class == IN && rr.Name.Equal(zone)
+}
+
+// a transfer session on one tcp connection
+type xfrConn struct {
+	conn    net.Conn
+	timeout time.Duration
+}
+
+func dialTCP(host *IPv4, port uint16, timeout time.Duration) (*xfrConn,
+	error) {
+	addr := net.JoinHostPort(host.String(), fmt.Sprintf("%d", port))
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return &xfrConn{conn, timeout}, nil
+}
+
+// writes a message with the two bytes length prefix
+func (c *xfrConn) send(wire []byte) error {
+	buf := make([]byte, 2, len(wire)+2)
+	binary.BigEndian.PutUint16(buf, uint16(len(wire)))
+	buf = append(buf, wire...)
+
+	c.conn.SetWriteDeadline(time.Now().Add(c.timeout))
+	_, err := c.conn.Write(buf)
+	return err
+}
+
+func (c *xfrConn) recv() ([]byte, error) {
+	c.conn.SetReadDeadline(time.Now().Add(c.timeout))
+	var size [2]byte
+	if _, err := io.ReadFull(c.conn, size[:]); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, binary.BigEndian.Uint16(size[:]))
+	if _, err := io.ReadFull(c.conn, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (c *xfrConn) Close() error {
+	return c.conn.Close()
+}
+
+// sends the query and calls f for each answer record of the response
+// messages in order, until f returns true for the last record
+func (c *XfrClient) transfer(q *Msg, f func(rr *RR) (bool, error)) error {
+	conn, err := dialTCP(c.Host, c.Port, c.Timeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var stream *TsigStream
+	var wire []byte
+	if c.TSIG != nil {
+		var mac []byte
+		wire, mac, err = q.WireTSIG(c.TSIG, nil)
+		stream = NewTsigStream(c.TSIG, mac)
+	} else {
+		wire, err = q.Wire()
+	}
+	if err != nil {
+		return err
+	}
+	if err = conn.send(wire); err != nil {
+		return err
+	}
+
+	for {
+		buf, err := conn.recv()
+		if err == io.EOF {
+			return errXfrUnended
+		}
+		if err != nil {
+			return err
+		}
+		var msg *Msg
+		if stream != nil {
+			msg, err = stream.Verify(buf)
+		} else {
+			msg, err = ParseMsg(buf)
+		}
+		if err != nil {
+			return err
+		}
+		if msg.ID != q.ID {
+			return errXfrID
+		}
+		if rcode := int(msg.Flags & F_RCODEMASK); rcode != RCODE_OKAY {
+			return &XfrRcodeError{rcode}
+		}
+		if (msg.Flags & F_TC) == F_TC {
+			return errXfrTrunc
+		}
+		for i := range msg.Answ {
+			end, err := f(&msg.Answ[i])
+			if err != nil {
+				return err
+			}
+			if end && stream != nil {
+				return stream.Done()
+			}
+			if end {
+				return nil
+			}
+		}
+	}
+}
+
+// pulls the whole zone and calls f for each record as it arrives,
+// starting with the soa; the trailing soa is checked but not passed
+func (c *XfrClient) AXFRStream(zone *Name, f func(rr *RR) error) error {
+	q := NewQuery(zone, AXFR)
+	var soa *RR
+	return c.transfer(q, func(rr *RR) (bool, error) {
+		if soa == nil {
+			if !isSOA(rr, zone) {
+				return false, errXfrNoSOA
+			}
+			soa = rr
+			return false, f(rr)
+		}
+		if isSOA(rr, zone) {
+			if soaSerial(rr) != soaSerial(soa) {
+				return false, errXfrSerial
+			}
+			return true, nil
+		}
+		return false, f(rr)
+	})
+}
+
+// pulls the whole zone, the soa is the first record
+func (c *XfrClient) AXFR(zone *Name) ([]RR, error) {
+	ret := make([]RR, 0, 64)
+	err := c.AXFRStream(zone, func(rr *RR) error {
+		ret = append(ret, *rr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// pulls the changes since the serial; the server may send the whole
+// zone instead, or only its soa if the serial is current
+func (c *XfrClient) IXFR(zone *Name, serial uint32) (*Ixfr, error) {
+	q := NewQuery(zone, IXFR)
+	q.Auth = append(q.Auth, RR{zone, SOA, IN, 0, &RdSOA{
+		Mname: rootName, Rname: rootName, Serial: serial,
+	}})
+
+	ret := new(Ixfr)
+	var (
+		n    int       // records seen
+		diff *IxfrDiff // the current diff, nil before the first
+		full bool
+	)
+	err := c.transfer(q, func(rr *RR) (bool, error) {
+		n++
+		soa := isSOA(rr, zone)
+		switch {
+		case n == 1:
+			if !soa {
+				return false, errXfrNoSOA
+			}
+			ret.Serial = soaSerial(rr)
+			if !serialLess(serial, ret.Serial) {
+				ret.UpToDate = true
+				return true, nil
+			}
+			ret.Full = append(ret.Full, *rr)
+			return false, nil
+		case n == 2 && !soa:
+			full = true // an axfr style response
+		case n == 2 && soaSerial(rr) == ret.Serial:
+			full = true // a zone of only the soa
+			return true, nil
+		}
+
+		if full {
+			if soa {
+				if soaSerial(rr) != ret.Serial {
+					return false, errXfrSerial
+				}
+				return true, nil
+			}
+			ret.Full = append(ret.Full, *rr)
+			return false, nil
+		}
+
+		if !soa {
+			if diff.To == nil {
+				diff.Deleted = append(diff.Deleted, *rr)
+			} else {
+				diff.Added = append(diff.Added, *rr)
+			}
+			return false, nil
+		}
+
+		if diff != nil && diff.To == nil {
+			diff.To = rr
+			return false, nil
+		}
+		if diff != nil && soaSerial(rr) == ret.Serial {
+			return true, nil // the final soa
+		}
+		if diff != nil && soaSerial(rr) != soaSerial(diff.To) {
+			return false, errXfrSerial
+		}
+		diff = &IxfrDiff{From: rr}
+		ret.Diffs = append(ret.Diffs, diff)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !full {
+		ret.Full = nil
+	}
+	return ret, nil
+}
+
+// the key of a record for matching, ignoring the ttl and the name case
+func rrKey(rr *RR) string {
+	w := &writer{canonical: true}
+	w.writeName(rr.Name)
+	w.writeUint16(rr.Type)
+	w.writeUint16(rr.Class)
+	w.writeBytes(canonicalRdata(rr.Rdata))
+	return string(w.wire())
+}
+
+// applies an ixfr to a zone, which starts with its soa like the result
+// of AXFR; returns the new zone
+func ApplyIxfr(zone []RR, x *Ixfr) ([]RR, error) {
+	if x.UpToDate {
+		return zone, nil
+	}
+	if x.Full != nil {
+		return x.Full, nil
+	}
+	if len(zone) == 0 || zone[0].Type != SOA {
+		return nil, errXfrNotFound
+	}
+
+	soa := zone[0]
+	rest := make([]RR, len(zone)-1)
+	copy(rest, zone[1:])
+
+	for _, d := range x.Diffs {
+		if soaSerial(d.From) != soaSerial(&soa) {
+			return nil, errXfrSerial
+		}
+		deleted := make(map[string]bool)
+		for i := range d.Deleted {
+			if d.Deleted[i].Type != SOA {
+				deleted[rrKey(&d.Deleted[i])] = true
+			}
+		}
+		kept := rest[:0]
+		for i := range rest {
+			k := rrKey(&rest[i])
+			if deleted[k] {
+				delete(deleted, k)
+				continue
+			}
+			kept = append(kept, rest[i])
+		}
+		if len(deleted) > 0 {
+			return nil, errXfrMissing
+		}
+		rest = kept
+
+		have := make(map[string]bool)
+		for i := range rest {
+			have[rrKey(&rest[i])] = true
+		}
+		for _, rr := range d.Added {
+			if k := rrKey(&rr); !have[k] && rr.Type != SOA {
+				have[k] = true
+				rest = append(rest, rr)
+			}
+		}
+		soa = *d.To
+	}
+
+	return append([]RR{soa}, rest...), nil
+}
@@ -0,0 +1,179 @@
+package dns
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+// serves one transfer on a local tcp port, answering with the records
+// split into messages of the given sizes, signed if key is not nil
+func xfrServer(t *testing.T, rrs []RR, split int, key *TsigKey) *XfrClient {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("no tcp:", err)
+	}
+	go func() {
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var size [2]byte
+		if _, err := io.ReadFull(conn, size[:]); err != nil {
+			return
+		}
+		buf := make([]byte, binary.BigEndian.Uint16(size[:]))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return
+		}
+		var q *Msg
+		var stream *TsigStream
+		if key != nil {
+			store := NewTsigKeyStore()
+			store.Add(key)
+			var mac []byte
+			if q, _, mac, err = store.VerifyRequest(buf); err != nil {
+				return
+			}
+			stream = NewTsigStream(key, mac)
+		} else if q, err = ParseMsg(buf); err != nil {
+			return
+		}
+
+		for len(rrs) > 0 {
+			n := split
+			if n > len(rrs) {
+				n = len(rrs)
+			}
+			m := &Msg{ID: q.ID, Flags: F_RESPONSE | F_AA, Answ: rrs[:n]}
+			rrs = rrs[n:]
+			var wire []byte
+			if stream != nil {
+				wire, _ = stream.Sign(m)
+			} else {
+				wire, _ = m.Wire()
+			}
+			binary.BigEndian.PutUint16(size[:], uint16(len(wire)))
+			conn.Write(append(size[:], wire...))
+		}
+	}()
+
+	c := NewXfrClient(ParseIP("127.0.0.1"))
+	c.Port = uint16(l.Addr().(*net.TCPAddr).Port)
+	c.TSIG = key
+	return c
+}
+
+func sameRRs(a, b []RR) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keys := make(map[string]int)
+	for i := range a {
+		keys[rrKey(&a[i])]++
+	}
+	for i := range b {
+		keys[rrKey(&b[i])]--
+	}
+	for _, n := range keys {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAXFR(t *testing.T) {
+	soa := soaRR("x.test", 5)
+	zone := []RR{soa, nsRR("x.test", "ns.x.test"), aRR("ns.x.test", "192.0.2.1"),
+		aRR("www.x.test", "192.0.2.2")}
+	c := xfrServer(t, append(append([]RR{}, zone...), soa), 2, nil)
+
+	got, err := c.AXFR(Domain("x.test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameRRs(got, zone) || got[0].Type != SOA {
+		t.Errorf("wrong zone: %v", got)
+	}
+
+	// no trailing soa
+	c = xfrServer(t, zone, 10, nil)
+	if _, err := c.AXFR(Domain("x.test")); err != errXfrUnended {
+		t.Errorf("expecting unended, got %v", err)
+	}
+}
+
+func TestIXFR(t *testing.T) {
+	old := []RR{soaRR("x.test", 1), aRR("a.x.test", "192.0.2.1"),
+		aRR("b.x.test", "192.0.2.2")}
+	c := xfrServer(t, []RR{
+		soaRR("x.test", 3),
+		soaRR("x.test", 1), aRR("a.x.test", "192.0.2.1"),
+		soaRR("x.test", 2), aRR("c.x.test", "192.0.2.3"),
+		soaRR("x.test", 2), aRR("b.x.test", "192.0.2.2"),
+		soaRR("x.test", 3), aRR("d.x.test", "192.0.2.4"),
+		soaRR("x.test", 3),
+	}, 3, nil)
+
+	x, err := c.IXFR(Domain("x.test"), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.Serial != 3 || len(x.Diffs) != 2 || x.Full != nil {
+		t.Fatalf("wrong ixfr: %+v", x)
+	}
+	got, err := ApplyIxfr(old, x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []RR{soaRR("x.test", 3), aRR("c.x.test", "192.0.2.3"),
+		aRR("d.x.test", "192.0.2.4")}
+	if !sameRRs(got, expect) || got[0].Type != SOA {
+		t.Errorf("wrong zone after ixfr: %v", got)
+	}
+
+	// the same serial
+	c = xfrServer(t, []RR{soaRR("x.test", 1)}, 1, nil)
+	if x, err = c.IXFR(Domain("x.test"), 1); err != nil || !x.UpToDate {
+		t.Errorf("expecting up to date: %+v %v", x, err)
+	}
+
+	// a full zone
+	c = xfrServer(t, []RR{soaRR("x.test", 4), aRR("a.x.test", "192.0.2.9"),
+		soaRR("x.test", 4)}, 1, nil)
+	if x, err = c.IXFR(Domain("x.test"), 1); err != nil || len(x.Full) != 2 {
+		t.Errorf("expecting a full zone: %+v %v", x, err)
+	}
+}
+
+func TestWriteMaster(t *testing.T) {
+	rrs := []RR{
+		soaRR("x.test", 7),
+		{Domain("x.test"), MX, IN, 300, &RdMX{10, Domain("mail.x.test")}},
+		{Domain("x.test"), TXT, IN, 300, &RdBytes{[]byte("\x05hi \"x")}},
+		{Domain("x.test"), 999, IN, 300, &RdBytes{[]byte{1, 2}}},
+	}
+	var buf bytes.Buffer
+	if err := WriteMaster(&buf, rrs); err != nil {
+		t.Fatal(err)
+	}
+	expect := []string{
+		"x.test. 3600 IN SOA ns1.x.test. admin.x.test. 7 7200 3600 1209600 300",
+		"x.test. 300 IN MX 10 mail.x.test.",
+		`x.test. 300 IN TXT "hi \"x"`,
+		`x.test. 300 IN TYPE999 \# 2 0102`,
+	}
+	got := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	for i := range expect {
+		if i >= len(got) || got[i] != expect[i] {
+			t.Errorf("line %d: %q", i, got)
+		}
+	}
+}
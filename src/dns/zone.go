@@ -1,6 +1,9 @@
 package dns
 
-import "math/rand"
+import (
+	"math/rand"
+	"sort"
+)
 
 type Zone struct {
 	name    *Name
@@ -116,6 +119,11 @@ func (self *Zone) Prepare() []*NameServer {
 	return servers
 }
 
+// orders the servers with a selection strategy
+func (self *Zone) PrepareWith(sel ServerSelector) []*NameServer {
+	return sel.Order(self.List())
+}
+
 func (self *Zone) List() []*NameServer {
 	servers := make([]*NameServer, 0, len(self.servers))
 
@@ -125,3 +133,12 @@ func (self *Zone) List() []*NameServer {
 
 	return servers
 }
+
+// list the servers ordered by name, for stable output
+func (self *Zone) sortedList() []*NameServer {
+	servers := self.List()
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].Name.String() < servers[j].Name.String()
+	})
+	return servers
+}
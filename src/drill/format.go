@@ -0,0 +1,302 @@
+package main
+
+import (
+	"dns"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// what a query ends with
+type result struct {
+	msg    *dns.Msg // nil if there is no answer
+	server string
+	when   time.Time // when the query started
+	rtt    time.Duration
+
+	// for recursion
+	recur     bool
+	code      string
+	chain     []string
+	security  string // empty if not validating
+	secReason string
+	trace     []string // the log lines, for json only
+}
+
+var ansCodes = map[int]string{
+	dns.OKAY:     "okay",
+	dns.NONEXIST: "nonexist",
+	dns.NORESP:   "noresp",
+	dns.BADCHAIN: "badchain",
+}
+
+func recurResult(p *dns.ProbRecur, start time.Time) *result {
+	r := &result{msg: p.Answer, when: start, rtt: time.Since(start),
+		recur: true, code: ansCodes[p.AnsCode]}
+	for i := len(p.History) - 1; i >= 0; i-- {
+		if h := p.History[i]; h.Resp != nil {
+			r.server = fmt.Sprintf("%s#%d", h.Host, h.Resp.Port)
+			break
+		}
+	}
+	for _, rr := range p.Chain {
+		r.chain = append(r.chain, rr.MasterString())
+	}
+	return r
+}
+
+func (r *result) print(out io.Writer, format int) error {
+	switch format {
+	case DIG:
+		return r.printDig(out)
+	case JSON:
+		return r.printJSON(out)
+	}
+	return r.printBrace(out)
+}
+
+func (r *result) printBrace(out io.Writer) error {
+	if r.recur {
+		fmt.Fprintf(out, "// %s", r.code)
+		if r.security != "" {
+			fmt.Fprintf(out, ", %s", r.security)
+			if r.secReason != "" {
+				fmt.Fprintf(out, ": %s", r.secReason)
+			}
+		}
+		fmt.Fprintln(out)
+	}
+	if r.msg == nil {
+		return nil
+	}
+	if r.server != "" {
+		fmt.Fprintf(out, "// from %s in %s\n", r.server, r.rtt)
+	}
+	_, err := fmt.Fprint(out, r.msg)
+	return err
+}
+
+var opcodeStrs = map[uint16]string{
+	dns.OPQUERY:  "QUERY",
+	dns.OPIQUERY: "IQUERY",
+	dns.OPSTATUS: "STATUS",
+	dns.OPNOTIFY: "NOTIFY",
+	dns.OPUPDATE: "UPDATE",
+}
+
+var statusStrs = map[int]string{
+	dns.RCODE_OKAY:         "NOERROR",
+	dns.RCODE_FORMATERROR:  "FORMERR",
+	dns.RCODE_SERVERFAIL:   "SERVFAIL",
+	dns.RCODE_NAMEERROR:    "NXDOMAIN",
+	dns.RCODE_NOTIMPLEMENT: "NOTIMP",
+	dns.RCODE_REFUSED:      "REFUSED",
+	dns.RCODE_YXDOMAIN:     "YXDOMAIN",
+	dns.RCODE_YXRRSET:      "YXRRSET",
+	dns.RCODE_NXRRSET:      "NXRRSET",
+	dns.RCODE_NOTAUTH:      "NOTAUTH",
+	dns.RCODE_NOTZONE:      "NOTZONE",
+}
+
+func opcodeStr(m *dns.Msg) string {
+	op := m.Flags & dns.F_OPMASK
+	if s, ok := opcodeStrs[op]; ok {
+		return s
+	}
+	return fmt.Sprintf("OPCODE%d", op>>11)
+}
+
+func statusStr(m *dns.Msg) string {
+	rcode := int(m.Flags & dns.F_RCODEMASK)
+	if s, ok := statusStrs[rcode]; ok {
+		return s
+	}
+	return fmt.Sprintf("RCODE%d", rcode)
+}
+
+func flagStrs(m *dns.Msg) []string {
+	ret := make([]string, 0, 7)
+	for _, f := range []struct {
+		bit  uint16
+		name string
+	}{
+		{dns.F_RESPONSE, "qr"},
+		{dns.F_AA, "aa"},
+		{dns.F_TC, "tc"},
+		{dns.F_RD, "rd"},
+		{dns.F_RA, "ra"},
+		{dns.F_AD, "ad"},
+		{dns.F_CD, "cd"},
+	} {
+		if (m.Flags & f.bit) != 0 {
+			ret = append(ret, f.name)
+		}
+	}
+	return ret
+}
+
+// the name, ttl, class, type and rdata of a record
+func rrFields(rr *dns.RR) []string {
+	return strings.SplitN(rr.MasterString(), " ", 5)
+}
+
+// the records without the opt pseudo record
+func records(rrs []dns.RR) []*dns.RR {
+	ret := make([]*dns.RR, 0, len(rrs))
+	for i := range rrs {
+		if rrs[i].Type != dns.OPT {
+			ret = append(ret, &rrs[i])
+		}
+	}
+	return ret
+}
+
+func (r *result) printDig(out io.Writer) error {
+	if r.recur {
+		fmt.Fprintf(out, ";; recursion: %s\n", r.code)
+		for _, c := range r.chain {
+			fmt.Fprintf(out, ";; followed: %s\n", c)
+		}
+		if r.security != "" {
+			fmt.Fprintf(out, ";; security: %s %s\n", r.security,
+				r.secReason)
+		}
+	}
+	m := r.msg
+	if m == nil {
+		return nil
+	}
+	answ, auth, addi := records(m.Answ), records(m.Auth), records(m.Addi)
+	fmt.Fprintf(out, ";; ->>HEADER<<- opcode: %s, status: %s, id: %d\n",
+		opcodeStr(m), statusStr(m), m.ID)
+	fmt.Fprintf(out, ";; flags: %s; QUERY: %d, ANSWER: %d, "+
+		"AUTHORITY: %d, ADDITIONAL: %d\n", strings.Join(flagStrs(m), " "),
+		len(m.Ques), len(answ), len(auth), len(addi))
+
+	if size, do, ok := m.EDNS(); ok {
+		flags := ""
+		if do {
+			flags = " do"
+		}
+		fmt.Fprintf(out, "\n;; OPT PSEUDOSECTION:\n"+
+			"; EDNS: version: 0, flags:%s; udp: %d\n", flags, size)
+	}
+
+	fmt.Fprintf(out, "\n;; QUESTION SECTION:\n")
+	for _, q := range m.Ques {
+		rr := &dns.RR{Name: q.Name, Type: q.Type, Class: q.Class,
+			Rdata: &dns.RdBytes{}}
+		f := rrFields(rr)
+		fmt.Fprintf(out, ";%s\t\t%s\t%s\n", f[0], f[2], f[3])
+	}
+	for _, sec := range []struct {
+		name string
+		rrs  []*dns.RR
+	}{
+		{"ANSWER", answ},
+		{"AUTHORITY", auth},
+		{"ADDITIONAL", addi},
+	} {
+		if len(sec.rrs) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "\n;; %s SECTION:\n", sec.name)
+		for _, rr := range sec.rrs {
+			fmt.Fprintln(out, strings.Join(rrFields(rr), "\t"))
+		}
+	}
+
+	fmt.Fprintf(out, "\n;; Query time: %d msec\n",
+		r.rtt.Nanoseconds()/1000000)
+	if r.server != "" {
+		fmt.Fprintf(out, ";; SERVER: %s\n", r.server)
+	}
+	_, err := fmt.Fprintf(out, ";; WHEN: %s\n",
+		r.when.Format(time.RFC1123))
+	return err
+}
+
+type jsonQues struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Class string `json:"class"`
+}
+
+type jsonRR struct {
+	Name  string `json:"name"`
+	TTL   uint32 `json:"ttl"`
+	Class string `json:"class"`
+	Type  string `json:"type"`
+	Data  string `json:"data"`
+}
+
+type jsonMsg struct {
+	ID         uint16     `json:"id"`
+	Opcode     string     `json:"opcode"`
+	Status     string     `json:"status"`
+	Flags      []string   `json:"flags"`
+	Question   []jsonQues `json:"question"`
+	Answer     []jsonRR   `json:"answer"`
+	Authority  []jsonRR   `json:"authority"`
+	Additional []jsonRR   `json:"additional"`
+}
+
+type jsonRecur struct {
+	Code      string   `json:"code"`
+	Chain     []string `json:"chain,omitempty"`
+	Security  string   `json:"security,omitempty"`
+	SecReason string   `json:"sec_reason,omitempty"`
+	Trace     []string `json:"trace,omitempty"`
+}
+
+type jsonResult struct {
+	Server  string     `json:"server,omitempty"`
+	TimeMS  int64      `json:"time_ms"`
+	Recur   *jsonRecur `json:"recursion,omitempty"`
+	Message *jsonMsg   `json:"message"`
+}
+
+func jsonRRs(rrs []*dns.RR) []jsonRR {
+	ret := make([]jsonRR, 0, len(rrs))
+	for _, rr := range rrs {
+		f := rrFields(rr)
+		data := ""
+		if len(f) > 4 {
+			data = f[4]
+		}
+		ret = append(ret, jsonRR{f[0], rr.TTL, f[2], f[3], data})
+	}
+	return ret
+}
+
+func (r *result) printJSON(out io.Writer) error {
+	ret := &jsonResult{Server: r.server, TimeMS: r.rtt.Nanoseconds() / 1000000}
+	if r.recur {
+		ret.Recur = &jsonRecur{r.code, r.chain, r.security, r.secReason,
+			r.trace}
+	}
+	if m := r.msg; m != nil {
+		ques := make([]jsonQues, 0, len(m.Ques))
+		for _, q := range m.Ques {
+			rr := &dns.RR{Name: q.Name, Type: q.Type, Class: q.Class,
+				Rdata: &dns.RdBytes{}}
+			f := rrFields(rr)
+			ques = append(ques, jsonQues{f[0], f[3], f[2]})
+		}
+		ret.Message = &jsonMsg{
+			ID:         m.ID,
+			Opcode:     opcodeStr(m),
+			Status:     statusStr(m),
+			Flags:      flagStrs(m),
+			Question:   ques,
+			Answer:     jsonRRs(records(m.Answ)),
+			Authority:  jsonRRs(records(m.Auth)),
+			Additional: jsonRRs(records(m.Addi)),
+		}
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(ret)
+}
@@ -1,34 +1,346 @@
+// drill is a dig-like tool, which resolves names recursively from the
+// root servers, or queries a server directly
 package main
 
 import (
+	"bytes"
 	"dns"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
-func main() {
-	client := dns.NewClient()
+const usage = `usage: drill [@server[:port]] name [type] [class] [+option...]
+
+without a server, the name is resolved recursively from the root servers;
+the type and the class can come in either order
+
+options:
+  +trace          print the log of the recursion
+  +stub           ask the servers in /etc/resolv.conf instead
+  +roots=file     start the recursion from the root hints in the file
+  +dnssec         ask for dnssec records, and validate the recursion
+  +[no]recurse    set the recursion desired flag, on by default
+  +tcp            query over tcp only
+  +bufsize=n      use edns with the udp buffer size
+  +timeout=sec    time to wait for a response, 5 by default
+  +tries=n        times to send a query, 3 by default
+  +retry=n        times to resend a query, 2 by default
+  +brace          print in the brace format, the default
+  +dig            print in the format of dig
+  +json           print in json
+`
+
+// output formats
+const (
+	BRACE = iota
+	DIG
+	JSON
+)
+
+type config struct {
+	server *dns.IPv4 // nil for recursion
+	port   uint16
+	name   string
+	t      uint16
+	class  uint16
+
+	trace   bool
+	stub    bool
+	roots   string
+	dnssec  bool
+	recurse bool
+	tcp     bool
+	bufsize uint16
+	timeout time.Duration
+	tries   int
+	format  int
+}
 
-	fmt.Println("> dig liulonnie.net @74.220.195.131")
-	resp, err := client.Query(
-		dns.ParseIP("74.220.195.131"), // ns1.hostmonster.com
-		dns.Domain("liulonnie.net"),
-		dns.A,
-	)
+var errUsage = errors.New("bad usage")
+
+func parseServer(s string) (*dns.IPv4, uint16, error) {
+	host, port := s, uint16(dns.DNS_PORT)
+	if h, p, err := net.SplitHostPort(s); err == nil {
+		i, err := strconv.ParseUint(p, 10, 16)
+		if err != nil {
+			return nil, 0, fmt.Errorf("bad port %q", p)
+		}
+		host, port = h, uint16(i)
+	}
+	if ip := dns.ParseIP(host); ip != nil {
+		return ip, port, nil
+	}
+	ips, err := net.LookupIP(host)
 	if err != nil {
-		fmt.Println(err)
-		return
+		return nil, 0, err
+	}
+	for _, ip := range ips {
+		if ret := dns.IPFromIP(ip); ret != nil {
+			return ret, port, nil
+		}
 	}
+	return nil, 0, fmt.Errorf("no ipv4 address for %s", host)
+}
 
-	fmt.Println(resp.Msg)
+func parseInt(s string, min, max int) (int, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil || i < min || i > max {
+		return 0, fmt.Errorf("bad number %q", s)
+	}
+	return i, nil
+}
 
-	fmt.Println("> dig -recursive google.com a")
-	client.RecurQuery(dns.Domain("google.com"), dns.A, os.Stdout)
+func (c *config) parseOption(opt string) error {
+	key, value := opt, ""
+	if i := strings.Index(opt, "="); i >= 0 {
+		key, value = opt[:i], opt[i+1:]
+	}
+	var err error
+	var i int
+	switch key {
+	case "trace":
+		c.trace = true
+	case "stub":
+		c.stub = true
+	case "roots":
+		c.roots = value
+	case "dnssec":
+		c.dnssec = true
+	case "recurse":
+		c.recurse = true
+	case "norecurse":
+		c.recurse = false
+	case "tcp":
+		c.tcp = true
+	case "notcp":
+		c.tcp = false
+	case "bufsize":
+		i, err = parseInt(value, 512, 65535)
+		c.bufsize = uint16(i)
+	case "timeout":
+		i, err = parseInt(value, 1, 300)
+		c.timeout = time.Duration(i) * time.Second
+	case "tries":
+		c.tries, err = parseInt(value, 1, 100)
+	case "retry":
+		i, err = parseInt(value, 0, 99)
+		c.tries = i + 1
+	case "brace":
+		c.format = BRACE
+	case "dig":
+		c.format = DIG
+	case "json":
+		c.format = JSON
+	default:
+		return fmt.Errorf("unknown option +%s", key)
+	}
+	return err
+}
+
+func parseArgs(args []string) (*config, error) {
+	c := &config{
+		t:       dns.A,
+		class:   dns.IN,
+		recurse: true,
+		timeout: 5 * time.Second,
+		tries:   3,
+	}
+	var typeSet, classSet bool
+	for _, arg := range args {
+		switch {
+		case arg == "-h" || arg == "--help":
+			return nil, errUsage
+		case strings.HasPrefix(arg, "@"):
+			ip, port, err := parseServer(arg[1:])
+			if err != nil {
+				return nil, err
+			}
+			c.server, c.port = ip, port
+		case strings.HasPrefix(arg, "+"):
+			if err := c.parseOption(arg[1:]); err != nil {
+				return nil, err
+			}
+		case c.name == "":
+			c.name = arg
+		case typeSet && classSet:
+			return nil, fmt.Errorf("extra argument %q", arg)
+		default:
+			// the type and the class can come in either order, like dig
+			if t, ok := dns.ParseType(arg); ok && !typeSet {
+				c.t, typeSet = t, true
+			} else if class, ok := dns.ParseClass(arg); ok && !classSet {
+				c.class, classSet = class, true
+			} else {
+				return nil, fmt.Errorf("unknown type or class %q", arg)
+			}
+		}
+	}
+	if c.name == "" {
+		return nil, errUsage
+	}
+
+	if c.server == nil {
+		switch {
+		case c.class != dns.IN:
+			return nil, errors.New("only class in can be resolved")
+		case c.tcp || c.bufsize > 0:
+			return nil, errors.New("+tcp and +bufsize need a server")
+		case !c.recurse:
+			return nil, errors.New("+norecurse needs a server")
+		case c.stub && (c.trace || c.roots != "" || c.dnssec):
+			return nil, errors.New(
+				"+stub does not go with +trace, +roots or +dnssec")
+		}
+	}
+	return c, nil
+}
+
+// the name as a domain name, the trailing dot is optional
+func (c *config) domain() (*dns.Name, error) {
+	if c.name == "." {
+		return dns.NewName(".")
+	}
+	return dns.NewName(strings.TrimSuffix(c.name, "."))
+}
 
-	fmt.Println("> dig -recursive liulonnie.net a")
-	client.RecurQuery(dns.Domain("liulonnie.net"), dns.A, os.Stdout)
+func isTimeout(err error) bool {
+	nerr, ok := err.(net.Error)
+	return ok && nerr.Timeout()
+}
 
-	fmt.Println("(do it again to see if caching works)")
-	fmt.Println("> dig -recursive liulonnie.net a")
-	client.RecurQuery(dns.Domain("liulonnie.net"), dns.A, os.Stdout)
+// queries the server directly
+func direct(c *config, out io.Writer) error {
+	n, err := c.domain()
+	if err != nil {
+		return err
+	}
+	q := dns.NewQuery(n, c.t)
+	q.Ques[0].Class = c.class
+	if c.recurse {
+		q.Flags |= dns.F_RD
+	}
+	if c.bufsize > 0 || c.dnssec {
+		size := c.bufsize
+		if size == 0 {
+			size = 4096
+		}
+		q.SetEDNS(size, c.dnssec)
+	}
+
+	x := dns.NewExchanger(c.server)
+	x.Port = c.port
+	x.Timeout = c.timeout
+	x.TCP = c.tcp
+
+	for i := 0; i < c.tries; i++ {
+		start := time.Now()
+		msg, err := x.Exchange(q)
+		if isTimeout(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		r := &result{
+			msg:    msg,
+			server: fmt.Sprintf("%s#%d", c.server, c.port),
+			when:   start,
+			rtt:    time.Since(start),
+		}
+		return r.print(out, c.format)
+	}
+	return fmt.Errorf("no response from %s after %d tries", c.server,
+		c.tries)
+}
+
+// asks the servers of resolv.conf
+func stub(c *config, out io.Writer) error {
+	conf, err := dns.LoadResolvConf(dns.RESOLV_CONF)
+	if err != nil {
+		conf = dns.DefaultResolvConf()
+	}
+	conf.Timeout = c.timeout
+	conf.Attempts = c.tries
+	client := dns.NewStubClient(conf)
+	defer client.Close()
+
+	start := time.Now()
+	p, err := client.Query(c.name, c.t, nil)
+	if err != nil {
+		return err
+	}
+	return recurResult(p, start).print(out, c.format)
+}
+
+// resolves the name from the root servers
+func recur(c *config, out io.Writer) error {
+	n, err := c.domain()
+	if err != nil {
+		return err
+	}
+	client := dns.NewClient()
+	client.SetTimeout(c.timeout, c.tries)
+	if c.roots != "" {
+		roots, err := dns.LoadRootHints(c.roots)
+		if err != nil {
+			return err
+		}
+		client.SetRootHints(roots)
+	}
+	if c.dnssec {
+		client.SetTrustAnchor(dns.RootTrustAnchor())
+	}
+
+	// the trace goes into the json document, so that it stays parsable
+	var log io.Writer
+	trace := new(bytes.Buffer)
+	if c.trace {
+		log = out
+		if c.format == JSON {
+			log = trace
+		}
+	}
+	start := time.Now()
+	p := client.RecurQuery(n, c.t, log)
+	r := recurResult(p, start)
+	if trace.Len() > 0 {
+		r.trace = strings.Split(strings.TrimSuffix(trace.String(), "\n"),
+			"\n")
+	}
+	if c.dnssec {
+		r.security = dns.SecStr(p.Security)
+		r.secReason = p.SecReason
+	}
+	return r.print(out, c.format)
+}
+
+func main() {
+	c, err := parseArgs(os.Args[1:])
+	if err == errUsage {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "drill:", err)
+		os.Exit(2)
+	}
+
+	switch {
+	case c.server != nil:
+		err = direct(c, os.Stdout)
+	case c.stub:
+		err = stub(c, os.Stdout)
+	default:
+		err = recur(c, os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "drill:", err)
+		os.Exit(1)
+	}
 }
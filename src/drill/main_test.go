@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"dns"
+	"testing"
+	"time"
+)
+
+func TestParseServer(t *testing.T) {
+	for _, c := range []struct {
+		s    string
+		ip   string
+		port uint16
+	}{
+		{"192.0.2.1", "192.0.2.1", 53},
+		{"192.0.2.1:5353", "192.0.2.1", 5353},
+		{"192.0.2.1:x", "", 0},
+		{"192.0.2.1:70000", "", 0},
+	} {
+		ip, port, err := parseServer(c.s)
+		if c.ip == "" {
+			if err == nil {
+				t.Errorf("%s: no error", c.s)
+			}
+			continue
+		}
+		if err != nil || ip.String() != c.ip || port != c.port {
+			t.Errorf("%s: %s %d %v", c.s, ip, port, err)
+		}
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	for _, c := range []struct {
+		args  []string
+		t     uint16
+		class uint16
+	}{
+		{[]string{"example.com"}, dns.A, dns.IN},
+		{[]string{"example.com", "MX"}, dns.MX, dns.IN},
+		{[]string{"example.com", "A", "IN"}, dns.A, dns.IN},
+		{[]string{"example.com", "IN", "AAAA"}, dns.AAAA, dns.IN},
+		{[]string{"example.com", "IN"}, dns.A, dns.IN},
+		{[]string{"@192.0.2.1", "version.bind", "CH", "TXT"}, dns.TXT, dns.CH},
+	} {
+		conf, err := parseArgs(c.args)
+		if err != nil {
+			t.Errorf("%v: %v", c.args, err)
+			continue
+		}
+		if conf.t != c.t || conf.class != c.class {
+			t.Errorf("%v: type %d class %d", c.args, conf.t, conf.class)
+		}
+	}
+
+	conf, err := parseArgs([]string{"+retry=4", "@192.0.2.1:5353", "x.test",
+		"+norecurse", "+tcp", "+bufsize=1232", "+timeout=2", "+json"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conf.server.String() != "192.0.2.1" || conf.port != 5353 ||
+		conf.name != "x.test" || conf.recurse || !conf.tcp ||
+		conf.bufsize != 1232 || conf.timeout != 2*time.Second ||
+		conf.tries != 5 || conf.format != JSON {
+		t.Errorf("options: %+v", conf)
+	}
+
+	for _, args := range [][]string{
+		{},
+		{"-h"},
+		{"+trace"},
+	} {
+		if _, err := parseArgs(args); err != errUsage {
+			t.Errorf("%v: %v, expecting usage", args, err)
+		}
+	}
+	for _, args := range [][]string{
+		{"x.test", "A", "AAAA"},
+		{"x.test", "A", "IN", "extra"},
+		{"x.test", "FOO"},
+		{"x.test", "+foo"},
+		{"x.test", "+bufsize=100"},
+		{"x.test", "+tries=0"},
+		{"x.test", "CH"},
+		{"x.test", "+tcp"},
+		{"x.test", "+bufsize=1232"},
+		{"x.test", "+norecurse"},
+		{"x.test", "+stub", "+trace"},
+		{"x.test", "+stub", "+dnssec"},
+		{"@192.0.2.1:x", "x.test"},
+	} {
+		if _, err := parseArgs(args); err == nil || err == errUsage {
+			t.Errorf("%v: %v", args, err)
+		}
+	}
+}
+
+const goldenDig = `;; ->>HEADER<<- opcode: QUERY, status: NOERROR, id: 4321
+;; flags: qr rd ra ad; QUERY: 1, ANSWER: 1, AUTHORITY: 0, ADDITIONAL: 0
+
+;; OPT PSEUDOSECTION:
+; EDNS: version: 0, flags: do; udp: 1232
+
+;; QUESTION SECTION:
+;example.com.		IN	A
+
+;; ANSWER SECTION:
+example.com.	300	IN	A	192.0.2.1
+
+;; Query time: 12 msec
+;; SERVER: 192.0.2.53#53
+;; WHEN: Wed, 03 Jul 2024 12:00:00 UTC
+`
+
+const goldenJSON = `{
+  "server": "192.0.2.53#53",
+  "time_ms": 12,
+  "message": {
+    "id": 4321,
+    "opcode": "QUERY",
+    "status": "NOERROR",
+    "flags": [
+      "qr",
+      "rd",
+      "ra",
+      "ad"
+    ],
+    "question": [
+      {
+        "name": "example.com.",
+        "type": "A",
+        "class": "IN"
+      }
+    ],
+    "answer": [
+      {
+        "name": "example.com.",
+        "ttl": 300,
+        "class": "IN",
+        "type": "A",
+        "data": "192.0.2.1"
+      }
+    ],
+    "authority": [],
+    "additional": []
+  }
+}
+`
+
+func testResult() *result {
+	m := &dns.Msg{
+		ID:    4321,
+		Flags: dns.F_RESPONSE | dns.F_RD | dns.F_RA | dns.F_AD,
+		Ques: []dns.Ques{{
+			Name: dns.Domain("example.com"), Type: dns.A, Class: dns.IN,
+		}},
+		Answ: []dns.RR{{
+			Name: dns.Domain("example.com"), Type: dns.A, Class: dns.IN,
+			TTL: 300, Rdata: &dns.RdIP{IP: dns.ParseIP("192.0.2.1")},
+		}},
+	}
+	m.SetEDNS(1232, true)
+	return &result{
+		msg:    m,
+		server: "192.0.2.53#53",
+		when:   time.Date(2024, 7, 3, 12, 0, 0, 0, time.UTC),
+		rtt:    12 * time.Millisecond,
+	}
+}
+
+func TestPrintDig(t *testing.T) {
+	out := new(bytes.Buffer)
+	if err := testResult().print(out, DIG); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != goldenDig {
+		t.Errorf("got\n%s\nexpecting\n%s", out, goldenDig)
+	}
+}
+
+func TestPrintJSON(t *testing.T) {
+	out := new(bytes.Buffer)
+	if err := testResult().print(out, JSON); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != goldenJSON {
+		t.Errorf("got\n%s\nexpecting\n%s", out, goldenJSON)
+	}
+}